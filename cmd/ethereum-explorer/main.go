@@ -2,32 +2,221 @@
 package main
 
 import (
+	goctx "context"
+	"flag"
 	"fmt"
+	"log"
+	"log/slog"
 	"os"
+	"time"
 
+	"awesomeProject/internal/cli"
 	"awesomeProject/internal/config"
 	"awesomeProject/internal/etherscan"
 	"awesomeProject/internal/model"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 func main() {
-	config.LoadEnv()
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	chain := flag.Int("chain", cfg.ChainID, "chain id to query (e.g., 11155111 for Sepolia)")
+	chains := flag.String("chains", "", "comma-separated chain ids in use, cycled through with tab and checked when a hash isn't found (default: all supported chains)")
+	networkHint := flag.Bool("network-hint", true, `suggest checking the network when a hash isn't found (only if more than one chain is in use)`)
+	timeout := flag.Duration("timeout", cfg.Timeout, "per-request timeout for most API calls (0 keeps the built-in per-action timeouts)")
+	cacheTTL := flag.Duration("cache-ttl", 5*time.Minute, "how long a fetched transaction is reused when searched again (0 disables the cache)")
+	cacheSize := flag.Int("cache-size", 100, "most transactions kept in the cache")
+	historySize := flag.Int("history-size", 50, "most viewed transactions remembered for recalling with up/down and the history panel (0 disables it)")
+	pendingPoll := flag.Duration("pending-poll", 8*time.Second, "how often a pending transaction being viewed is fetched again until it settles (0 disables it)")
+	maxWatch := flag.Duration("max-watch", 0, "quit watch mode with a non-zero exit after this long (0 for no limit)")
+	delay := flag.Duration("delay", cfg.Delay, "pause before each transaction fetch, keeping the loading state visible (0 disables it)")
+	rateLimit := flag.Float64("rate-limit", etherscan.DefaultTuning().RateLimit, "most API requests per second, retries included (0 for no limit)")
+	rateBurst := flag.Int("rate-burst", etherscan.DefaultTuning().RateBurst, "API requests that may be sent at once before -rate-limit paces them")
+	fast := flag.Bool("fast", false, "disable artificial delays and the rate limit and probe networks concurrently (for paid API keys with high rate limits)")
+	finality := flag.String("finality", "", `when a transaction counts as finalized: "finalized" (chain's finalized block) or a number of confirmations`)
+	nonceContext := flag.Bool("nonce-context", false, "show the nonce in the context of the sender's history (one extra API call per lookup)")
+	skipConfirmations := flag.Bool("skip-confirmations", false, "don't fetch confirmations, saving one or two API calls per lookup")
+	skipReceipt := flag.Bool("skip-receipt", false, "don't fetch the receipt (status, gas used and fees), saving an API call per lookup")
+	skipTimestamp := flag.Bool("skip-timestamp", false, "don't fetch the block (timestamp, base fee and burnt fees), saving an API call per lookup")
+	ens := flag.Bool("ens", false, "show verified ENS names for Mainnet senders and recipients (extra API calls per new address)")
+	usd := flag.Bool("usd", true, "show approximate USD values next to Mainnet ETH amounts (one extra API call a minute at most)")
+	labels := flag.String("labels", "", `field label terminology: "etherscan" or "blockscout"`)
+	addressLabels := flag.String("address-labels", "", "JSON file mapping addresses to friendly names")
+	snapshot := flag.String("snapshot", "", "open a saved transaction snapshot (works offline, no API key needed)")
+	offline := flag.Bool("offline", false, "strict offline mode: make no network requests at all (needs -snapshot)")
+	rawLog := flag.String("raw-log", "", "append every raw API response (API key redacted) to this file, rotated at 5 MB")
+	localTime := flag.Bool("local-time", false, "show timestamps in the local timezone instead of UTC")
+	boxed := flag.Bool("boxed", false, "draw the transaction details in a bordered box (ignored without color)")
+	noColor := flag.Bool("no-color", false, "render without colors (NO_COLOR is also honored)")
+	simpleProgress := flag.Bool("simple-progress", false, "show a static progress bar instead of the animated one, for slow terminals")
+	debug := flag.Bool("debug", false, "log each API request and its request id to debug.log, and verify response ids")
+	listChains := flag.Bool("list-chains", false, "print the supported chains and exit")
+	oneShot := cli.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	if *listChains {
+		if err := etherscan.WriteChainsTable(os.Stdout, etherscan.SupportedChains()); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Flags left unset fall back to the environment, then the config file
+	if err := config.ApplyDefaults(flag.CommandLine, cfg.File[config.DefaultsSection], config.EnvVars); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if oneShot.Enabled() && (*snapshot != "" || *offline) {
+		fmt.Println("Error: -hash looks the transaction up live, so it can't be combined with -snapshot or -offline.")
+		os.Exit(cli.ExitUsage)
+	}
 
-	apiKey := config.APIKey()
-	if apiKey == "" {
+	var snap *etherscan.Snapshot
+	if *snapshot != "" {
+		snap, err = etherscan.LoadSnapshot(*snapshot)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if *offline && snap == nil {
+		fmt.Println("Error: -offline needs -snapshot, since there is nothing to show without the network.")
+		os.Exit(1)
+	}
+
+	if *noColor {
+		theme.DisableColor()
+	}
+
+	apiKey := cfg.APIKey
+	if apiKey == "" && snap == nil {
 		fmt.Println("Error: ETHERSCAN_API_KEY environment variable is not set.")
-		fmt.Println("Please create a .env file with your Etherscan API key.")
+		fmt.Printf("Please create a .env file with your Etherscan API key, or set key in the [%s] section of the config file.\n", config.APISection)
+		os.Exit(1)
+	}
+
+	fin, err := etherscan.ParseFinality(*finality)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	client := etherscan.NewClient(apiKey)
+	flavor, err := context.ParseFlavor(*labels)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	known, err := config.ReadAddressLabels(*addressLabels)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	chainIDs, err := etherscan.ParseChainIDs(*chains)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	for _, id := range append([]int{*chain}, chainIDs...) {
+		if !etherscan.IsKnownNetwork(id) {
+			fmt.Printf("Error: chain %d isn't supported; run with -list-chains to see the supported chains.\n", id)
+			os.Exit(1)
+		}
+	}
+
+	client := etherscan.NewClient(apiKey,
+		etherscan.WithChainID(*chain),
+		etherscan.WithTimeout(*timeout),
+		etherscan.WithCache(*cacheTTL, *cacheSize),
+		etherscan.WithArtificialDelay(*delay),
+		etherscan.WithRateLimit(*rateLimit, *rateBurst),
+	)
+	client.SetChains(chainIDs)
+	client.SetNetworkHint(*networkHint)
+	client.SetFinality(fin)
+	client.SetNonceContext(*nonceContext)
+	client.SetFetchConfirmations(!*skipConfirmations)
+	client.SetFetchReceipt(!*skipReceipt)
+	client.SetFetchTimestamp(!*skipTimestamp)
+	client.SetENSNames(*ens)
+	client.SetUSDPrices(*usd)
+	client.SetOffline(*offline)
+	if len(known) > 0 {
+		client.SetEnricher(etherscan.AddressLabeler(known))
+	}
+	if *fast {
+		client.SetTuning(etherscan.FastTuning())
+	}
+	if *debug {
+		// The TUI owns the terminal, so debug output goes to a file
+		f, err := tea.LogToFile("debug.log", "debug")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close() // nolint:errcheck // best-effort close of the debug log
+		client.SetDebugLogger(log.Default())
+		client.SetLogger(slog.New(slog.NewTextHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	}
+	if *rawLog != "" {
+		f, err := etherscan.NewRotatingFile(*rawLog, etherscan.DefaultRawLogMaxBytes, etherscan.DefaultRawLogBackups)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close() // nolint:errcheck // best-effort close of the raw response log
+		client.SetRawResponseLog(f)
+	}
+	if oneShot.Enabled() {
+		// There's no loading state to keep visible
+		tuning := client.Tuning()
+		tuning.ArtificialDelay = 0
+		client.SetTuning(tuning)
+		if code := cli.Run(goctx.Background(), client, *oneShot, os.Stdout, os.Stderr); code != cli.ExitOK {
+			os.Exit(code)
+		}
+		return
+	}
+
 	m := model.New(client)
+	if err := m.SetKeys(cfg.File[config.KeysSection]); err != nil {
+		fmt.Printf("Error: config file: [%s]: %v\n", config.KeysSection, err)
+		os.Exit(1)
+	}
+	m.SetLabelFlavor(flavor)
+	m.SetMaxWatch(*maxWatch)
+	m.SetHistorySize(*historySize)
+	m.SetPendingPoll(*pendingPoll)
+	m.SetSimpleProgress(*simpleProgress)
+	m.SetLocalTime(*localTime)
+	// Without color the border is just more characters to read past
+	m.SetBoxed(*boxed && !*noColor && os.Getenv("NO_COLOR") == "")
+	if snap != nil {
+		m.LoadSnapshot(snap)
+	}
 	p := tea.NewProgram(m, tea.WithAltScreen())
 
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
+	if fm, ok := final.(model.Model); ok {
+		fmt.Print(fm.Summary())
+		if reason := fm.GaveUp(); reason != "" {
+			fmt.Printf("Error: %s\n", reason)
+			os.Exit(1)
+		}
+	}
 }
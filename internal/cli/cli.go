@@ -0,0 +1,117 @@
+// Package cli runs a single lookup without the interactive UI, for scripts.
+package cli
+
+import (
+	"awesomeProject/internal/etherscan"
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Exit codes returned by Run.
+const (
+	ExitOK     = 0
+	ExitFailed = 1 // the lookup failed
+	ExitUsage  = 2 // the hash is malformed, as for flag errors
+)
+
+// Fetcher fetches a transaction. *etherscan.Client implements it; tests use a fake.
+type Fetcher interface {
+	FetchTransaction(ctx context.Context, hash etherscan.Hash) (*etherscan.Transaction, error)
+}
+
+// Options selects what a one-shot lookup fetches and how it's printed.
+type Options struct {
+	Hash string // transaction to look up; empty runs the interactive UI instead
+	JSON bool   // print every field as JSON instead of a text block
+}
+
+// RegisterFlags adds the -hash and -json flags to fs, returning the options
+// they fill in once fs is parsed. The chain comes from the existing -chain flag.
+func RegisterFlags(fs *flag.FlagSet) *Options {
+	opts := &Options{}
+	fs.StringVar(&opts.Hash, "hash", "", "look up this transaction, print it and exit instead of starting the UI")
+	fs.BoolVar(&opts.JSON, "json", false, "with -hash, print every field as JSON instead of a text block")
+	return opts
+}
+
+// Enabled reports whether a one-shot lookup was asked for.
+func (o Options) Enabled() bool {
+	return o.Hash != ""
+}
+
+// Run fetches the transaction in opts and prints it to stdout, as text or JSON.
+// Errors go to stderr. It returns the process exit code.
+func Run(ctx context.Context, f Fetcher, opts Options, stdout, stderr io.Writer) int {
+	hash := strings.TrimSpace(opts.Hash)
+	if err := etherscan.ValidateTxHash(hash); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitUsage
+	}
+
+	tx, err := f.FetchTransaction(ctx, etherscan.Hash(hash))
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitFailed
+	}
+
+	var out []byte
+	if opts.JSON {
+		out, err = etherscan.ExportJSON(tx)
+	} else {
+		out = formatText(tx)
+	}
+	if err == nil {
+		_, err = stdout.Write(out)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return ExitFailed
+	}
+	return ExitOK
+}
+
+// formatText renders a transaction as aligned "Label: value" lines, without the
+// glyphs used on screen, showing n/a for fields that weren't fetched.
+func formatText(tx *etherscan.Transaction) []byte {
+	to := "To"
+	if tx.To == "" && tx.ContractAddress != "" {
+		to = "Contract Created"
+	}
+
+	rows := []struct{ label, value string }{
+		{"Hash", string(tx.Hash)},
+		{"Status", tx.Status},
+		{"Block", tx.BlockNumber},
+		{"Confirmations", tx.Confirmations},
+		{"Timestamp", tx.Timestamp},
+		{"From", string(tx.From)},
+		{to, string(cmp.Or(tx.To, tx.ContractAddress))},
+		{"Method", tx.Method},
+		{"Value", tx.ValueAmount()},
+		{"Transaction Fee", tx.FeeAmount()},
+		{"Gas Limit", tx.Gas},
+		{"Gas Used", tx.GasUsed},
+		{"Gas Price", tx.GasPriceGwei()},
+		{"Nonce", tx.Nonce},
+		{"Type", tx.Type},
+	}
+	switch {
+	case tx.RevertReason != "":
+		rows = append(rows, struct{ label, value string }{"Revert Reason", tx.RevertReason})
+	case tx.RevertReasonError != "":
+		rows = append(rows, struct{ label, value string }{"Revert Reason", "unknown (replay failed: " + tx.RevertReasonError + ")"})
+	}
+
+	var b strings.Builder
+	for _, r := range rows {
+		fmt.Fprintf(&b, "%-17s %s\n", r.label+":", cmp.Or(r.value, "n/a"))
+	}
+	for _, w := range tx.Warnings {
+		fmt.Fprintf(&b, "Warning: %s\n", w)
+	}
+	return []byte(b.String())
+}
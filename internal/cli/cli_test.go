@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"awesomeProject/internal/etherscan"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testHash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+// fakeFetcher returns a canned transaction or error, recording the hashes it was asked for.
+type fakeFetcher struct {
+	tx      *etherscan.Transaction
+	err     error
+	fetched []etherscan.Hash
+}
+
+func (f *fakeFetcher) FetchTransaction(_ context.Context, hash etherscan.Hash) (*etherscan.Transaction, error) {
+	f.fetched = append(f.fetched, hash)
+	return f.tx, f.err
+}
+
+var testTx = &etherscan.Transaction{
+	Hash:           testHash,
+	Status:         "success",
+	BlockNumber:    "46147",
+	From:           "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+	To:             "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+	Value:          "♦ 0.031337 ETH",
+	GasPrice:       "⛽ 50000 Gwei (0.00005 ETH)",
+	GasPriceWei:    "50000000000000",
+	TransactionFee: "1.05 ETH",
+	Gas:            "21000",
+	GasUsed:        "21000",
+	Nonce:          "0",
+	Type:           "0 (Legacy)",
+}
+
+func TestRegisterFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    Options
+		enabled bool
+	}{
+		{"None", nil, Options{}, false},
+		{"Hash", []string{"-hash", testHash}, Options{Hash: testHash}, true},
+		{"Hash And JSON", []string{"--hash", testHash, "--json"}, Options{Hash: testHash, JSON: true}, true},
+		{"JSON Alone", []string{"-json"}, Options{JSON: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.Int("chain", 1, "chain id") // defined in main alongside these
+			opts := RegisterFlags(fs)
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *opts != tt.want || opts.Enabled() != tt.enabled {
+				t.Errorf("options = %+v, enabled %v; want %+v, %v", *opts, opts.Enabled(), tt.want, tt.enabled)
+			}
+		})
+	}
+}
+
+func TestRun_Text(t *testing.T) {
+	f := &fakeFetcher{tx: testTx}
+	var stdout, stderr bytes.Buffer
+	if code := Run(t.Context(), f, Options{Hash: " " + testHash + " "}, &stdout, &stderr); code != ExitOK {
+		t.Fatalf("Run() = %d; want %d, stderr %q", code, ExitOK, stderr.String())
+	}
+	if len(f.fetched) != 1 || f.fetched[0] != testHash {
+		t.Errorf("expected one fetch of the trimmed hash, got %v", f.fetched)
+	}
+
+	out := stdout.String()
+	for _, line := range []string{
+		"Hash:             " + testHash,
+		"Status:           success",
+		"To:               0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+		"Value:            0.031337 ETH",
+		"Gas Price:        50000 Gwei",
+		"Method:           n/a",
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("output missing line %q, got:\n%s", line, out)
+		}
+	}
+	if strings.ContainsAny(out, "♦⛽") || strings.Contains(out, "{") {
+		t.Errorf("expected plain text without glyphs or JSON, got:\n%s", out)
+	}
+}
+
+func TestRun_ContractCreation(t *testing.T) {
+	tx := *testTx
+	tx.To, tx.ContractAddress = "", "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+	var stdout bytes.Buffer
+	Run(t.Context(), &fakeFetcher{tx: &tx}, Options{Hash: testHash}, &stdout, io.Discard)
+	if !strings.Contains(stdout.String(), "Contract Created: 0x5fbdb2315678afecb367f032d93f642f64180aa3\n") {
+		t.Errorf("expected the created contract, got:\n%s", stdout.String())
+	}
+}
+
+func TestRun_JSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := Run(t.Context(), &fakeFetcher{tx: testTx}, Options{Hash: testHash, JSON: true}, &stdout, &stderr); code != ExitOK {
+		t.Fatalf("Run() = %d; want %d, stderr %q", code, ExitOK, stderr.String())
+	}
+	var got etherscan.Transaction
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", stdout.String(), err)
+	}
+	if !reflect.DeepEqual(&got, testTx) {
+		t.Errorf("decoded %+v; want %+v", got, *testTx)
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		err     error
+		code    int
+		fetches int
+	}{
+		{"Malformed Hash", "0x123", nil, ExitUsage, 0},
+		{"Not Found", testHash, etherscan.ErrTransactionNotFound, ExitFailed, 1},
+		{"Network", testHash, &etherscan.NetworkError{Err: errors.New("connection refused")}, ExitFailed, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeFetcher{err: tt.err}
+			var stdout, stderr bytes.Buffer
+			if code := Run(t.Context(), f, Options{Hash: tt.hash}, &stdout, &stderr); code != tt.code {
+				t.Errorf("Run() = %d; want %d", code, tt.code)
+			}
+			if len(f.fetched) != tt.fetches {
+				t.Errorf("fetched %d times; want %d", len(f.fetched), tt.fetches)
+			}
+			if stdout.Len() != 0 || !strings.HasPrefix(stderr.String(), "Error: ") {
+				t.Errorf("expected only an error on stderr, got stdout %q stderr %q", stdout.String(), stderr.String())
+			}
+		})
+	}
+}
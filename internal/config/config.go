@@ -1,12 +1,117 @@
-// Package config handles application configuration and environment variables.
+// Package config handles application configuration: the config file, environment variables and flag defaults.
 package config
 
 import (
+	"awesomeProject/internal/etherscan"
+	"cmp"
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Config is the configuration read before flags are parsed. The typed settings
+// are resolved as environment variable (including .env), then the config file's
+// [defaults] section, then the built-in default; a command-line flag overrides them.
+type Config struct {
+	// APIKey is the Etherscan API key: ETHERSCAN_API_KEY (including .env) if set,
+	// otherwise the key in the config file's [api] section.
+	APIKey string
+	// ChainID is the chain to query (ETHERSCAN_CHAIN_ID or chain), Mainnet by default.
+	ChainID int
+	// Delay is the pause before each transaction fetch (ETHERSCAN_DELAY or delay),
+	// the client's default unless set; zero disables it.
+	Delay time.Duration
+	// Timeout is the per-request timeout (ETHERSCAN_TIMEOUT or timeout); zero, the
+	// default, keeps the client's per-action timeouts.
+	Timeout time.Duration
+	// File is the parsed config file, empty if there is none. Its [defaults]
+	// section no longer holds the typed settings above, so they aren't applied twice.
+	File File
+}
+
+// Load reads .env, then the config file at Path, and resolves the API key and the
+// typed settings. A missing config file is not an error; a malformed one is, naming
+// the file and line, and so is an invalid setting, naming where it came from.
+func Load() (*Config, error) {
+	LoadEnv()
+	file, err := ReadFile(Path())
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{
+		APIKey:  cmp.Or(APIKey(), file[APISection]["key"]),
+		ChainID: 1,
+		Delay:   etherscan.DefaultTuning().ArtificialDelay,
+		File:    file,
+	}
+
+	defaults := file[DefaultsSection]
+	if err := resolve(defaults, "chain", "ETHERSCAN_CHAIN_ID", parseChainID, &cfg.ChainID); err != nil {
+		return nil, err
+	}
+	if err := resolve(defaults, "delay", "ETHERSCAN_DELAY", parseDuration, &cfg.Delay); err != nil {
+		return nil, err
+	}
+	if err := resolve(defaults, "timeout", "ETHERSCAN_TIMEOUT", parseDuration, &cfg.Timeout); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// resolve sets *dst from the environment variable env or, failing that, from key in
+// the config file's defaults, leaving *dst alone if neither is set. The key is
+// removed from defaults either way, since it has been consumed.
+func resolve[T any](defaults map[string]string, key, env string, parse func(string) (T, error), dst *T) error {
+	fileValue, inFile := defaults[key]
+	delete(defaults, key)
+
+	if value := os.Getenv(env); value != "" {
+		v, err := parse(value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid value %q: %w", env, value, err)
+		}
+		*dst = v
+		return nil
+	}
+	if inFile {
+		v, err := parse(fileValue)
+		if err != nil {
+			return fmt.Errorf("config file: invalid value %q for %s: %w", fileValue, key, err)
+		}
+		*dst = v
+	}
+	return nil
+}
+
+// parseChainID parses a positive chain ID. Whether the chain is supported is
+// checked once the -chain flag has had its say.
+func parseChainID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.New("not a chain ID")
+	}
+	if id <= 0 {
+		return 0, errors.New("chain ID must be positive")
+	}
+	return id, nil
+}
+
+// parseDuration parses a non-negative duration such as "500ms".
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, errors.New("duration must not be negative")
+	}
+	return d, nil
+}
+
 // LoadEnv loads variables from a local .env file if present.
 // It is safe to call multiple times; subsequent calls are no-ops.
 func LoadEnv() {
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string // config file contents, "" for no file
+		envKey    string
+		expected  string
+		wantChain int
+	}{
+		{"no file or env", "", "", "", 1},
+		{"config file", "[api]\nkey = file-key\n", "", "file-key", 10},
+		{"env over config file", "[api]\nkey = file-key\n", "env-key", "env-key", 10},
+		{"env without config file", "", "env-key", "env-key", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// An empty directory, so no .env is loaded
+			dir := t.TempDir()
+			t.Chdir(dir)
+			path := filepath.Join(dir, "config.ini")
+			if tt.file != "" {
+				if err := os.WriteFile(path, []byte(tt.file+"[defaults]\nchain = 10\n"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			t.Setenv("ETHERSCAN_CONFIG", path)
+			t.Setenv("ETHERSCAN_API_KEY", tt.envKey)
+			t.Setenv("ETHERSCAN_CHAIN_ID", "")
+
+			cfg, err := Load()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.APIKey != tt.expected {
+				t.Errorf("APIKey = %q; want %q", cfg.APIKey, tt.expected)
+			}
+			if cfg.ChainID != tt.wantChain {
+				t.Errorf("ChainID = %d; want %d", cfg.ChainID, tt.wantChain)
+			}
+		})
+	}
+}
+
+func TestLoad_Malformed(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	path := filepath.Join(dir, "config.ini")
+	if err := os.WriteFile(path, []byte("[api]\nkey\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("ETHERSCAN_CONFIG", path)
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), path) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error naming the file and line, got %v", err)
+	}
+}
+
+func TestLoad_TypedSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string // [defaults] entries
+		env         map[string]string
+		wantChain   int
+		wantDelay   time.Duration
+		wantTimeout time.Duration
+		errSub      string
+	}{
+		{"built-in defaults", "", nil, 1, 500 * time.Millisecond, 0, ""},
+		{"config file", "chain = 137\ndelay = 0s\ntimeout = 20s\n", nil, 137, 0, 20 * time.Second, ""},
+		{"env over config file", "chain = 137\ndelay = 1s\n", map[string]string{"ETHERSCAN_CHAIN_ID": "8453", "ETHERSCAN_DELAY": "200ms"}, 8453, 200 * time.Millisecond, 0, ""},
+		{"env without config file", "", map[string]string{"ETHERSCAN_TIMEOUT": "5s"}, 1, 500 * time.Millisecond, 5 * time.Second, ""},
+		{"malformed config chain", "chain = sepolia\n", nil, 0, 0, 0, `config file: invalid value "sepolia" for chain`},
+		{"negative config delay", "delay = -1s\n", nil, 0, 0, 0, "for delay: duration must not be negative"},
+		{"malformed env timeout", "", map[string]string{"ETHERSCAN_TIMEOUT": "soon"}, 0, 0, 0, `ETHERSCAN_TIMEOUT: invalid value "soon"`},
+		{"zero env chain", "", map[string]string{"ETHERSCAN_CHAIN_ID": "0"}, 0, 0, 0, "chain ID must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Chdir(dir)
+			path := filepath.Join(dir, "config.ini")
+			if err := os.WriteFile(path, []byte("[defaults]\n"+tt.file), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("ETHERSCAN_CONFIG", path)
+			for _, name := range []string{"ETHERSCAN_CHAIN_ID", "ETHERSCAN_DELAY", "ETHERSCAN_TIMEOUT"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			cfg, err := Load()
+			if tt.errSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errSub) {
+					t.Errorf("Load() error = %v; want it to contain %q", err, tt.errSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.ChainID != tt.wantChain || cfg.Delay != tt.wantDelay || cfg.Timeout != tt.wantTimeout {
+				t.Errorf("Load() = chain %d, delay %v, timeout %v; want %d, %v, %v",
+					cfg.ChainID, cfg.Delay, cfg.Timeout, tt.wantChain, tt.wantDelay, tt.wantTimeout)
+			}
+			// Consumed settings are left out of the defaults, so flags don't apply them again
+			for _, key := range []string{"chain", "delay", "timeout"} {
+				if _, ok := cfg.File[DefaultsSection][key]; ok {
+					t.Errorf("expected %s to be consumed from [%s], got %v", key, DefaultsSection, cfg.File[DefaultsSection])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultsSection is the config file section that sets defaults for command-line flags.
+const DefaultsSection = "defaults"
+
+// KeysSection is the config file section that rebinds keys, mapping action names to keys.
+const KeysSection = "keys"
+
+// APISection is the config file section holding the API key, so it never has to
+// be passed on the command line.
+const APISection = "api"
+
+// File is a parsed config file, mapping section names to their key/value pairs.
+// Keys before the first section header belong to the "" section.
+type File map[string]map[string]string
+
+// Path returns the config file location: ETHERSCAN_CONFIG if set, otherwise
+// ethereum-explorer/config.ini in the user's config directory (e.g., ~/.config).
+// It returns "" if neither is available.
+func Path() string {
+	if path := os.Getenv("ETHERSCAN_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "ethereum-explorer", "config.ini")
+}
+
+// ReadFile reads and parses the config file at path.
+// A missing file (or an empty path) is not an error and yields an empty File.
+func ReadFile(path string) (File, error) {
+	if path == "" {
+		return File{}, nil
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return File{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // nolint:errcheck // read-only file
+
+	file, err := ParseFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// ParseFile parses an INI-style config file:
+//
+//	# comment
+//	[defaults]
+//	chain = 11155111
+//	fast = true
+//
+// Values may be wrapped in double quotes. Later keys override earlier ones.
+func ParseFile(r io.Reader) (File, error) {
+	file := File{}
+	section := ""
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "["):
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header %q", n, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected key = value, got %q", n, line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			value = value[1 : len(value)-1]
+		}
+
+		if file[section] == nil {
+			file[section] = map[string]string{}
+		}
+		file[section][key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseFile(t *testing.T) {
+	input := `# ethereum-explorer config
+top = level
+
+[defaults]
+chain = 11155111
+labels = "blockscout"
+  fast=true
+; a comment
+chain = 17000
+
+[other]
+key = value with = sign
+`
+	file, err := ParseFile(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := File{
+		"":         {"top": "level"},
+		"defaults": {"chain": "17000", "labels": "blockscout", "fast": "true"},
+		"other":    {"key": "value with = sign"},
+	}
+	for section, want := range expected {
+		if !maps.Equal(file[section], want) {
+			t.Errorf("section %q = %v; want %v", section, file[section], want)
+		}
+	}
+}
+
+func TestParseFile_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unterminated section", "[defaults\nfast = true"},
+		{"missing equals", "[defaults]\nfast"},
+		{"missing key", "[defaults]\n= true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseFile(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("ParseFile(%q) expected an error", tt.input)
+			}
+		})
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	file, err := ReadFile(filepath.Join(dir, "missing.ini"))
+	if err != nil || len(file) != 0 {
+		t.Errorf("ReadFile(missing) = %v, %v; want empty file and no error", file, err)
+	}
+
+	path := filepath.Join(dir, "config.ini")
+	if err := os.WriteFile(path, []byte("[defaults]\nfast = true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file, err = ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file[DefaultsSection]["fast"] != "true" {
+		t.Errorf("expected fast = true, got %v", file)
+	}
+
+	if err := os.WriteFile(path, []byte("[defaults\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadFile(path); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("expected a parse error naming the file, got %v", err)
+	}
+}
+
+func TestPath(t *testing.T) {
+	t.Setenv("ETHERSCAN_CONFIG", "/tmp/explorer.ini")
+	if got := Path(); got != "/tmp/explorer.ini" {
+		t.Errorf("Path() = %q; want ETHERSCAN_CONFIG", got)
+	}
+
+	t.Setenv("ETHERSCAN_CONFIG", "")
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+	if got := Path(); got != filepath.Join("/tmp/xdg", "ethereum-explorer", "config.ini") {
+		t.Errorf("Path() = %q; want a path in the user config directory", got)
+	}
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+)
+
+// EnvVars maps command-line flag names to the environment variables that set their defaults.
+// The chain, delay and timeout are resolved by Load into Config's typed fields instead.
+var EnvVars = map[string]string{
+	// Comma-separated chain IDs in use (e.g., "1"); a single chain drops the wrong-network hint.
+	"chains": "ETHERSCAN_CHAINS",
+	// Set to false to never suggest checking the network when a hash isn't found.
+	"network-hint": "ETHERSCAN_NETWORK_HINT",
+	// How long fetched transactions are reused (e.g., "5m"; 0 disables the cache), and how many are kept.
+	"cache-ttl":  "ETHERSCAN_CACHE_TTL",
+	"cache-size": "ETHERSCAN_CACHE_SIZE",
+	// Most API requests per second and how many may be sent at once; a zero limit disables it.
+	"rate-limit": "ETHERSCAN_RATE_LIMIT",
+	"rate-burst": "ETHERSCAN_RATE_BURST",
+	// Fast mode removes the client's artificial delays, raises its concurrency and is intended for paid API keys.
+	"fast": "ETHERSCAN_FAST_MODE",
+	// "finalized" to use the chain's finalized block tag, or a number of confirmations.
+	"finality": "ETHERSCAN_FINALITY",
+	// An extra API call per lookup to show the nonce in the sender's history.
+	"nonce-context": "ETHERSCAN_NONCE_CONTEXT",
+	// Skip auxiliary calls on tight rate limits; the affected fields show "n/a".
+	"skip-confirmations": "ETHERSCAN_SKIP_CONFIRMATIONS",
+	"skip-receipt":       "ETHERSCAN_SKIP_RECEIPT",
+	"skip-timestamp":     "ETHERSCAN_SKIP_TIMESTAMP",
+	// How often a pending transaction being viewed is fetched again (e.g., "15s"); 0 disables it.
+	"pending-poll": "ETHERSCAN_PENDING_POLL",
+	// Longest watch mode may run before quitting with a non-zero exit (e.g., "10m").
+	"max-watch": "ETHERSCAN_MAX_WATCH",
+	// Most viewed transactions remembered for the session; 0 disables the history.
+	"history-size": "ETHERSCAN_HISTORY_SIZE",
+	// Up to four extra API calls per new address to show Mainnet ENS names.
+	"ens": "ETHERSCAN_ENS",
+	// Approximate USD values for Mainnet amounts, from a price fetched at most once a minute.
+	"usd": "ETHERSCAN_USD",
+	// "etherscan" or "blockscout" terminology for transaction field labels.
+	"labels": "ETHERSCAN_LABELS",
+	// JSON file mapping addresses to friendly names.
+	"address-labels": "ETHERSCAN_ADDRESS_LABELS",
+	// Refuse every network request; only snapshots can be viewed.
+	"offline": "ETHERSCAN_OFFLINE",
+	// Logs JSON-RPC request ids and verifies that responses echo them.
+	"debug": "ETHERSCAN_DEBUG",
+	// File to append every raw API response to, for attaching to bug reports.
+	"raw-log": "ETHERSCAN_RAW_LOG",
+	// Plain text output without colors (NO_COLOR is also honored).
+	"no-color": "ETHERSCAN_NO_COLOR",
+	// A static progress bar for slow terminals where the animation stutters.
+	"simple-progress": "ETHERSCAN_SIMPLE_PROGRESS",
+	// Timestamps in the local timezone instead of UTC.
+	"local-time": "ETHERSCAN_LOCAL_TIME",
+	// A bordered box around the transaction details.
+	"boxed": "ETHERSCAN_BOXED",
+}
+
+// ApplyDefaults fills in flags that were not set on the command line, so that
+// precedence runs: built-in default < config file < environment < flag.
+// Call it after fs.Parse.
+//
+// defaults holds values from the config file's [defaults] section, keyed by
+// flag name; env maps flag names to environment variables (see EnvVars).
+// It returns an error for an unknown key in defaults or an invalid value.
+func ApplyDefaults(fs *flag.FlagSet, defaults map[string]string, env map[string]string) error {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+
+	// Sort keys so the first error reported is deterministic
+	for _, name := range slices.Sorted(maps.Keys(defaults)) {
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("config file: unknown flag %q in [%s]", name, DefaultsSection)
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || set[f.Name] {
+			return
+		}
+		if value := os.Getenv(env[f.Name]); env[f.Name] != "" && value != "" {
+			if serr := fs.Set(f.Name, value); serr != nil {
+				err = fmt.Errorf("%s: invalid value %q: %w", env[f.Name], value, serr)
+			}
+			return
+		}
+		if value, ok := defaults[f.Name]; ok {
+			if serr := fs.Set(f.Name, value); serr != nil {
+				err = fmt.Errorf("config file: invalid value %q for %s: %w", value, f.Name, serr)
+			}
+		}
+	})
+	return err
+}
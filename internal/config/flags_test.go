@@ -0,0 +1,79 @@
+package config
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestApplyDefaults_Precedence(t *testing.T) {
+	env := map[string]string{"chain": "TEST_CHAIN_ID"}
+
+	tests := []struct {
+		name     string
+		args     []string
+		file     map[string]string
+		envValue string
+		expected int
+	}{
+		{"built-in default", nil, nil, "", 1},
+		{"config file over default", nil, map[string]string{"chain": "10"}, "", 10},
+		{"env over config file", nil, map[string]string{"chain": "10"}, "137", 137},
+		{"flag over env", []string{"-chain", "8453"}, map[string]string{"chain": "10"}, "137", 8453},
+		{"flag over config file", []string{"-chain", "8453"}, map[string]string{"chain": "10"}, "", 8453},
+		{"flag set to the default still wins", []string{"-chain", "1"}, map[string]string{"chain": "10"}, "137", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CHAIN_ID", tt.envValue)
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			chain := fs.Int("chain", 1, "")
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ApplyDefaults(fs, tt.file, env); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *chain != tt.expected {
+				t.Errorf("chain = %d; want %d", *chain, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyDefaults_Errors(t *testing.T) {
+	env := map[string]string{"fast": "TEST_FAST"}
+
+	tests := []struct {
+		name     string
+		file     map[string]string
+		envValue string
+		errSub   string
+	}{
+		{"unknown config key", map[string]string{"fats": "true"}, "", `unknown flag "fats"`},
+		{"invalid config value", map[string]string{"fast": "sometimes"}, "", "config file: invalid value"},
+		{"invalid env value", nil, "sometimes", "TEST_FAST: invalid value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_FAST", tt.envValue)
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			fs.Bool("fast", false, "")
+			if err := fs.Parse(nil); err != nil {
+				t.Fatal(err)
+			}
+
+			err := ApplyDefaults(fs, tt.file, env)
+			if err == nil || !strings.Contains(err.Error(), tt.errSub) {
+				t.Errorf("ApplyDefaults() error = %v; want it to contain %q", err, tt.errSub)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReadAddressLabels reads a JSON file mapping addresses to friendly names, e.g.
+//
+//	{"0x28C6c06298d514Db089934071355E5743bf21d60": "Binance Hot Wallet"}
+//
+// An empty path yields no labels.
+func ReadAddressLabels(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels map[string]string
+	if err := json.Unmarshal(b, &labels); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return labels, nil
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadAddressLabels(t *testing.T) {
+	labels, err := ReadAddressLabels("")
+	if err != nil || labels != nil {
+		t.Errorf("ReadAddressLabels(\"\") = %v, %v; want no labels", labels, err)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "labels.json")
+	if err := os.WriteFile(path, []byte(`{"0x28C6c06298d514Db089934071355E5743bf21d60": "Binance Hot Wallet"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	labels, err = ReadAddressLabels(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if labels["0x28C6c06298d514Db089934071355E5743bf21d60"] != "Binance Hot Wallet" {
+		t.Errorf("unexpected labels: %v", labels)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"0xabc": 1}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadAddressLabels(path); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("expected an error naming the file, got %v", err)
+	}
+
+	if _, err := ReadAddressLabels(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected an error for a missing labels file")
+	}
+}
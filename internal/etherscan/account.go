@@ -0,0 +1,88 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// AccountTransaction summarizes an entry in an address's transaction list, with
+// enough to pick one out and look it up in full.
+type AccountTransaction struct {
+	Hash        Hash
+	BlockNumber string // decimal
+	From        Address
+	To          Address // the created contract, for contract creations
+	Value       string  // formatted, e.g. "0.5 ETH"
+	Timestamp   string  // ISO 8601 format
+	Status      string  // "success" or "failed"
+}
+
+// FetchAddressTransactions retrieves a page of an address's normal transactions,
+// newest first.
+// Parameters:
+//   - ctx: The context for the request.
+//   - address: The account address.
+//   - page: The 1-based page number.
+//   - offset: The number of transactions per page.
+//
+// Returns:
+//   - The transactions on that page; empty past the last page or for an address without any.
+//   - An error if the page or offset is out of range or the request fails.
+func (c *Client) FetchAddressTransactions(ctx context.Context, address Address, page, offset int) ([]AccountTransaction, error) {
+	if page < 1 || offset < 1 {
+		return nil, errors.New("page and offset must be at least 1")
+	}
+	if page*offset > exportMaxResults {
+		return nil, fmt.Errorf("etherscan only lists the latest %d transactions of an address", exportMaxResults)
+	}
+	ctx = c.withNetwork(ctx)
+
+	txs, err := c.fetchAccountTransactionsPage(ctx, address, page, offset, "desc")
+	if err != nil {
+		return nil, err
+	}
+
+	decimals := c.networkFor(ctx).NativeDecimals
+	summaries := make([]AccountTransaction, len(txs))
+	for i, t := range txs {
+		summaries[i] = summarizeAccountTransaction(t, decimals)
+	}
+	return summaries, nil
+}
+
+// summarizeAccountTransaction formats a txlist entry, with amounts in a native
+// unit with the given decimals. Values that can't be parsed are kept as given.
+func summarizeAccountTransaction(t accountTransaction, decimals int) AccountTransaction {
+	timestamp := t.TimeStamp
+	if unix, err := strconv.ParseInt(t.TimeStamp, 10, 64); err == nil {
+		timestamp = time.Unix(unix, 0).UTC().Format(time.RFC3339)
+	}
+
+	value := t.Value
+	if v := stringToBigInt(t.Value); v != nil {
+		value = fmt.Sprintf("%s ETH", formatUnits(v, decimals))
+	}
+
+	status := "success"
+	if t.IsError == "1" {
+		status = "failed"
+	}
+
+	to := t.To
+	if to == "" {
+		to = t.ContractAddress
+	}
+
+	return AccountTransaction{
+		Hash:        Hash(t.Hash),
+		BlockNumber: t.BlockNumber,
+		From:        Address(t.From),
+		To:          Address(to),
+		Value:       value,
+		Timestamp:   timestamp,
+		Status:      status,
+	}
+}
@@ -0,0 +1,95 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestFetchAddressTransactions(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		want    []AccountTransaction
+	}{
+		{"Transactions", etherscantest.TxList, []AccountTransaction{
+			{
+				Hash:        "0x2f1c5c2b44f771e942a8506148e256f94f1a464babc938ae0690c6e34cd79190",
+				BlockNumber: "19000001",
+				From:        "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:          "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+				Value:       "1.5 ETH",
+				Timestamp:   "2024-01-11T19:06:40Z",
+				Status:      "success",
+			},
+			{
+				Hash:        "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+				BlockNumber: "19000000",
+				From:        "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:          "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+				Value:       "0 ETH",
+				Timestamp:   "2024-01-11T19:06:28Z",
+				Status:      "failed",
+			},
+		}},
+		{"No Transactions", etherscantest.TxListEmpty, []AccountTransaction{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := etherscantest.NewServer(t, etherscantest.Routes{"txlist": tt.fixture})
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			got, err := client.FetchAddressTransactions(t.Context(), "0xa1e4380a3b1f749673e270229993ee55f35663b4", 1, 25)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FetchAddressTransactions() = %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchAddressTransactions_Query(t *testing.T) {
+	var query map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = map[string]string{}
+		for k := range r.URL.Query() {
+			query[k] = r.URL.Query().Get(k)
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":[]}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	if _, err := client.FetchAddressTransactions(t.Context(), "0xa1e4380a3b1f749673e270229993ee55f35663b4", 3, 25); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for k, want := range map[string]string{"module": "account", "action": "txlist", "page": "3", "offset": "25", "sort": "desc"} {
+		if query[k] != want {
+			t.Errorf("query %s = %q; want %q", k, query[k], want)
+		}
+	}
+}
+
+func TestFetchAddressTransactions_Errors(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.Routes{"txlist": etherscantest.ErrorReverted})
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	const addr = "0xa1e4380a3b1f749673e270229993ee55f35663b4"
+
+	for _, tt := range []struct{ page, offset int }{{0, 25}, {1, 0}, {401, 25}} {
+		if _, err := client.FetchAddressTransactions(t.Context(), addr, tt.page, tt.offset); err == nil {
+			t.Errorf("FetchAddressTransactions(page %d, offset %d) expected an error", tt.page, tt.offset)
+		}
+	}
+	if server.Calls("txlist") != 0 {
+		t.Errorf("expected out of range pages to fail without a request")
+	}
+
+	if _, err := NewClient("").FetchAddressTransactions(t.Context(), addr, 1, 25); err != ErrNoAPIKey {
+		t.Errorf("expected ErrNoAPIKey, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Package etherscan provides address validation and EIP-55 checksumming.
+package etherscan
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// ToChecksum returns the EIP-55 mixed-case checksum form of an address.
+// Parameters:
+//   - addr: A 40-hex-digit address, with or without the 0x prefix, in any case.
+//
+// Returns:
+//   - The checksummed address with a 0x prefix.
+//   - An error if addr is not a valid address.
+func ToChecksum(addr Address) (Address, error) {
+	hexPart, ok := addressHex(string(addr))
+	if !ok {
+		return "", fmt.Errorf("invalid address %q: want 40 hex digits", addr)
+	}
+	lower := strings.ToLower(hexPart)
+
+	digest := hex.EncodeToString(keccak256([]byte(lower)))
+
+	// Uppercase each letter whose matching hash nibble is 8 or more
+	out := []byte(lower)
+	for i, c := range out {
+		if c >= 'a' && c <= 'f' && digest[i] >= '8' {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return Address("0x" + string(out)), nil
+}
+
+// IsValidAddress validates a typed address. All-lowercase and all-uppercase
+// addresses carry no checksum, so only mixed-case input is checked against EIP-55.
+// Parameters:
+//   - s: The address, with or without the 0x prefix.
+//
+// Returns:
+//   - valid: True if s is 40 hex digits.
+//   - checksumOK: True if s is valid and either carries no checksum or a correct one.
+//     A false value with valid set suggests a typo.
+func IsValidAddress(s string) (valid bool, checksumOK bool) {
+	hexPart, ok := addressHex(s)
+	if !ok {
+		return false, false
+	}
+	if hexPart == strings.ToLower(hexPart) || hexPart == strings.ToUpper(hexPart) {
+		return true, true
+	}
+	checksummed, err := ToChecksum(Address(hexPart))
+	return true, err == nil && string(checksummed[2:]) == hexPart
+}
+
+// addressHex strips an optional 0x prefix and reports whether the rest is 40 hex digits.
+func addressHex(s string) (string, bool) {
+	hexPart := s
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		hexPart = s[2:]
+	}
+	return hexPart, len(hexPart) == 40 && strings.TrimLeft(hexPart, "0123456789abcdefABCDEF") == ""
+}
+
+// keccak256 hashes the concatenation of parts.
+func keccak256(parts ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
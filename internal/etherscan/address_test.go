@@ -0,0 +1,68 @@
+package etherscan
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test vectors from EIP-55.
+var checksumVectors = []Address{
+	"0x52908400098527886E0F7030069857D2E4169EE7",
+	"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+	"0xde709f2102306220921060314715629080e2fb77",
+	"0x27b1fdb04752bbc536007a920d24acb045561c26",
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestToChecksum(t *testing.T) {
+	for _, want := range checksumVectors {
+		for _, input := range []Address{want, Address(strings.ToLower(string(want))), Address(strings.TrimPrefix(string(want), "0x"))} {
+			got, err := ToChecksum(input)
+			if err != nil {
+				t.Fatalf("ToChecksum(%s) unexpected error: %v", input, err)
+			}
+			if got != want {
+				t.Errorf("ToChecksum(%s) = %s; want %s", input, got, want)
+			}
+		}
+	}
+
+	for _, invalid := range []Address{"", "0x", "0x123", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeZ", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00"} {
+		if _, err := ToChecksum(invalid); err == nil {
+			t.Errorf("ToChecksum(%q) expected an error", invalid)
+		}
+	}
+}
+
+func TestIsValidAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		wantValid      bool
+		wantChecksumOK bool
+	}{
+		{"checksummed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true, true},
+		{"without prefix", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true, true},
+		{"uppercase prefix", "0X5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true, true},
+		{"all lowercase", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true, true},
+		{"all uppercase", "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", true, true},
+		{"bad checksum", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", true, false},
+		{"typo in digit", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAee", true, false},
+		{"too short", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", false, false},
+		{"too long", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00", false, false},
+		{"non-hex", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", false, false},
+		{"empty", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, checksumOK := IsValidAddress(tt.input)
+			if valid != tt.wantValid || checksumOK != tt.wantChecksumOK {
+				t.Errorf("IsValidAddress(%q) = %v, %v; want %v, %v", tt.input, valid, checksumOK, tt.wantValid, tt.wantChecksumOK)
+			}
+		})
+	}
+}
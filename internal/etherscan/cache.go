@@ -0,0 +1,174 @@
+// Package etherscan provides an in-memory cache of fetched transactions.
+package etherscan
+
+import (
+	"container/list"
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStats counts transaction cache lookups.
+type CacheStats struct {
+	Hits   int
+	Misses int
+}
+
+// cacheKey identifies a transaction across chains.
+type cacheKey struct {
+	chainID int
+	hash    Hash // lowercased
+}
+
+// cacheEntry is a cached transaction and when it was stored.
+type cacheEntry struct {
+	key    cacheKey
+	tx     *Transaction
+	stored time.Time
+}
+
+// txCache is a least recently used cache of mined transactions with a time to live.
+type txCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	order   *list.List // of *cacheEntry, most recently used first
+	entries map[cacheKey]*list.Element
+	stats   CacheStats
+	now     func() time.Time // stubbed in tests to expire entries
+}
+
+// newTxCache creates a cache holding up to size transactions for ttl each.
+func newTxCache(ttl time.Duration, size int) *txCache {
+	return &txCache{
+		ttl:     ttl,
+		size:    size,
+		order:   list.New(),
+		entries: map[cacheKey]*list.Element{},
+		now:     time.Now,
+	}
+}
+
+// get returns a copy of the cached transaction for key, if it is there and hasn't expired.
+func (c *txCache) get(key cacheKey) (*Transaction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if ok && c.now().Sub(el.Value.(*cacheEntry).stored) >= c.ttl {
+		c.order.Remove(el)
+		delete(c.entries, key)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(el)
+	return cloneTransaction(el.Value.(*cacheEntry).tx), true
+}
+
+// put stores a copy of tx under key, evicting the least recently used entry if the cache is full.
+func (c *txCache) put(key cacheKey, tx *Transaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{key: key, tx: cloneTransaction(tx), stored: c.now()}
+	if el, ok := c.entries[key]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// cloneTransaction copies tx deeply enough that changes to the copy don't reach the original.
+func cloneTransaction(tx *Transaction) *Transaction {
+	cp := *tx
+	cp.Events = slices.Clone(tx.Events)
+	cp.TokenTransfers = slices.Clone(tx.TokenTransfers)
+	cp.BlobVersionedHashes = slices.Clone(tx.BlobVersionedHashes)
+	cp.Labels = maps.Clone(tx.Labels)
+	cp.ENSNames = maps.Clone(tx.ENSNames)
+	cp.Warnings = slices.Clone(tx.Warnings)
+	return &cp
+}
+
+// cacheable reports whether a fetched transaction can be served again later.
+// Only mined transactions fetched without any issues are cached, since anything
+// else, like a pending status or a receipt that failed to load, may change.
+func cacheable(tx *Transaction) bool {
+	mined := tx.BlockNumber != "" && tx.BlockNumber != "0"
+	return mined && tx.Status != "Pending" && tx.Status != "replaced" && tx.Status != "error" && len(tx.Warnings) == 0
+}
+
+// WithCache keeps mined transactions in memory, so fetching one again skips every
+// request but a check of the latest block to bring its confirmations up to date.
+// Parameters:
+//   - ttl: How long a transaction is served from the cache.
+//   - size: The most transactions kept; the least recently used is dropped first.
+//
+// Returns:
+//   - The Option. A zero or negative ttl or size disables the cache.
+func WithCache(ttl time.Duration, size int) Option {
+	return func(c *Client) {
+		c.cache = nil
+		if ttl > 0 && size > 0 {
+			c.cache = newTxCache(ttl, size)
+		}
+	}
+}
+
+// CacheStats returns how many transaction fetches the cache has served and missed.
+//
+// Returns:
+//   - The counts, zero if the cache is disabled.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	return c.cache.stats
+}
+
+// cachedTransaction returns a cached transaction with its confirmations brought up to date.
+// If the latest block can't be fetched, the cached count is kept with a warning that it may be outdated.
+func (c *Client) cachedTransaction(ctx context.Context, key cacheKey) (*Transaction, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+	tx, ok := c.cache.get(key)
+	if !ok || c.skipConfirmations {
+		return tx, ok
+	}
+
+	defer beginStep(ctx, stepConfirmations)()
+	latest, err := c.FetchLatestBlockNumber(ctx)
+	if err != nil {
+		tx.AddWarning("confirmations may be outdated: %v", err)
+		return tx, true
+	}
+	if confirmations, _ := calculateConfirmations(latest, tx.BlockNumber); confirmations != "" {
+		tx.Confirmations = confirmations
+	}
+	// A finalized transaction stays finalized, so only check again if it wasn't
+	if !tx.Finalized {
+		tx.Finalized = c.isFinalized(ctx, tx.BlockNumber, tx.Confirmations)
+	}
+	return tx, true
+}
+
+// newCacheKey returns the cache key for a hash on a chain.
+func newCacheKey(chainID int, hash Hash) cacheKey {
+	return cacheKey{chainID: chainID, hash: Hash(strings.ToLower(string(hash)))}
+}
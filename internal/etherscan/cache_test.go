@@ -0,0 +1,171 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchTransaction_Cache(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithCache(time.Minute, 10))
+
+	first, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A hit must not wait out the artificial delay
+	client.SetTuning(Tuning{ArtificialDelay: time.Hour})
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	second, err := client.FetchTransaction(ctx, testHash)
+	if err != nil {
+		t.Fatalf("unexpected error on cache hit: %v", err)
+	}
+
+	if second == first || second.Hash != first.Hash || second.Status != first.Status || second.Confirmations != first.Confirmations {
+		t.Errorf("expected a copy of the cached transaction, got %+v", second)
+	}
+	if got := server.Calls("eth_getTransactionByHash"); got != 1 {
+		t.Errorf("expected 1 transaction request, got %d", got)
+	}
+	if got := server.Calls("eth_getTransactionReceipt"); got != 1 {
+		t.Errorf("expected 1 receipt request, got %d", got)
+	}
+	// Confirmations are brought up to date on a hit
+	if got := server.Calls("eth_blockNumber"); got != 2 {
+		t.Errorf("expected 2 latest block requests, got %d", got)
+	}
+	if got, want := client.CacheStats(), (CacheStats{Hits: 1, Misses: 1}); got != want {
+		t.Errorf("CacheStats() = %+v; want %+v", got, want)
+	}
+
+	// The same hash on another chain is a different transaction
+	client.SetChainID(11155111)
+	client.SetTuning(FastTuning())
+	if _, err := client.FetchTransaction(t.Context(), testHash); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.Calls("eth_getTransactionByHash"); got != 2 {
+		t.Errorf("expected another transaction request on a new chain, got %d", got)
+	}
+}
+
+func TestFetchTransaction_CacheExpiry(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithCache(time.Minute, 10))
+
+	clock := time.Now()
+	client.cache.now = func() time.Time { return clock }
+
+	for _, advance := range []time.Duration{0, 59 * time.Second, time.Second} {
+		clock = clock.Add(advance)
+		if _, err := client.FetchTransaction(t.Context(), testHash); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Stored at 0s, served at 59s, expired at 60s
+	if got, want := client.CacheStats(), (CacheStats{Hits: 1, Misses: 2}); got != want {
+		t.Errorf("CacheStats() = %+v; want %+v", got, want)
+	}
+	if got := server.Calls("eth_getTransactionByHash"); got != 2 {
+		t.Errorf("expected 2 transaction requests, got %d", got)
+	}
+}
+
+func TestFetchTransaction_CacheRefreshesConfirmations(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithCache(time.Minute, 10))
+
+	key := newCacheKey(1, testHash)
+	client.cache.put(key, &Transaction{Hash: testHash, BlockNumber: "5", Status: "success", Confirmations: "1"})
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The latest block is 11, so block 5 has 7 confirmations
+	if tx.Confirmations != "7" {
+		t.Errorf("expected confirmations recomputed to 7, got %q", tx.Confirmations)
+	}
+
+	// Offline, the cached count is served with a warning that it may be outdated
+	client.SetOffline(true)
+	tx, err = client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Confirmations != "1" || len(tx.Warnings) != 1 || !strings.Contains(tx.Warnings[0], "confirmations may be outdated") {
+		t.Errorf("expected the cached confirmations with a warning, got %q, %v", tx.Confirmations, tx.Warnings)
+	}
+	if cached, _ := client.cache.get(key); len(cached.Warnings) != 0 {
+		t.Errorf("expected the warning to stay off the cached copy, got %v", cached.Warnings)
+	}
+}
+
+func TestFetchTransaction_CacheSkipsUnsettled(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes etherscantest.Routes
+	}{
+		{"Pending", etherscantest.Routes{"eth_getTransactionByHash": etherscantest.TxPending, "eth_blockNumber": etherscantest.BlockNumber}},
+		{"Receipt Unavailable", etherscantest.Routes{
+			"eth_getTransactionByHash":  etherscantest.TxSuccess,
+			"eth_getTransactionReceipt": etherscantest.NullResult,
+			"eth_blockNumber":           etherscantest.BlockNumber,
+			"eth_getBlockByNumber":      etherscantest.Block,
+			"eth_getCode":               etherscantest.CodeEOA,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := etherscantest.NewServer(t, tt.routes)
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithCache(time.Minute, 10))
+
+			for range 2 {
+				if _, err := client.FetchTransaction(t.Context(), testHash); err != nil && !errors.Is(err, ErrTransactionNotFound) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if got := server.Calls("eth_getTransactionByHash"); got != 2 {
+				t.Errorf("expected both fetches to reach the API, got %d", got)
+			}
+		})
+	}
+}
+
+func TestTxCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newTxCache(time.Minute, 2)
+	a, b, c := newCacheKey(1, "0xA"), newCacheKey(1, "0xb"), newCacheKey(1, "0xc")
+	cache.put(a, &Transaction{Hash: "0xa"})
+	cache.put(b, &Transaction{Hash: "0xb"})
+
+	// Using a makes b the least recently used
+	if _, ok := cache.get(newCacheKey(1, "0xa")); !ok {
+		t.Fatal("expected a hit for 0xa regardless of case")
+	}
+	cache.put(c, &Transaction{Hash: "0xc"})
+
+	for key, want := range map[cacheKey]bool{a: true, b: false, c: true} {
+		if _, ok := cache.get(key); ok != want {
+			t.Errorf("get(%s) cached = %v; want %v", key.hash, ok, want)
+		}
+	}
+}
+
+func TestWithCache_Disabled(t *testing.T) {
+	for _, opt := range []Option{WithCache(0, 10), WithCache(time.Minute, 0)} {
+		if client := NewClient("test", WithCache(time.Minute, 10), opt); client.cache != nil {
+			t.Errorf("expected a zero ttl or size to disable the cache")
+		}
+	}
+	if got := NewClient("test").CacheStats(); got != (CacheStats{}) {
+		t.Errorf("CacheStats() without a cache = %+v; want zero", got)
+	}
+}
@@ -0,0 +1,82 @@
+// Package etherscan defines the registry of chains the client can switch between.
+package etherscan
+
+import "slices"
+
+// chainRegistry lists the chains served by the Etherscan V2 multichain API that
+// the explorer can switch between, in the order they are shown and cycled through.
+var chainRegistry = []Network{
+	{
+		ChainID: 1, Name: "Mainnet", ShortName: "Mainnet", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://etherscan.io",
+		FinalityConfirmations: defaultFinalityConfirmations, FinalizedTag: true, ENS: true, EtherPrice: true,
+	},
+	{
+		ChainID: 11155111, Name: "Sepolia", ShortName: "Sepolia", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://sepolia.etherscan.io",
+		FinalityConfirmations: defaultFinalityConfirmations, FinalizedTag: true,
+	},
+	{
+		ChainID: 17000, Name: "Holesky", ShortName: "Holesky", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://holesky.etherscan.io",
+		FinalityConfirmations: defaultFinalityConfirmations, FinalizedTag: true,
+	},
+	{
+		ChainID: 8453, Name: "Base", ShortName: "Base", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://basescan.org",
+		FinalizedTag: true,
+	},
+	{
+		ChainID: 137, Name: "Polygon PoS", ShortName: "Polygon", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://polygonscan.com",
+		FinalizedTag: true,
+	},
+	{
+		ChainID: 42161, Name: "Arbitrum One", ShortName: "Arbitrum", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://arbiscan.io",
+		FinalizedTag: true,
+	},
+	{
+		ChainID: 10, Name: "OP Mainnet", ShortName: "Optimism", NativeDecimals: defaultNativeDecimals, ExplorerURL: "https://optimistic.etherscan.io",
+		FinalizedTag: true,
+	},
+}
+
+// knownNetworks indexes chainRegistry by chain ID.
+var knownNetworks = func() map[int]Network {
+	m := make(map[int]Network, len(chainRegistry))
+	for _, n := range chainRegistry {
+		m[n.ChainID] = n
+	}
+	return m
+}()
+
+// SupportedChains returns the chains in the registry, in display order.
+// Other chain IDs can still be described with generic settings (see NetworkByID),
+// but the client can't be switched to them.
+// Returns:
+//   - A copy of the chain registry.
+func SupportedChains() []Network {
+	return slices.Clone(chainRegistry)
+}
+
+// ChainsInUse returns the registered chains the client cycles through, in display order.
+// Returns:
+//   - The chains set with SetChains that are in the registry, or every registered
+//     chain if none are set.
+func (c *Client) ChainsInUse() []Network {
+	if len(c.chains) == 0 {
+		return SupportedChains()
+	}
+	return slices.DeleteFunc(SupportedChains(), func(n Network) bool {
+		return !slices.Contains(c.chains, n.ChainID)
+	})
+}
+
+// NextChain returns the chain after the current one in the chains in use, wrapping
+// around, for cycling through networks.
+// Returns:
+//   - The next chain, or the first chain in use if the current one isn't among them.
+func (c *Client) NextChain() Network {
+	chains := c.ChainsInUse()
+	if len(chains) == 0 {
+		return c.Network()
+	}
+	current := c.ChainID()
+	i := slices.IndexFunc(chains, func(n Network) bool { return n.ChainID == current })
+	return chains[(i+1)%len(chains)]
+}
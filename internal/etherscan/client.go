@@ -2,51 +2,300 @@
 package etherscan
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/big"
 	"net/http"
 	"strings"
 	"time"
 )
 
+// replacementScanSize is the number of recent sender transactions scanned when
+// looking for the transaction that replaced a pending one.
+const replacementScanSize = 100
+
+// nonceScanSize is the number of an account's oldest transactions scanned when
+// looking up a transaction by sender and nonce.
+const nonceScanSize = 1000
+
 // ProxyResponse is a generic struct for handling Etherscan proxy responses.
 type ProxyResponse[T any] struct {
-	Result T `json:"result"`
+	ID     json.RawMessage `json:"id"`
+	Result T               `json:"result"`
 	Error  *struct {
 		Message string `json:"message"`
 	} `json:"error"`
 }
 
 // NewClient creates a new Etherscan client with the provided API key.
+// Without options, it queries Mainnet through the Etherscan V2 API with the
+// built-in per-action timeouts.
 // Parameters:
 //   - apiKey: The Etherscan API key to use for requests.
+//   - opts: Options applied in order, e.g. WithChainID(11155111).
 //
 // Returns:
 //   - A pointer to the newly created Client.
-func NewClient(apiKey string) *Client {
-	return &Client{
-		apiKey:  apiKey,
-		http:    &http.Client{Timeout: 15 * time.Second},
-		baseURL: "https://api.etherscan.io/v2/api",
-		chainID: 1, // Default to Mainnet
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		apiKey: apiKey,
+		// Timeouts are applied per request from the per-action table
+		http:           &http.Client{},
+		baseURL:        "https://api.etherscan.io/v2/api",
+		network:        NetworkByID(1), // Default to Mainnet
+		finality:       DefaultFinality(),
+		timeouts:       DefaultTimeouts(),
+		defaultTimeout: defaultRequestTimeout,
+		maxRetries:     defaultMaxRetries,
+		retryBaseDelay: defaultRetryBaseDelay,
+		logger:         slog.New(slog.DiscardHandler),
+	}
+	c.SetTuning(DefaultTuning())
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Option configures a Client when it is created. Options that have a setter,
+// such as WithChainID, behave exactly like calling it afterwards.
+type Option func(*Client)
+
+// WithHTTPClient sends requests through h, e.g. one with a custom transport or
+// proxy. Its own Timeout, if any, applies on top of the per-action timeouts.
+// Parameters:
+//   - h: The HTTP client to use. Nil keeps the default.
+//
+// Returns:
+//   - The Option.
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) {
+		if h != nil {
+			c.http = h
+		}
 	}
 }
 
-// SetChainID sets the Ethereum chain ID for the client.
+// WithBaseURL sends requests to a different Etherscan-compatible API, such as a
+// self-hosted proxy or a test server.
+// Parameters:
+//   - url: The API endpoint, e.g. "https://api.etherscan.io/v2/api".
+//
+// Returns:
+//   - The Option.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = url
+	}
+}
+
+// WithTimeout sets the request timeout for actions without their own entry (see SetDefaultTimeout).
+// Parameters:
+//   - d: The timeout. Zero or negative keeps the built-in default.
+//
+// Returns:
+//   - The Option.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.SetDefaultTimeout(d)
+	}
+}
+
+// WithChainID sets the chain to query (see SetChainID).
+// Parameters:
+//   - id: The chain ID (e.g., 11155111 for Sepolia).
+//
+// Returns:
+//   - The Option. Options can't fail, so a chain missing from the registry makes
+//     every request return an error wrapping ErrUnsupportedChain until
+//     SetChainID or SetNetwork picks a supported network.
+func WithChainID(id int) Option {
+	return func(c *Client) {
+		if err := c.SetChainID(id); err != nil {
+			c.netMu.Lock()
+			c.chainErr = err
+			c.netMu.Unlock()
+		}
+	}
+}
+
+// WithArtificialDelay sets the pause before each transaction fetch, which keeps
+// the loading state visible in the UI. Scripts and tests usually want zero.
+// Parameters:
+//   - d: The delay (500ms by default). Zero or less disables it.
+//
+// Returns:
+//   - The Option.
+func WithArtificialDelay(d time.Duration) Option {
+	return func(c *Client) {
+		c.tuning.ArtificialDelay = max(d, 0)
+	}
+}
+
+// WithTuning sets the politeness settings (see SetTuning).
+// Parameters:
+//   - t: The tuning preset (e.g., DefaultTuning or FastTuning).
+//
+// Returns:
+//   - The Option.
+func WithTuning(t Tuning) Option {
+	return func(c *Client) {
+		c.SetTuning(t)
+	}
+}
+
+// WithRateLimit paces API requests, retries included, so bursts of calls don't
+// trip the per-second rate limit.
+// Parameters:
+//   - rps: The most requests per second (5 by default). Zero or less removes the limit.
+//   - burst: How many requests may be sent at once before they are paced.
+//
+// Returns:
+//   - The Option.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Client) {
+		t := c.tuning
+		t.RateLimit, t.RateBurst = rps, burst
+		c.SetTuning(t)
+	}
+}
+
+// SetTuning sets the politeness settings used by the client.
+// Parameters:
+//   - t: The tuning preset (e.g., DefaultTuning or FastTuning).
+func (c *Client) SetTuning(t Tuning) {
+	c.tuning = t
+	c.limiter = newRateLimiter(t.RateLimit, t.RateBurst)
+}
+
+// Tuning returns the politeness settings used by the client.
+// Returns:
+//   - The current Tuning.
+func (c *Client) Tuning() Tuning {
+	return c.tuning
+}
+
+// SetChainID switches the client to a chain in the registry (see SupportedChains).
 // Parameters:
 //   - id: The Ethereum chain ID (e.g., 1 for Mainnet, 11155111 for Sepolia).
-func (c *Client) SetChainID(id int) {
-	c.chainID = id
+//
+// Returns:
+//   - An error wrapping ErrUnsupportedChain if the chain isn't in the registry,
+//     leaving the client's chain unchanged.
+func (c *Client) SetChainID(id int) error {
+	if !IsKnownNetwork(id) {
+		return fmt.Errorf("%w %d (see -list-chains for the supported chains)", ErrUnsupportedChain, id)
+	}
+	c.SetNetwork(NetworkByID(id))
+	return nil
 }
 
 // ChainID returns the current Ethereum chain ID.
 // Returns:
 //   - The current Ethereum chain ID.
 func (c *Client) ChainID() int {
-	return c.chainID
+	return c.Network().ChainID
+}
+
+// SetNetwork sets the network used for requests and value formatting.
+// Parameters:
+//   - n: The network to use. A non-positive NativeDecimals defaults to 18.
+func (c *Client) SetNetwork(n Network) {
+	if n.NativeDecimals <= 0 {
+		n.NativeDecimals = defaultNativeDecimals
+	}
+	c.netMu.Lock()
+	c.network = n
+	c.chainErr = nil
+	c.netMu.Unlock()
+}
+
+// Network returns the network currently used by the client.
+// Returns:
+//   - The current Network.
+func (c *Client) Network() Network {
+	c.netMu.RLock()
+	defer c.netMu.RUnlock()
+	return c.network
+}
+
+// SetNonceContext enables fetching the sender's transaction count with each
+// transaction, so the nonce can be shown in the context of the sender's history.
+// It is off by default since it costs an extra API call per lookup.
+// Parameters:
+//   - enabled: Whether to fetch the sender's transaction count.
+func (c *Client) SetNonceContext(enabled bool) {
+	c.nonceContext = enabled
+}
+
+// SetFetchConfirmations enables fetching the latest block, and the finalized
+// block if needed, to count the transaction's confirmations. It is on by default.
+// Parameters:
+//   - enabled: Whether to fetch confirmations.
+func (c *Client) SetFetchConfirmations(enabled bool) {
+	c.skipConfirmations = !enabled
+}
+
+// SetFetchReceipt enables fetching the transaction receipt, which provides the
+// status, gas used and the fees derived from it. It is on by default.
+// Parameters:
+//   - enabled: Whether to fetch the receipt.
+func (c *Client) SetFetchReceipt(enabled bool) {
+	c.skipReceipt = !enabled
+}
+
+// SetFetchTimestamp enables fetching the transaction's block, which provides
+// the timestamp, base fee, burnt fees and block details. It is on by default.
+// Parameters:
+//   - enabled: Whether to fetch the block.
+func (c *Client) SetFetchTimestamp(enabled bool) {
+	c.skipTimestamp = !enabled
+}
+
+// SetOffline enables strict offline mode, in which the client makes no network
+// requests at all: every call that would reach the API fails with ErrOfflineMode
+// instead. It is off by default.
+// Parameters:
+//   - enabled: Whether to refuse network requests.
+func (c *Client) SetOffline(enabled bool) {
+	c.offline = enabled
+}
+
+// Ping performs a lightweight connectivity check against the Etherscan API.
+// Any HTTP response counts as reachable; no API key or quota is consumed.
+// Parameters:
+//   - ctx: The context for the request.
+//
+// Returns:
+//   - A *NetworkError if the API cannot be reached, ErrOfflineMode in strict
+//     offline mode, otherwise nil.
+func (c *Client) Ping(ctx context.Context) error {
+	if c.offline {
+		return fmt.Errorf("%w (ping)", ErrOfflineMode)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout("eth_blockNumber"))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.baseURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return &NetworkError{Err: err}
+	}
+	_ = resp.Body.Close()
+
+	return nil
 }
 
 // FetchTransaction fetches transaction details by its hash.
@@ -56,21 +305,35 @@ func (c *Client) ChainID() int {
 //
 // Returns:
 //   - A pointer to the Transaction struct containing details.
-//   - An error if the request fails or the transaction is not found.
+//   - An error if the hash is malformed, the request fails or the transaction is not found.
 func (c *Client) FetchTransaction(ctx context.Context, hash Hash) (*Transaction, error) {
+	if err := ValidateTxHash(string(hash)); err != nil {
+		return nil, err
+	}
+	ctx = c.withNetwork(ctx)
 	if c.apiKey == "" {
-		return nil, errors.New("ETHERSCAN_API_KEY environment variable is not set")
+		return nil, ErrNoAPIKey
+	}
+
+	// A cached transaction skips the delay too, since it makes at most a request or two
+	key := newCacheKey(c.networkFor(ctx).ChainID, hash)
+	if tx, ok := c.cachedTransaction(ctx, key); ok {
+		return tx, nil
 	}
 
-	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionByHash&txhash=%s&apikey=%s", c.baseURL, c.chainID, hash, c.apiKey)
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionByHash&txhash=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, hash, c.apiKey)
+
+	endStep := beginStep(ctx, stepTransaction)
 
 	// small delay so the loading state is visible in the UI and to be polite with API
-	transaction, done, err2 := throttle(ctx)
+	transaction, done, err2 := throttle(ctx, c.tuning.ArtificialDelay)
 	if done {
+		endStep()
 		return transaction, err2
 	}
 
 	proxyResp, err := doRequest[json.RawMessage](ctx, c, url)
+	endStep()
 	if err != nil {
 		return nil, err
 	}
@@ -79,21 +342,32 @@ func (c *Client) FetchTransaction(ctx context.Context, hash Hash) (*Transaction,
 	if err3 != nil {
 		return t, err3
 	}
+	c.enrich(ctx, &tx)
 
+	if c.cache != nil && cacheable(&tx) {
+		c.cache.put(key, &tx)
+	}
 	return &tx, nil
 }
 
 // throttle introduces a small delay to be polite with the Etherscan API.
 // Parameters:
 //   - ctx: The context for the request.
+//   - delay: How long to wait. Zero or negative returns immediately.
 //
 // Returns:
 //   - A pointer to Transaction (always nil in this implementation).
 //   - An error if the context is cancelled.
 //   - A boolean indicating if the request should be considered done (e.g., on context cancellation).
-func throttle(ctx context.Context) (*Transaction, bool, error) {
+func throttle(ctx context.Context, delay time.Duration) (*Transaction, bool, error) {
+	if delay <= 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, true, err
+		}
+		return nil, false, nil
+	}
 	select {
-	case <-time.After(500 * time.Millisecond):
+	case <-time.After(delay):
 	case <-ctx.Done():
 		return nil, true, ctx.Err()
 	}
@@ -109,10 +383,10 @@ func throttle(ctx context.Context) (*Transaction, bool, error) {
 //   - An error if the request fails.
 func (c *Client) FetchLatestBlockNumber(ctx context.Context) (string, error) {
 	if c.apiKey == "" {
-		return "", errors.New("ETHERSCAN_API_KEY environment variable is not set")
+		return "", ErrNoAPIKey
 	}
 
-	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_blockNumber&apikey=%s", c.baseURL, c.chainID, c.apiKey)
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_blockNumber&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, c.apiKey)
 
 	proxyResp, err := doRequest[string](ctx, c, url)
 	if err != nil {
@@ -137,23 +411,63 @@ func (c *Client) FetchLatestBlockNumber(ctx context.Context) (string, error) {
 //   - The list of transaction hashes in the block.
 //   - An error if the request fails.
 func (c *Client) FetchBlockDetails(ctx context.Context, blockNumber string) (string, string, []string, error) {
+	block, timestamp, err := c.fetchBlock(ctx, blockNumber)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return timestamp, block.BaseFeePerGas, block.Transactions, nil
+}
+
+// fetchBlock retrieves a block header and its transaction hashes.
+// Parameters:
+//   - ctx: The context for the request.
+//   - blockNumber: The block number (hex or tag) to fetch.
+//
+// Returns:
+//   - The parsed block data.
+//   - The formatted timestamp string.
+//   - An error if the request fails.
+func (c *Client) fetchBlock(ctx context.Context, blockNumber string) (*blockResultData, string, error) {
 	if c.apiKey == "" {
-		return "", "", nil, errors.New("ETHERSCAN_API_KEY environment variable is not set")
+		return nil, "", ErrNoAPIKey
 	}
 
-	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getBlockByNumber&tag=%s&boolean=false&apikey=%s", c.baseURL, c.chainID, blockNumber, c.apiKey)
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getBlockByNumber&tag=%s&boolean=false&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, blockNumber, c.apiKey)
 
 	proxyResp, err := doRequest[json.RawMessage](ctx, c, url)
 	if err != nil {
-		return "", "", nil, err
+		return nil, "", err
 	}
 
-	block, unixTime, _, _, err2 := extractBlockDetails(proxyResp)
-	if err2 != nil {
-		return "", "", nil, err2
+	block, unixTime, _, _, err := extractBlockDetails(proxyResp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &block, time.Unix(unixTime, 0).UTC().Format(time.RFC3339), nil
+}
+
+// FetchBlockSummary retrieves the headline details of a block.
+// Parameters:
+//   - ctx: The context for the request.
+//   - blockNumber: The block number (hex or tag) to summarize.
+//
+// Returns:
+//   - A pointer to the BlockSummary.
+//   - An error if the request fails.
+func (c *Client) FetchBlockSummary(ctx context.Context, blockNumber string) (*BlockSummary, error) {
+	timestamp, baseFee, txHashes, err := c.FetchBlockDetails(ctx, blockNumber)
+	if err != nil {
+		return nil, err
 	}
 
-	return time.Unix(unixTime, 0).UTC().Format(time.RFC3339), block.BaseFeePerGas, block.Transactions, nil
+	return &BlockSummary{
+		Number:           hexToDecimal(blockNumber),
+		Timestamp:        timestamp,
+		TransactionCount: len(txHashes),
+		BaseFeePerGas:    formatGwei(baseFee),
+	}, nil
 }
 
 // FetchNextTransactionHash attempts to find the next transaction hash after the given one in the same block.
@@ -166,6 +480,7 @@ func (c *Client) FetchBlockDetails(ctx context.Context, blockNumber string) (str
 //   - The next transaction hash.
 //   - An error if the next transaction cannot be found.
 func (c *Client) FetchNextTransactionHash(ctx context.Context, currentTx *Transaction) (string, error) {
+	ctx = c.withNetwork(ctx)
 	if currentTx == nil || currentTx.BlockNumber == "" {
 		return "", errors.New("invalid current transaction")
 	}
@@ -207,6 +522,7 @@ func (c *Client) FetchNextTransactionHash(ctx context.Context, currentTx *Transa
 //   - The previous transaction hash.
 //   - An error if the previous transaction cannot be found.
 func (c *Client) FetchPreviousTransactionHash(ctx context.Context, currentTx *Transaction) (string, error) {
+	ctx = c.withNetwork(ctx)
 	if currentTx == nil || currentTx.BlockNumber == "" {
 		return "", errors.New("invalid current transaction")
 	}
@@ -242,6 +558,245 @@ func (c *Client) FetchPreviousTransactionHash(ctx context.Context, currentTx *Tr
 	return prevTxHashes[len(prevTxHashes)-1], nil
 }
 
+// FetchTransactionHashByBlockAndIndex finds the hash of the transaction at a position in a block.
+// Parameters:
+//   - ctx: The context for the request.
+//   - blockNumber: The block number, in hex or decimal.
+//   - index: The zero-based position of the transaction in the block.
+//
+// Returns:
+//   - The transaction hash.
+//   - An error if the request fails or the block has no transaction at that index.
+func (c *Client) FetchTransactionHashByBlockAndIndex(ctx context.Context, blockNumber string, index int) (string, error) {
+	if c.apiKey == "" {
+		return "", ErrNoAPIKey
+	}
+	block := stringToBigInt(blockNumber)
+	if block == nil || index < 0 {
+		return "", fmt.Errorf("invalid block position %s:%d", blockNumber, index)
+	}
+
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionByBlockNumberAndIndex&tag=0x%x&index=0x%x&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, block, index, c.apiKey)
+
+	proxyResp, err := doRequest[json.RawMessage](ctx, c, url)
+	if err != nil {
+		return "", err
+	}
+
+	// A null result means the index is past the end of the block
+	var tx struct {
+		Hash string `json:"hash"`
+	}
+	if len(proxyResp.Result) > 0 && string(proxyResp.Result) != "null" {
+		if err := json.Unmarshal(proxyResp.Result, &tx); err != nil {
+			return "", fmt.Errorf("failed to decode transaction: %w", err)
+		}
+	}
+	if tx.Hash == "" {
+		return "", fmt.Errorf("block %s has no transaction at index %d", block, index)
+	}
+	return tx.Hash, nil
+}
+
+// FetchTransactionCount retrieves the number of transactions sent from an address.
+// Parameters:
+//   - ctx: The context for the request.
+//   - address: The Ethereum address to query.
+//   - tag: The block tag to query at (e.g., "latest" or "pending").
+//
+// Returns:
+//   - The transaction count (the next nonce) as a hex string.
+//   - An error if the request fails.
+func (c *Client) FetchTransactionCount(ctx context.Context, address Address, tag string) (string, error) {
+	if c.apiKey == "" {
+		return "", ErrNoAPIKey
+	}
+
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionCount&address=%s&tag=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, address, tag, c.apiKey)
+
+	proxyResp, err := doRequest[string](ctx, c, url)
+	if err != nil {
+		return "", err
+	}
+
+	if stringToBigInt(proxyResp.Result) == nil {
+		return "", fmt.Errorf("invalid transaction count response: %q", proxyResp.Result)
+	}
+
+	return proxyResp.Result, nil
+}
+
+// FetchReplacementTransactionHash finds the mined transaction that replaced a pending one.
+// A transaction is considered replaced when another transaction from the same sender
+// with the same nonce has been mined (e.g., a speed-up or cancel).
+// Parameters:
+//   - ctx: The context for the request.
+//   - currentTx: The replaced transaction object.
+//
+// Returns:
+//   - The hash of the replacement transaction.
+//   - An error if no replacement can be found.
+func (c *Client) FetchReplacementTransactionHash(ctx context.Context, currentTx *Transaction) (string, error) {
+	ctx = c.withNetwork(ctx)
+	if currentTx == nil || currentTx.From == "" || currentTx.Nonce == "" {
+		return "", errors.New("invalid current transaction")
+	}
+	if c.apiKey == "" {
+		return "", ErrNoAPIKey
+	}
+
+	nonce := stringToBigInt(currentTx.Nonce)
+	if nonce == nil {
+		return "", fmt.Errorf("invalid nonce: %s", currentTx.Nonce)
+	}
+
+	txs, err := c.fetchAccountTransactions(ctx, currentTx.From, replacementScanSize, "desc")
+	if err != nil {
+		return "", err
+	}
+
+	for _, t := range txs {
+		if !strings.EqualFold(t.From, string(currentTx.From)) || strings.EqualFold(t.Hash, string(currentTx.Hash)) {
+			continue
+		}
+		if n := stringToBigInt(t.Nonce); n != nil && n.Cmp(nonce) == 0 {
+			return t.Hash, nil
+		}
+	}
+
+	return "", fmt.Errorf("no mined transaction found for nonce %s", nonce)
+}
+
+// FetchTransactionHashByNonce finds the mined transaction sent by an address with the given nonce.
+// Only the account's first nonceScanSize transactions are scanned.
+// Parameters:
+//   - ctx: The context for the request.
+//   - address: The sender address.
+//   - nonce: The transaction nonce (decimal or hex).
+//
+// Returns:
+//   - The hash of the matching transaction.
+//   - An error if the nonce has not been used or lies beyond the scanned transactions.
+func (c *Client) FetchTransactionHashByNonce(ctx context.Context, address Address, nonce string) (string, error) {
+	ctx = c.withNetwork(ctx)
+	n := stringToBigInt(nonce)
+	if address == "" || n == nil || n.Sign() < 0 {
+		return "", fmt.Errorf("invalid address or nonce: %s#%s", address, nonce)
+	}
+	if c.apiKey == "" {
+		return "", ErrNoAPIKey
+	}
+
+	count, err := c.FetchTransactionCount(ctx, address, "latest")
+	if err != nil {
+		return "", err
+	}
+	if next := stringToBigInt(count); next != nil && next.Cmp(n) <= 0 {
+		return "", fmt.Errorf("nonce %s has not been used by %s (next nonce is %s)", n, address, next)
+	}
+
+	txs, err := c.fetchAccountTransactions(ctx, address, nonceScanSize, "asc")
+	if err != nil {
+		return "", err
+	}
+
+	for _, t := range txs {
+		if !strings.EqualFold(t.From, string(address)) {
+			continue
+		}
+		if tn := stringToBigInt(t.Nonce); tn != nil && tn.Cmp(n) == 0 {
+			return t.Hash, nil
+		}
+	}
+
+	return "", fmt.Errorf("nonce %s not found in the first %d transactions of %s", n, nonceScanSize, address)
+}
+
+// fetchAccountTransactions retrieves a page of an account's normal transactions.
+// Parameters:
+//   - ctx: The context for the request.
+//   - address: The account address.
+//   - limit: The maximum number of transactions to return.
+//   - sort: The block order, "asc" or "desc".
+//
+// Returns:
+//   - The account's transactions.
+//   - An error if the request fails.
+func (c *Client) fetchAccountTransactions(ctx context.Context, address Address, limit int, sort string) ([]accountTransaction, error) {
+	return c.fetchAccountTransactionsPage(ctx, address, 1, limit, sort)
+}
+
+// fetchAccountTransactionsPage retrieves one page of an account's normal transactions.
+// Parameters:
+//   - ctx: The context for the request.
+//   - address: The account address.
+//   - page: The 1-based page number.
+//   - limit: The number of transactions per page.
+//   - sort: The block order, "asc" or "desc".
+//
+// Returns:
+//   - The account's transactions on that page.
+//   - An error if the request fails.
+func (c *Client) fetchAccountTransactionsPage(ctx context.Context, address Address, page, limit int, sort string) ([]accountTransaction, error) {
+	if c.apiKey == "" {
+		return nil, ErrNoAPIKey
+	}
+
+	url := fmt.Sprintf("%s?chainid=%d&module=account&action=txlist&address=%s&startblock=0&endblock=latest&page=%d&offset=%d&sort=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, address, page, limit, sort, c.apiKey)
+
+	resp, err := doRequest[json.RawMessage](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []accountTransaction
+	if err := json.Unmarshal(resp.Result, &txs); err != nil {
+		var msg string
+		if json.Unmarshal(resp.Result, &msg) == nil {
+			return nil, newAPIError(msg)
+		}
+		return nil, fmt.Errorf("unexpected response format for transaction list: %w", err)
+	}
+
+	return txs, nil
+}
+
+// replacementCheckKey is the context key that enables the replacement check of pending transactions.
+type replacementCheckKey struct{}
+
+// WithReplacementCheck returns a context under which FetchTransaction also checks
+// whether a pending transaction's nonce has been consumed by another mined
+// transaction from the same sender, marking it "replaced". The check needs the
+// sender's transaction count, an extra API call unless the nonce context already
+// fetches it, so it's meant for polling a pending transaction rather than every lookup.
+// Parameters:
+//   - ctx: The parent context.
+//
+// Returns:
+//   - A context that enables the check.
+func WithReplacementCheck(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replacementCheckKey{}, true)
+}
+
+// replacementCheck reports whether ctx enables the replacement check (see WithReplacementCheck).
+func replacementCheck(ctx context.Context) bool {
+	enabled, _ := ctx.Value(replacementCheckKey{}).(bool)
+	return enabled
+}
+
+// isReplaced reports whether a pending transaction's nonce has already been consumed
+// by another mined transaction, given its sender's confirmed transaction count.
+func isReplaced(senderTxCount, nonce string) (bool, error) {
+	count, n := stringToBigInt(senderTxCount), stringToBigInt(nonce)
+	if count == nil || n == nil {
+		return false, errors.New("invalid transaction count or nonce")
+	}
+
+	// The confirmed transaction count is the next usable nonce, so any
+	// nonce below it has been mined.
+	return count.Cmp(n) > 0, nil
+}
+
 // IsContract checks if the given address is a smart contract.
 // Parameters:
 //   - ctx: The context for the request.
@@ -252,10 +807,10 @@ func (c *Client) FetchPreviousTransactionHash(ctx context.Context, currentTx *Tr
 //   - An error if the request fails.
 func (c *Client) IsContract(ctx context.Context, address Address) (bool, error) {
 	if c.apiKey == "" {
-		return false, errors.New("ETHERSCAN_API_KEY environment variable is not set")
+		return false, ErrNoAPIKey
 	}
 
-	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getCode&address=%s&tag=latest&apikey=%s", c.baseURL, c.chainID, address, c.apiKey)
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getCode&address=%s&tag=latest&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, address, c.apiKey)
 
 	proxyResp, err := doRequest[string](ctx, c, url)
 	if err != nil {
@@ -266,6 +821,109 @@ func (c *Client) IsContract(ctx context.Context, address Address) (bool, error)
 	return proxyResp.Result != "0x" && proxyResp.Result != "" && proxyResp.Result != "null", nil
 }
 
+// Call executes a read-only message call against a contract at the latest block.
+// Parameters:
+//   - ctx: The context for the request.
+//   - to: The contract address to call.
+//   - data: The ABI-encoded call data (hex with "0x" prefix).
+//
+// Returns:
+//   - The raw hex result of the call.
+//   - An error if the request fails or the call reverts.
+func (c *Client) Call(ctx context.Context, to Address, data string) (string, error) {
+	return c.callAt(ctx, to, data, "latest")
+}
+
+// callAt executes a read-only message call against a contract at the given block tag.
+func (c *Client) callAt(ctx context.Context, to Address, data, tag string) (string, error) {
+	return c.callMsg(ctx, callMessage{to: to, data: data}, tag)
+}
+
+// callMessage is the message of an eth_call. Empty optional fields are left out of the request.
+type callMessage struct {
+	from  Address
+	to    Address
+	value string // hex, optional
+	gas   string // hex, optional
+	data  string
+}
+
+// callMsg executes a read-only message call at the given block tag.
+func (c *Client) callMsg(ctx context.Context, msg callMessage, tag string) (string, error) {
+	if c.apiKey == "" {
+		return "", ErrNoAPIKey
+	}
+
+	var optional strings.Builder
+	if msg.from != "" {
+		fmt.Fprintf(&optional, "&from=%s", msg.from)
+	}
+	if msg.value != "" {
+		fmt.Fprintf(&optional, "&value=%s", msg.value)
+	}
+	if msg.gas != "" {
+		fmt.Fprintf(&optional, "&gas=%s", msg.gas)
+	}
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_call&to=%s&data=%s%s&tag=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, msg.to, msg.data, optional.String(), tag, c.apiKey)
+
+	proxyResp, err := doRequest[string](ctx, c, url)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(proxyResp.Result, "0x") {
+		return "", newAPIError(proxyResp.Result)
+	}
+
+	return proxyResp.Result, nil
+}
+
+// ErrReplayNotReverted is returned by FetchRevertReason when the replayed call succeeds,
+// so the original revert can't be reproduced and its reason is unknown.
+var ErrReplayNotReverted = errors.New("replay did not revert")
+
+// FetchRevertReason replays a failed transaction with eth_call against the state
+// before its block and returns the decoded revert reason. The replay carries the
+// transaction's sender, value, gas limit and input, but not the state changes of
+// earlier transactions in the same block, so reasons that depend on them may differ.
+// Parameters:
+//   - ctx: The context for the request.
+//   - tx: The failed transaction, with its block number, value and gas limit in hex or decimal.
+//
+// Returns:
+//   - The revert reason, or "" if the replay reverted without a reason string.
+//   - An error if the transaction can't be replayed, the replay request fails,
+//     or the replay doesn't revert (ErrReplayNotReverted).
+func (c *Client) FetchRevertReason(ctx context.Context, tx *Transaction) (string, error) {
+	block := stringToBigInt(tx.BlockNumber)
+	if tx.To == "" || block == nil || block.Sign() <= 0 {
+		return "", errors.New("invalid transaction for revert replay")
+	}
+
+	msg := callMessage{from: tx.From, to: tx.To, data: cmp.Or(tx.Input, "0x")}
+	if v := stringToBigInt(cmp.Or(tx.ValueWei, tx.Value)); v != nil && v.Sign() > 0 {
+		msg.value = fmt.Sprintf("0x%x", v)
+	}
+	if g := stringToBigInt(tx.Gas); g != nil && g.Sign() > 0 {
+		msg.gas = fmt.Sprintf("0x%x", g)
+	}
+	tag := fmt.Sprintf("0x%x", new(big.Int).Sub(block, big.NewInt(1)))
+
+	result, err := c.callMsg(ctx, msg, tag)
+	if err != nil {
+		if reason, ok := parseRevertMessage(err.Error()); ok {
+			return reason, nil
+		}
+		return "", err
+	}
+
+	// Some nodes return the revert data as the result rather than an error
+	if !strings.HasPrefix(result, selectorError) {
+		return "", ErrReplayNotReverted
+	}
+	return decodeRevertData(result), nil
+}
+
 // FetchTransactionReceipt retrieves the receipt for a transaction by its hash.
 // Parameters:
 //   - ctx: The context for the request.
@@ -277,26 +935,69 @@ func (c *Client) IsContract(ctx context.Context, address Address) (bool, error)
 //   - The effective gas price (hex).
 //   - An error if the request fails.
 func (c *Client) FetchTransactionReceipt(ctx context.Context, hash Hash) (string, string, string, bool, error) {
+	receipt, err := c.fetchReceipt(ctx, hash)
+	if err != nil {
+		return "", "", "", false, err
+	}
+	return receiptFields(receipt)
+}
+
+// fetchReceipt retrieves the raw receipt for a transaction by its hash.
+// Parameters:
+//   - ctx: The context for the request.
+//   - hash: The transaction hash to fetch the receipt for.
+//
+// Returns:
+//   - The receipt, empty if the transaction hasn't been mined yet.
+//   - An error if the request fails or the receipt has an unexpected format.
+func (c *Client) fetchReceipt(ctx context.Context, hash Hash) (receiptResultData, error) {
 	if c.apiKey == "" {
-		return "", "", "", false, errors.New("ETHERSCAN_API_KEY environment variable is not set")
+		return receiptResultData{}, ErrNoAPIKey
 	}
 
-	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionReceipt&txhash=%s&apikey=%s", c.baseURL, c.chainID, hash, c.apiKey)
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionReceipt&txhash=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, hash, c.apiKey)
 
-	proxyResp, err := doRequest[receiptResultData](ctx, c, url)
+	rawResp, err := doRequest[json.RawMessage](ctx, c, url)
 	if err != nil {
-		return "", "", "", false, err
+		return receiptResultData{}, err
+	}
+
+	// No receipt means the transaction hasn't been mined yet
+	if isEmptyResult(rawResp.Result) {
+		return receiptResultData{}, nil
 	}
+	receipt, err := decodeResult[receiptResultData](rawResp.Result, "receipt")
+	if err != nil {
+		return receiptResultData{}, err
+	}
+	if receipt.Status == "" && receipt.Root == "" && receipt.GasUsed == "" {
+		return receiptResultData{}, errors.New("unexpected response format for receipt: no status or gasUsed")
+	}
+	return receipt, nil
+}
 
-	status, s, s2, s3, done, err2 := extractTransactionReceipt(proxyResp)
+// receiptFields returns the fields of a receipt that FetchTransactionReceipt reports.
+// Parameters:
+//   - receipt: The receipt returned by fetchReceipt.
+//
+// Returns:
+//   - The status of the transaction (e.g., "success", "failed").
+//   - The gas used by the transaction (hex).
+//   - The effective gas price (hex).
+//   - Whether the receipt is missing because the transaction is pending.
+//   - An error if extraction fails (currently always nil).
+func receiptFields(receipt receiptResultData) (string, string, string, bool, error) {
+	status, s, s2, s3, done, err := extractTransactionReceipt(&ProxyResponse[receiptResultData]{Result: receipt})
 	if done {
-		return s, s2, s3, done, err2
+		return s, s2, s3, done, err
 	}
 
-	return status, proxyResp.Result.GasUsed, proxyResp.Result.EffectiveGasPrice, false, nil
+	return status, receipt.GasUsed, receipt.EffectiveGasPrice, false, nil
 }
 
 // doRequest is a helper function that performs a generic Etherscan API request.
+// Requests for read-only actions are retried with backoff; any other action is
+// attempted once (see readActions).
 // Parameters:
 //   - c: The Etherscan client.
 //   - ctx: The context for the request.
@@ -306,7 +1007,18 @@ func (c *Client) FetchTransactionReceipt(ctx context.Context, hash Hash) (string
 //   - A pointer to the generic ProxyResponse[T] struct.
 //   - An error if the request or unmarshaling fails.
 func doRequest[T any](ctx context.Context, c *Client, url string) (*ProxyResponse[T], error) {
-	body, err := c.doRequestWithRetry(ctx, url)
+	// Every API call comes through here, so this is the one place to refuse them
+	if c.offline {
+		return nil, fmt.Errorf("%w (%s)", ErrOfflineMode, actionFromURL(url))
+	}
+	c.netMu.RLock()
+	chainErr := c.chainErr
+	c.netMu.RUnlock()
+	if chainErr != nil {
+		return nil, chainErr
+	}
+	url, id := c.tagRequest(url)
+	body, err := c.doRequestWithRetry(ctx, url, isIdempotent(actionFromURL(url)))
 	if err != nil {
 		return nil, err
 	}
@@ -316,6 +1028,10 @@ func doRequest[T any](ctx context.Context, c *Client, url string) (*ProxyRespons
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	if err := c.checkResponseID(id, proxyResp.ID); err != nil {
+		return nil, err
+	}
+
 	if proxyResp.Error != nil {
 		return nil, errors.New(proxyResp.Error.Message)
 	}
@@ -2,17 +2,28 @@
 package etherscan
 
 import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
+// testHash is a well-formed transaction hash for requests answered by mock servers.
+const testHash Hash = "0x00000000000000000000000000000000000000000000000000000000000000ab"
+
 func TestFetchTransaction_MockAPI(t *testing.T) {
 	tests := []struct {
 		name         string
 		responseBody string
 		expectedErr  string
+		expectedIs   error // sentinel the error must match, if any
 		expectedHash string
 	}{
 		{
@@ -29,6 +40,19 @@ func TestFetchTransaction_MockAPI(t *testing.T) {
 			name:         "Rate Limit Error (String Result)",
 			responseBody: `{"jsonrpc":"2.0","id":1,"result":"Max rate limit reached"}`,
 			expectedErr:  "Etherscan API error: Max rate limit reached",
+			expectedIs:   ErrRateLimited,
+		},
+		{
+			name:         "Daily Quota (String Result)",
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":"Max daily rate limit reached. 100000 (100%) of daily limit used"}`,
+			expectedErr:  "daily API quota exceeded",
+			expectedIs:   ErrQuotaExceeded,
+		},
+		{
+			name:         "Invalid API Key (String Result)",
+			responseBody: `{"status":"0","message":"NOTOK","result":"Invalid API Key"}`,
+			expectedErr:  "Etherscan API error: Invalid API Key",
+			expectedIs:   ErrInvalidAPIKey,
 		},
 		{
 			name:         "Explicit Error Object",
@@ -39,11 +63,13 @@ func TestFetchTransaction_MockAPI(t *testing.T) {
 			name:         "Empty Result",
 			responseBody: `{"jsonrpc":"2.0","id":1,"result":null}`,
 			expectedErr:  "transaction not found or invalid response",
+			expectedIs:   ErrTransactionNotFound,
 		},
 		{
 			name:         "Hash Not Found Error (String Result)",
 			responseBody: `{"jsonrpc":"2.0","id":1,"result":"Error! Transaction hash not found"}`,
 			expectedErr:  "Etherscan API error: Error! Transaction hash not found (Is the hash on the correct network?)",
+			expectedIs:   ErrWrongNetwork,
 		},
 		{
 			name:         "Success Repro Sepolia",
@@ -78,10 +104,10 @@ func TestFetchTransaction_MockAPI(t *testing.T) {
 			server := httptest.NewServer(mockHandler)
 			defer server.Close()
 
-			client := NewClient("test-api-key")
-			client.baseURL = server.URL
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetRetryPolicy(defaultMaxRetries, time.Millisecond)
 
-			tx, err := client.FetchTransaction(t.Context(), Hash("0xabc"))
+			tx, err := client.FetchTransaction(t.Context(), testHash)
 
 			if tt.expectedErr != "" {
 				if err == nil {
@@ -90,6 +116,9 @@ func TestFetchTransaction_MockAPI(t *testing.T) {
 				if !strings.Contains(err.Error(), tt.expectedErr) {
 					t.Errorf("Expected error containing '%s', got '%v'", tt.expectedErr, err)
 				}
+				if tt.expectedIs != nil && !errors.Is(err, tt.expectedIs) {
+					t.Errorf("Expected error matching %v, got %v", tt.expectedIs, err)
+				}
 				return
 			}
 
@@ -132,11 +161,52 @@ func TestClient_ChainID(t *testing.T) {
 	}
 }
 
+func TestNewClient_Options(t *testing.T) {
+	var viaCustomClient atomic.Bool
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		viaCustomClient.Store(true)
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	client := NewClient("test",
+		WithBaseURL(server.URL),
+		WithTuning(FastTuning()),
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithTimeout(20*time.Second),
+		WithChainID(11155111),
+	)
+	if got := client.ChainID(); got != 11155111 {
+		t.Errorf("ChainID() = %d; want 11155111", got)
+	}
+	if got := client.Timeout("eth_getTransactionByHash"); got != 20*time.Second {
+		t.Errorf("Timeout() = %v; want 20s", got)
+	}
+	if got := client.Timeout("eth_blockNumber"); got != quickRequestTimeout {
+		t.Errorf("expected per-action timeouts to be kept, got %v", got)
+	}
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !viaCustomClient.Load() || server.Calls("eth_blockNumber") != 1 {
+		t.Error("expected the request to reach the base URL through the custom HTTP client")
+	}
+
+	// No options keeps the defaults
+	plain := NewClient("test", WithHTTPClient(nil), WithTimeout(0))
+	if plain.ChainID() != 1 || plain.baseURL != "https://api.etherscan.io/v2/api" || plain.http == nil ||
+		plain.Timeout("eth_getTransactionByHash") != defaultRequestTimeout {
+		t.Error("expected no-op options to keep the defaults")
+	}
+}
+
 func TestFetchTransactionReceipt(t *testing.T) {
 	tests := []struct {
-		name           string
-		responseBody   string
-		expectedStatus string
+		name            string
+		responseBody    string
+		expectedStatus  string
+		expectedPending bool
+		expectedErr     string
 	}{
 		{
 			name:           "Success",
@@ -149,9 +219,49 @@ func TestFetchTransactionReceipt(t *testing.T) {
 			expectedStatus: "failed",
 		},
 		{
-			name:           "Pending",
-			responseBody:   `{"jsonrpc":"2.0","id":1,"result":null}`,
-			expectedStatus: "Pending",
+			name:            "Pending",
+			responseBody:    `{"jsonrpc":"2.0","id":1,"result":null}`,
+			expectedStatus:  "Pending",
+			expectedPending: true,
+		},
+		{
+			name:            "PendingSpaced",
+			responseBody:    "{\n  \"jsonrpc\": \"2.0\",\n  \"id\": 1,\n  \"result\" : null\n}",
+			expectedStatus:  "Pending",
+			expectedPending: true,
+		},
+		{
+			name:            "PendingReordered",
+			responseBody:    `{"result":null,"id":1,"jsonrpc":"2.0"}`,
+			expectedStatus:  "Pending",
+			expectedPending: true,
+		},
+		{
+			name:            "PendingNoResult",
+			responseBody:    `{"jsonrpc":"2.0","id":1}`,
+			expectedStatus:  "Pending",
+			expectedPending: true,
+		},
+		{
+			name:            "PendingEmptyObject",
+			responseBody:    `{"jsonrpc":"2.0","id":1,"result":{ }}`,
+			expectedStatus:  "Pending",
+			expectedPending: true,
+		},
+		{
+			name:         "StringResult",
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":"Error! Invalid transaction hash"}`,
+			expectedErr:  "Etherscan API error: Error! Invalid transaction hash",
+		},
+		{
+			name:         "UnexpectedShape",
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":{"message":"NOTOK"}}`,
+			expectedErr:  "unexpected response format for receipt",
+		},
+		{
+			name:         "WrongType",
+			responseBody: `{"jsonrpc":"2.0","id":1,"result":[1,2,3]}`,
+			expectedErr:  "unexpected response format for receipt",
 		},
 	}
 
@@ -163,16 +273,1048 @@ func TestFetchTransactionReceipt(t *testing.T) {
 			}))
 			defer server.Close()
 
-			client := NewClient("test")
-			client.baseURL = server.URL
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
 
-			status, _, _, _, err := client.FetchTransactionReceipt(t.Context(), Hash("0xabc"))
+			status, _, _, pending, err := client.FetchTransactionReceipt(t.Context(), Hash("0xabc"))
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
 			if status != tt.expectedStatus {
 				t.Errorf("Expected status %s, got %s", tt.expectedStatus, status)
 			}
+			if pending != tt.expectedPending {
+				t.Errorf("Expected pending %v, got %v", tt.expectedPending, pending)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_Replaced(t *testing.T) {
+	tests := []struct {
+		name         string
+		check        bool
+		nonceContext bool
+		wantStatus   string
+		wantCalls    int
+	}{
+		// A plain lookup doesn't spend a call on the replacement check
+		{"Plain Lookup", false, false, "Pending", 0},
+		{"Pending Poll", true, false, "replaced", 1},
+		// The nonce context's transaction count is reused rather than fetched again
+		{"Pending Poll With Nonce Context", true, true, "replaced", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionByHash"] = etherscantest.TxPending
+			routes["eth_getTransactionReceipt"] = etherscantest.NullResult
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetNonceContext(tt.nonceContext)
+
+			ctx := t.Context()
+			if tt.check {
+				ctx = WithReplacementCheck(ctx)
+			}
+			tx, err := client.FetchTransaction(ctx, testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.Status != tt.wantStatus {
+				t.Errorf("Expected status %s, got %s", tt.wantStatus, tx.Status)
+			}
+			if n := server.Calls("eth_getTransactionCount"); n != tt.wantCalls {
+				t.Errorf("expected %d transaction count calls, got %d", tt.wantCalls, n)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_BlockGasLimit(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.BlockGasLimit != "30000000" {
+		t.Errorf("Expected block gas limit 30000000, got %q", tx.BlockGasLimit)
+	}
+
+	// Pending transactions have no block to take a gas limit from
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxPending
+	routes["eth_getTransactionReceipt"] = etherscantest.NullResult
+	pending := etherscantest.NewServer(t, routes)
+	client = NewClient("test", WithBaseURL(pending.URL), WithTuning(FastTuning()))
+
+	tx, err = client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.BlockGasLimit != "" {
+		t.Errorf("Expected no block gas limit for a pending transaction, got %q", tx.BlockGasLimit)
+	}
+}
+
+func TestFetchTransaction_Warnings(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tx.Warnings) != 0 {
+		t.Errorf("Expected no warnings, got %v", tx.Warnings)
+	}
+
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxApprove
+	routes["eth_getCode"] = etherscantest.ErrorReverted
+	warned := etherscantest.NewServer(t, routes)
+	client = NewClient("test", WithBaseURL(warned.URL), WithTuning(FastTuning()))
+
+	tx, err = client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"could not check recipient account: execution reverted",
+		"unlimited approval: 0x1111111254eeb25477b68fb85ed929f73a960582 can spend all of this token",
+	}
+	if strings.Join(tx.Warnings, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected warnings %q, got %q", expected, tx.Warnings)
+	}
+}
+
+func TestFetchTransaction_NonceContext(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.SenderTxCount != "" || server.Calls("eth_getTransactionCount") != 0 {
+		t.Errorf("Expected no sender transaction count by default, got %q", tx.SenderTxCount)
+	}
+
+	client.SetNonceContext(true)
+	tx, err = client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.SenderTxCount != "6" {
+		t.Errorf("Expected sender transaction count 6, got %q", tx.SenderTxCount)
+	}
+}
+
+func TestFetchTransaction_NetworkHint(t *testing.T) {
+	const hint = "(Is the hash on the correct network?)"
+
+	tests := []struct {
+		name      string
+		configure func(c *Client)
+		wantHint  bool
+	}{
+		{"Default Multi Chain", func(c *Client) {}, true},
+		{"Pinned Multi Chain", func(c *Client) { c.SetChains([]int{1, 137}) }, true},
+		{"Single Chain", func(c *Client) { c.SetChains([]int{1}) }, false},
+		{"Opted Out", func(c *Client) { c.SetNetworkHint(false) }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"Error! Transaction hash not found"}`)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			tt.configure(client)
+
+			_, err := client.FetchTransaction(t.Context(), testHash)
+			if err == nil || !strings.Contains(err.Error(), "Error! Transaction hash not found") {
+				t.Fatalf("expected not found error, got %v", err)
+			}
+			if got := strings.Contains(err.Error(), hint); got != tt.wantHint {
+				t.Errorf("expected hint %v, got error %q", tt.wantHint, err)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_ChainSwitchMidFetch(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	var client *Client
+
+	var mu sync.Mutex
+	chains := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+		mu.Lock()
+		chains[action] = r.URL.Query().Get("chainid")
+		mu.Unlock()
+		if action == "eth_getTransactionByHash" {
+			// The user switches networks after the main call, before the auxiliary ones
+			client.SetChainID(11155111)
+		}
+		w.Write(etherscantest.Fixture(t, routes[action])) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+	client = NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.ChainID() != 11155111 {
+		t.Fatalf("expected the switch to take effect, got chain %d", client.ChainID())
+	}
+	if tx.Status != "success" || tx.Timestamp == "" {
+		t.Errorf("expected auxiliary calls to complete, got status %q timestamp %q", tx.Status, tx.Timestamp)
+	}
+	for action, chainID := range chains {
+		if chainID != "1" {
+			t.Errorf("expected %s on chain 1, got chain %s", action, chainID)
+		}
+	}
+	if len(chains) < 3 {
+		t.Errorf("expected auxiliary calls, got only %v", chains)
+	}
+}
+
+func TestFetchTransaction_Concurrent(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetNonceContext(true)
+	client.SetENSNames(true)
+
+	const workers = 32
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := range workers {
+		wg.Go(func() {
+			// Half the goroutines switch networks while the others fetch
+			if i%2 == 0 {
+				client.SetChainID([]int{1, 11155111}[i%4/2])
+				_ = client.NewSnapshot(&Transaction{}, time.Now())
+				return
+			}
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err == nil && tx.Status != "success" {
+				err = errors.New("unexpected status " + tx.Status)
+			}
+			errs <- err
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestFetchTransaction_StaleLatestBlock(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_blockNumber"] = etherscantest.BlockNumberStale
+	server := etherscantest.NewServer(t, routes)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Confirmations != "1" {
+		t.Errorf("Expected a mined transaction to have at least 1 confirmation, got %q", tx.Confirmations)
+	}
+	expected := "latest block 10 is behind the transaction's block 11; confirmations may be undercounted"
+	if !slices.Contains(tx.Warnings, expected) {
+		t.Errorf("Expected warning %q, got %q", expected, tx.Warnings)
+	}
+}
+
+func TestFetchTransaction_SkipAuxiliaryCalls(t *testing.T) {
+	tests := []struct {
+		name    string
+		disable func(c *Client)
+		action  string
+		check   func(tx *Transaction) bool
+	}{
+		{
+			name:    "Confirmations",
+			disable: func(c *Client) { c.SetFetchConfirmations(false) },
+			action:  "eth_blockNumber",
+			check:   func(tx *Transaction) bool { return tx.Confirmations == "" && !tx.Finalized },
+		},
+		{
+			name:    "Receipt",
+			disable: func(c *Client) { c.SetFetchReceipt(false) },
+			action:  "eth_getTransactionReceipt",
+			check: func(tx *Transaction) bool {
+				return tx.Status == "" && tx.GasUsed == "" && tx.TransactionFee == "" && tx.BurntFees == ""
+			},
+		},
+		{
+			name:    "Timestamp",
+			disable: func(c *Client) { c.SetFetchTimestamp(false) },
+			action:  "eth_getBlockByNumber",
+			check:   func(tx *Transaction) bool { return tx.Timestamp == "" && tx.BaseFeePerGas == "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			tt.disable(client)
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if calls := server.Calls(tt.action); calls != 0 {
+				t.Errorf("expected no %s calls, got %d", tt.action, calls)
+			}
+			if !tt.check(tx) {
+				t.Errorf("expected skipped fields to be empty, got %+v", tx)
+			}
+			if tx.Hash == "" || tx.Value == "" {
+				t.Errorf("expected core transaction fields, got %+v", tx)
+			}
+		})
+	}
+}
+
+func TestFetchReplacementTransactionHash(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseBody string
+		expectedHash string
+		expectedErr  string
+	}{
+		{
+			name:         "Found",
+			responseBody: `{"status":"1","message":"OK","result":[{"hash":"0xnew","from":"0xAAA","nonce":"5"},{"hash":"0xother","from":"0xaaa","nonce":"4"}]}`,
+			expectedHash: "0xnew",
+		},
+		{
+			name:         "Skips Original Hash",
+			responseBody: `{"status":"1","message":"OK","result":[{"hash":"0xabc","from":"0xaaa","nonce":"5"}]}`,
+			expectedErr:  "no mined transaction found for nonce 5",
+		},
+		{
+			name:         "API Error",
+			responseBody: `{"status":"0","message":"NOTOK","result":"Invalid API Key"}`,
+			expectedErr:  "Etherscan API error: Invalid API Key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.responseBody)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			hash, err := client.FetchReplacementTransactionHash(t.Context(), &Transaction{Hash: "0xabc", From: "0xaaa", Nonce: "5"})
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hash != tt.expectedHash {
+				t.Errorf("Expected hash %s, got %s", tt.expectedHash, hash)
+			}
 		})
 	}
 }
+
+func TestFetchTransactionHashByNonce(t *testing.T) {
+	const address = Address("0x00000000000000000000000000000000000000aa")
+	tests := []struct {
+		name         string
+		count        string
+		txlistBody   string
+		nonce        string
+		expectedHash string
+		expectedErr  string
+	}{
+		{
+			name:         "Found",
+			count:        "0x6",
+			txlistBody:   `{"status":"1","message":"OK","result":[{"hash":"0xin","from":"0xbbb","nonce":"5"},{"hash":"0xout","from":"0x00000000000000000000000000000000000000AA","nonce":"5"}]}`,
+			nonce:        "5",
+			expectedHash: "0xout",
+		},
+		{
+			name:        "Nonce Never Used",
+			count:       "0x6",
+			nonce:       "6",
+			expectedErr: "nonce 6 has not been used by 0x00000000000000000000000000000000000000aa (next nonce is 6)",
+		},
+		{
+			name:        "Beyond Txlist Cap",
+			count:       "0x1000",
+			txlistBody:  `{"status":"1","message":"OK","result":[{"hash":"0xout","from":"0x00000000000000000000000000000000000000aa","nonce":"0"}]}`,
+			nonce:       "2048",
+			expectedErr: "nonce 2048 not found in the first 1000 transactions",
+		},
+		{
+			name:        "Invalid Nonce",
+			nonce:       "abc",
+			expectedErr: "invalid address or nonce",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Query().Get("action") {
+				case "eth_getTransactionCount":
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + tt.count + `"}`)) // nolint:errcheck // mock server
+				case "txlist":
+					if r.URL.Query().Get("sort") != "asc" {
+						t.Errorf("expected an ascending txlist scan, got sort=%s", r.URL.Query().Get("sort"))
+					}
+					w.Write([]byte(tt.txlistBody)) // nolint:errcheck // mock server
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			hash, err := client.FetchTransactionHashByNonce(t.Context(), address, tt.nonce)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hash != tt.expectedHash {
+				t.Errorf("Expected hash %s, got %s", tt.expectedHash, hash)
+			}
+		})
+	}
+}
+
+func TestFetchTransactionHashByBlockAndIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		block        string
+		index        int
+		body         string
+		wantQuery    string
+		expectedHash string
+		expectedErr  string
+	}{
+		{
+			name:         "Found",
+			block:        "19000000",
+			index:        2,
+			body:         `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc","transactionIndex":"0x2"}}`,
+			wantQuery:    "tag=0x121eac0&index=0x2",
+			expectedHash: "0xabc",
+		},
+		{
+			name:         "Hex Block",
+			block:        "0x10",
+			index:        0,
+			body:         `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xdef"}}`,
+			wantQuery:    "tag=0x10&index=0x0",
+			expectedHash: "0xdef",
+		},
+		{
+			name:        "Past End Of Block",
+			block:       "16",
+			index:       180,
+			body:        `{"jsonrpc":"2.0","id":1,"result":null}`,
+			expectedErr: "block 16 has no transaction at index 180",
+		},
+		{
+			name:        "Invalid Block",
+			block:       "pending",
+			expectedErr: "invalid block position",
+		},
+		{
+			name:        "Negative Index",
+			block:       "16",
+			index:       -1,
+			expectedErr: "invalid block position",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("action") != "eth_getTransactionByBlockNumberAndIndex" {
+					t.Errorf("unexpected action %s", r.URL.Query().Get("action"))
+				}
+				if tt.wantQuery != "" && !strings.Contains(r.URL.RawQuery, tt.wantQuery) {
+					t.Errorf("expected query to contain %s, got %s", tt.wantQuery, r.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.body)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			hash, err := client.FetchTransactionHashByBlockAndIndex(t.Context(), tt.block, tt.index)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if hash != tt.expectedHash {
+				t.Errorf("Expected hash %s, got %s", tt.expectedHash, hash)
+			}
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	if err := client.Ping(t.Context()); err != nil {
+		t.Errorf("expected reachable server, got %v", err)
+	}
+
+	server.Close()
+
+	err := client.Ping(t.Context())
+	if _, ok := errors.AsType[*NetworkError](err); !ok {
+		t.Errorf("expected *NetworkError, got %v", err)
+	}
+}
+
+func TestOfflineMode(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetOffline(true)
+
+	_, err := client.FetchTransaction(t.Context(), testHash)
+	if !errors.Is(err, ErrOfflineMode) {
+		t.Errorf("FetchTransaction() error = %v; want ErrOfflineMode", err)
+	}
+	if _, ok := errors.AsType[*NetworkError](err); ok {
+		t.Errorf("expected an offline error not to be a network error, got %v", err)
+	}
+	if err := client.Ping(t.Context()); !errors.Is(err, ErrOfflineMode) {
+		t.Errorf("Ping() error = %v; want ErrOfflineMode", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("expected no requests in offline mode, got %d", n)
+	}
+}
+
+func TestClient_SetNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("chainid") != "424242" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"wrong chain"}}`)) // nolint:errcheck // mock server
+			return
+		}
+		switch r.URL.Query().Get("action") {
+		case "eth_getTransactionByHash":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc","blockNumber":"0x0","value":"0x1e8480","gasPrice":"0x3e8"}}`)) // nolint:errcheck // mock server
+		case "eth_getTransactionReceipt":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"status":"0x1","gasUsed":"0x3e8"}}`)) // nolint:errcheck // mock server
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)) // nolint:errcheck // mock server
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetNetwork(Network{ChainID: 424242, Name: "Six Decimals", NativeDecimals: 6})
+
+	if client.ChainID() != 424242 {
+		t.Errorf("Expected chain ID 424242, got %d", client.ChainID())
+	}
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tx.Value != "♦ 2 ETH" {
+		t.Errorf("Expected value '♦ 2 ETH', got '%s'", tx.Value)
+	}
+	if tx.TransactionFee != "1 ETH" {
+		t.Errorf("Expected fee '1 ETH', got '%s'", tx.TransactionFee)
+	}
+
+	// Switching by chain ID restores the default decimals
+	client.SetChainID(1)
+	if client.Network().NativeDecimals != 18 {
+		t.Errorf("Expected 18 decimals for Mainnet, got %d", client.Network().NativeDecimals)
+	}
+}
+
+func TestFetchBlockSummary(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	summary, err := client.FetchBlockSummary(t.Context(), "0xb")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := BlockSummary{Number: "11", Timestamp: "2024-02-20T20:12:48Z", TransactionCount: 2, BaseFeePerGas: "1"}
+	if *summary != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *summary)
+	}
+
+	// A string result carries the API's message rather than block data
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getBlockByNumber"] = etherscantest.TxNotFound
+	failing := etherscantest.NewServer(t, routes)
+	client = NewClient("test", WithBaseURL(failing.URL), WithTuning(FastTuning()))
+
+	_, err = client.FetchBlockSummary(t.Context(), "0xb")
+	if err == nil || !strings.Contains(err.Error(), "Etherscan API error: Error!") {
+		t.Errorf("Expected the API message as the error, got %v", err)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	start := time.Now()
+	if _, done, err := throttle(t.Context(), 0); done || err != nil {
+		t.Errorf("expected zero delay to continue, got done=%v err=%v", done, err)
+	}
+	if time.Since(start) > 100*time.Millisecond {
+		t.Error("expected zero delay to return immediately")
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	for _, delay := range []time.Duration{0, time.Second} {
+		if _, done, err := throttle(ctx, delay); !done || !errors.Is(err, context.Canceled) {
+			t.Errorf("throttle(cancelled, %v) = done %v, err %v; want done, context.Canceled", delay, done, err)
+		}
+	}
+}
+
+func TestClient_Tuning(t *testing.T) {
+	client := NewClient("test")
+	if client.Tuning() != DefaultTuning() {
+		t.Errorf("Expected default tuning, got %+v", client.Tuning())
+	}
+
+	client.SetTuning(FastTuning())
+	if client.Tuning().ArtificialDelay != 0 {
+		t.Errorf("Expected fast mode to disable the artificial delay, got %v", client.Tuning().ArtificialDelay)
+	}
+
+	for _, tt := range []struct{ delay, want time.Duration }{{time.Second, time.Second}, {0, 0}, {-time.Second, 0}} {
+		if got := NewClient("test", WithArtificialDelay(tt.delay)).Tuning().ArtificialDelay; got != tt.want {
+			t.Errorf("WithArtificialDelay(%v) set %v; want %v", tt.delay, got, tt.want)
+		}
+	}
+}
+
+func TestFetchTransaction_Blob(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxBlob
+	routes["eth_getTransactionReceipt"] = etherscantest.ReceiptBlob
+	server := etherscantest.NewServer(t, routes)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !IsBlobTransaction(tx) {
+		t.Errorf("expected a blob transaction, got type %q", tx.Type)
+	}
+
+	checks := []struct {
+		field, got, want string
+	}{
+		{"MaxFeePerBlobGas", tx.MaxFeePerBlobGas, "2"},
+		{"BlobGasUsed", tx.BlobGasUsed, "262144"},
+		{"BlobGasPrice", tx.BlobGasPrice, "1"},
+		{"BlobFee", tx.BlobFee, "0.000262144 ETH"},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %q; want %q", c.field, c.got, c.want)
+		}
+	}
+	if len(tx.BlobVersionedHashes) != 2 || tx.BlobVersionedHashes[0] != "0x01a5b1a5e3d8c2f4b6a7d9e0c1b2a3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0" {
+		t.Errorf("BlobVersionedHashes = %v; want the two hashes in order", tx.BlobVersionedHashes)
+	}
+
+	// Other transactions have none of the blob fields
+	routes = etherscantest.DefaultRoutes()
+	server = etherscantest.NewServer(t, routes)
+	plain, err := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning())).FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if IsBlobTransaction(plain) || plain.MaxFeePerBlobGas != "" || plain.BlobGasUsed != "" || plain.BlobFee != "" || plain.BlobVersionedHashes != nil {
+		t.Errorf("expected no blob fields for a non-blob transaction, got %+v", plain)
+	}
+}
+
+func TestFetchTransaction_PreEIP155(t *testing.T) {
+	// Modelled on the first mainnet transaction (block 46147): no type or chainId,
+	// v of 27/28, a receipt with a state root instead of a status, and a block
+	// without a base fee.
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxPreEIP155
+	routes["eth_getTransactionReceipt"] = etherscantest.ReceiptRoot
+	routes["eth_getBlockByNumber"] = etherscantest.BlockFrontier
+	routes["eth_blockNumber"] = etherscantest.BlockNumberMain
+	server := etherscantest.NewServer(t, routes)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), Hash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := []struct {
+		field, got, want string
+	}{
+		{"Status", tx.Status, "mined"},
+		{"Type", tx.Type, "0 (Legacy, pre-EIP-155)"},
+		{"BlockNumber", tx.BlockNumber, "46147"},
+		{"Timestamp", tx.Timestamp, "2015-08-07T03:30:33Z"},
+		{"ValueWei", tx.ValueWei, "31337"},
+		{"GasUsed", tx.GasUsed, "21000"},
+		{"TransactionFeeWei", tx.TransactionFeeWei, "1050000000000000000"},
+		{"Nonce", tx.Nonce, "0"},
+		{"BaseFeePerGas", tx.BaseFeePerGas, ""},
+		{"BurntFees", tx.BurntFees, ""},
+		{"Savings", tx.Savings, ""},
+		{"MaxFeePerGas", tx.MaxFeePerGas, ""},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s = %q; want %q", c.field, c.got, c.want)
+		}
+	}
+
+	if len(tx.Warnings) != 1 || !strings.Contains(tx.Warnings[0], "pre-Byzantium") {
+		t.Errorf("Expected a single pre-Byzantium warning, got %q", tx.Warnings)
+	}
+	if server.Calls("eth_call") != 0 {
+		t.Error("Expected no revert reason lookup for a pre-Byzantium transaction")
+	}
+}
+
+func TestFetchTransaction_RevertReplay(t *testing.T) {
+	tests := []struct {
+		name          string
+		call          string
+		wantReplayErr bool
+	}{
+		// A revert without a reason string is a known outcome
+		{"No Reason", etherscantest.ErrorReverted, false},
+		// A replay that fails is not the same as a revert without a reason
+		{"Replay Failed", etherscantest.NullResult, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionReceipt"] = etherscantest.ReceiptFailed
+			routes["eth_call"] = tt.call
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), Hash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.Status != "failed" || tx.RevertReason != "" {
+				t.Errorf("Status, RevertReason = %q, %q; want failed without a reason", tx.Status, tx.RevertReason)
+			}
+			if (tx.RevertReasonError != "") != tt.wantReplayErr {
+				t.Errorf("RevertReasonError = %q; want an error %v", tx.RevertReasonError, tt.wantReplayErr)
+			}
+			if strings.Contains(tx.RevertReasonError, "apikey=test") {
+				t.Errorf("RevertReasonError leaks the API key: %q", tx.RevertReasonError)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_Events(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionReceipt"] = etherscantest.ReceiptLogs
+	server := etherscantest.NewServer(t, routes)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []EventCount{{"Transfer", 3}, {"Approval", 1}, {"unknown", 1}}
+	if !slices.Equal(tx.Events, want) {
+		t.Errorf("Events = %v; want %v", tx.Events, want)
+	}
+	if got, want := FormatEvents(tx.Events), "Emitted 5 events (3 Transfer, 1 Approval, 1 unknown)"; got != want {
+		t.Errorf("FormatEvents() = %q; want %q", got, want)
+	}
+	if tx.Status != "success" || tx.GasUsed != "187500" {
+		t.Errorf("Status, GasUsed = %q, %q; want the receipt's", tx.Status, tx.GasUsed)
+	}
+}
+
+func TestFetchTransaction_ToAccountType(t *testing.T) {
+	tests := []struct {
+		name      string
+		tx        string
+		code      string
+		want      string
+		wantCalls int // eth_getCode requests
+	}{
+		{"EOA", etherscantest.TxSuccess, etherscantest.CodeEOA, "EOA", 1},
+		{"Contract", etherscantest.TxSuccess, etherscantest.CodeContract, "Smart Contract", 1},
+		{"Contract Creation", etherscantest.TxCreation, etherscantest.CodeContract, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionByHash"] = tt.tx
+			routes["eth_getCode"] = tt.code
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.ToAccountType != tt.want {
+				t.Errorf("ToAccountType = %q; want %q", tx.ToAccountType, tt.want)
+			}
+			if n := server.Calls("eth_getCode"); n != tt.wantCalls {
+				t.Errorf("expected %d eth_getCode requests, got %d", tt.wantCalls, n)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_ContractCreation(t *testing.T) {
+	tests := []struct {
+		name    string
+		tx      string
+		receipt string
+		want    Address
+	}{
+		{"Creation", etherscantest.TxCreation, etherscantest.ReceiptCreation, "0x5fbdb2315678afecb367f032d93f642f64180aa3"},
+		{"Creation Without Contract Address", etherscantest.TxCreation, etherscantest.ReceiptSuccess, ""},
+		{"Call Ignores Contract Address", etherscantest.TxSuccess, etherscantest.ReceiptCreation, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionByHash"] = tt.tx
+			routes["eth_getTransactionReceipt"] = tt.receipt
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.ContractAddress != tt.want {
+				t.Errorf("ContractAddress = %q; want %q", tx.ContractAddress, tt.want)
+			}
+			if tt.tx == etherscantest.TxCreation && (tx.To != "" || tx.ToAccountType != "" || server.Calls("eth_getCode") != 0) {
+				t.Errorf("expected no recipient lookup for a creation, got To %q, ToAccountType %q", tx.To, tx.ToAccountType)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_Method(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   string
+		want string
+	}{
+		{"Plain Transfer", etherscantest.TxSuccess, ""},
+		{"Contract Call", etherscantest.TxApprove, "approve(address,uint256)"},
+		{"Contract Creation", etherscantest.TxCreation, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionByHash"] = tt.tx
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.Method != tt.want {
+				t.Errorf("Method = %q; want %q", tx.Method, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_ConcurrentDetails(t *testing.T) {
+	tests := []struct {
+		name   string
+		failed string // action answered with an error
+		check  func(t *testing.T, tx *Transaction)
+	}{
+		{
+			name: "All Succeed",
+			check: func(t *testing.T, tx *Transaction) {
+				if tx.Confirmations == "" || tx.Status != "success" || tx.Timestamp == "" {
+					t.Errorf("expected every detail, got confirmations %q status %q timestamp %q", tx.Confirmations, tx.Status, tx.Timestamp)
+				}
+			},
+		},
+		{
+			name:   "Latest Block Fails",
+			failed: "eth_blockNumber",
+			check: func(t *testing.T, tx *Transaction) {
+				if !strings.Contains(tx.Confirmations, "execution reverted") || tx.Status != "success" || tx.Timestamp == "" {
+					t.Errorf("expected only the confirmations to fail, got confirmations %q status %q timestamp %q", tx.Confirmations, tx.Status, tx.Timestamp)
+				}
+			},
+		},
+		{
+			name:   "Receipt Fails",
+			failed: "eth_getTransactionReceipt",
+			check: func(t *testing.T, tx *Transaction) {
+				if tx.Status != "error" || tx.Confirmations == "" || tx.Timestamp == "" {
+					t.Errorf("expected only the receipt to fail, got confirmations %q status %q timestamp %q", tx.Confirmations, tx.Status, tx.Timestamp)
+				}
+			},
+		},
+		{
+			name:   "Block Fails",
+			failed: "eth_getBlockByNumber",
+			check: func(t *testing.T, tx *Transaction) {
+				if !strings.Contains(tx.Timestamp, "execution reverted") || tx.Status != "success" || tx.Confirmations == "" {
+					t.Errorf("expected only the block to fail, got confirmations %q status %q timestamp %q", tx.Confirmations, tx.Status, tx.Timestamp)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			if tt.failed != "" {
+				routes[tt.failed] = etherscantest.ErrorReverted
+			}
+			server := etherscantest.NewServer(t, routes)
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, tx)
+			for _, action := range []string{"eth_blockNumber", "eth_getTransactionReceipt", "eth_getBlockByNumber"} {
+				if n := server.Calls(action); n != 1 {
+					t.Errorf("expected 1 %s request, got %d", action, n)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_DetailsFetchedAtOnce(t *testing.T) {
+	var mu sync.Mutex
+	inFlight := map[string]bool{}
+	allStarted := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+		switch action {
+		case "eth_blockNumber", "eth_getTransactionReceipt", "eth_getBlockByNumber":
+			// Each detail request waits for the other two, so a sequential fetch would time out
+			mu.Lock()
+			inFlight[action] = true
+			if len(inFlight) == 3 {
+				close(allStarted)
+			}
+			mu.Unlock()
+			select {
+			case <-allStarted:
+			case <-time.After(2 * time.Second):
+				w.Write(etherscantest.Fixture(t, etherscantest.ErrorReverted)) // nolint:errcheck // mock server
+				return
+			}
+		}
+		name := etherscantest.DefaultRoutes()[action]
+		if name == "" {
+			name = etherscantest.NullResult
+		}
+		w.Write(etherscantest.Fixture(t, name)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), testHash)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tx.Warnings) != 0 || strings.Contains(tx.Confirmations, "reverted") {
+		t.Errorf("expected the detail requests to run at once, got confirmations %q warnings %v", tx.Confirmations, tx.Warnings)
+	}
+}
@@ -7,26 +7,53 @@ import (
 	"strings"
 )
 
-const (
-	weiInEth  = 1e18
-	weiInGwei = 1e9
-)
+// gweiDecimals is the number of decimals between Wei and Gwei.
+const gweiDecimals = 9
+
+// invalidAmount is shown in place of an amount whose hex can't be parsed as a non-negative number.
+const invalidAmount = "invalid"
+
+// pow10Table caches 10^n for every decimal count a native unit realistically uses,
+// since formatting runs for several fields on every lookup.
+var pow10Table = func() [37]*big.Int {
+	var t [37]*big.Int
+	t[0] = big.NewInt(1)
+	for i := 1; i < len(t); i++ {
+		t[i] = new(big.Int).Mul(t[i-1], big.NewInt(10))
+	}
+	return t
+}()
+
+// pow10 returns 10^n. Cached values are shared and must not be modified.
+func pow10(n int) *big.Int {
+	if n < len(pow10Table) {
+		return pow10Table[n]
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
 
 // stringToBigInt converts a hex (with "0x" prefix) or decimal string to a *big.Int.
+// Values come from the API, which never returns signed numbers, so anything other
+// than plain digits (including a leading "+" or "-") is rejected and yields nil.
 func stringToBigInt(s string) *big.Int {
 	if s == "" {
 		return nil
 	}
 	bi := new(big.Int)
 	base := 10
+	digits := "0123456789"
 	trimmed := s
-	if strings.HasPrefix(s, "0x") {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
 		base = 16
-		trimmed = strings.TrimPrefix(s, "0x")
+		digits = "0123456789abcdefABCDEF"
+		trimmed = s[2:]
 	}
 	if trimmed == "" && base == 16 {
 		return new(big.Int)
 	}
+	if trimmed == "" || strings.TrimLeft(trimmed, digits) != "" {
+		return nil
+	}
 
 	if _, ok := bi.SetString(trimmed, base); !ok {
 		return nil
@@ -34,50 +61,75 @@ func stringToBigInt(s string) *big.Int {
 	return bi
 }
 
-// weiToEth converts a big.Int Wei value to a big.Float ETH value.
-func weiToEth(wei *big.Int) *big.Float {
-	if wei == nil {
-		return new(big.Float)
+// formatUnits renders v divided by 10^decimals as an exact decimal string without trailing zeros.
+// Integer math is used throughout since Wei amounts routinely exceed float64's exact-integer range.
+func formatUnits(v *big.Int, decimals int) string {
+	if v == nil {
+		return "0"
+	}
+	if decimals <= 0 {
+		return v.String()
 	}
-	f := new(big.Float).SetInt(wei)
-	return f.Quo(f, big.NewFloat(weiInEth))
+
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(v), pow10(decimals), new(big.Int))
+
+	s := q.String()
+	if r.Sign() != 0 {
+		frac := r.String()
+		s += "." + strings.Repeat("0", decimals-len(frac)) + strings.TrimRight(frac, "0")
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
 }
 
-// weiToGwei converts a big.Int Wei value to a big.Float Gwei value.
-func weiToGwei(wei *big.Int) *big.Float {
-	if wei == nil {
-		return new(big.Float)
+// formatPercent renders part/whole as a percentage rounded half-up to two decimal places.
+// It returns false if whole is not positive.
+func formatPercent(part, whole *big.Int) (string, bool) {
+	if part == nil || whole == nil || whole.Sign() <= 0 {
+		return "", false
 	}
-	f := new(big.Float).SetInt(wei)
-	return f.Quo(f, big.NewFloat(weiInGwei))
+
+	// round(part * 10000 / whole) == (2 * part * 10000 + whole) / (2 * whole)
+	num := new(big.Int).Mul(part, big.NewInt(20000))
+	num.Add(num, whole)
+	den := new(big.Int).Mul(whole, big.NewInt(2))
+	hundredths := new(big.Int).Quo(num, den)
+
+	q, r := new(big.Int).QuoRem(hundredths, big.NewInt(100), new(big.Int))
+	return fmt.Sprintf("%s.%02d", q, r.Int64()), true
 }
 
-// hexToFloat converts a hex string to a big.Float using the given divisor.
-// Deprecated: use stringToBigInt and weiToEth/weiToGwei instead.
-func hexToFloat(hexStr string, val float64) (*big.Float, string, bool) {
+// hexToUnits converts a hex string to an exact decimal string in units with the given decimals.
+// Strings without a "0x" prefix are passed through as the fallback, since the API uses them for
+// placeholders, but malformed or negative hex falls back to "invalid" rather than a confusing number.
+// Returns:
+//   - The converted value.
+//   - A fallback string to display when conversion is not possible.
+//   - A boolean indicating whether the fallback should be used.
+func hexToUnits(hexStr string, decimals int) (string, string, bool) {
 	if hexStr == "" {
-		return nil, "", true
+		return "", "", true
 	}
 	if !strings.HasPrefix(hexStr, "0x") {
-		return nil, hexStr, true
+		return "", hexStr, true
 	}
 
 	bi := stringToBigInt(hexStr)
-	if bi == nil {
-		return nil, hexStr, true
+	if bi == nil || bi.Sign() < 0 {
+		return "", invalidAmount, true
 	}
 
 	if hexStr == "0x" {
-		return nil, "0 ETH", true
+		return "", "0 ETH", true
 	}
 
-	f := new(big.Float).SetInt(bi)
-	f.Quo(f, big.NewFloat(val))
-	return f, "", false
+	return formatUnits(bi, decimals), "", false
 }
 
-// calculateBurntFees calculates burnt fees in ETH given gas used and base fee.
-func calculateBurntFees(gasUsedHex, baseFeeHex string) string {
+// calculateBurntFees calculates burnt fees in ETH given gas used, base fee and native decimals.
+func calculateBurntFees(gasUsedHex, baseFeeHex string, decimals int) string {
 	gu := stringToBigInt(gasUsedHex)
 	bf := stringToBigInt(baseFeeHex)
 	if gu == nil || bf == nil {
@@ -85,13 +137,11 @@ func calculateBurntFees(gasUsedHex, baseFeeHex string) string {
 	}
 
 	burntWei := new(big.Int).Mul(gu, bf)
-	burntEth := weiToEth(burntWei)
-
-	return fmt.Sprintf("%s ETH 🔥", burntEth.Text('f', -1))
+	return fmt.Sprintf("%s ETH 🔥", formatUnits(burntWei, decimals))
 }
 
 // calculateSavings calculates the ETH saved when MaxFeePerGas exceeds EffectiveGasPrice.
-func calculateSavings(gasUsedHex, maxFeeHex, effectivePriceHex string) string {
+func calculateSavings(gasUsedHex, maxFeeHex, effectivePriceHex string, decimals int) string {
 	gu := stringToBigInt(gasUsedHex)
 	mf := stringToBigInt(maxFeeHex)
 	ep := stringToBigInt(effectivePriceHex)
@@ -106,9 +156,7 @@ func calculateSavings(gasUsedHex, maxFeeHex, effectivePriceHex string) string {
 	}
 
 	totalSavingsWei := new(big.Int).Mul(savingsPerGas, gu)
-	savingsEth := weiToEth(totalSavingsWei)
-
-	return fmt.Sprintf("%s ETH 💸", savingsEth.Text('f', -1))
+	return fmt.Sprintf("%s ETH 💸", formatUnits(totalSavingsWei, decimals))
 }
 
 // hexToDecimal converts a hex string to its decimal string representation.
@@ -121,23 +169,25 @@ func hexToDecimal(hexStr string) string {
 }
 
 // calculateConfirmations calculates the number of confirmations for a transaction block.
-func calculateConfirmations(latestBlock, txBlock string) string {
+// A mined transaction has at least one confirmation, so if the latest block lags
+// the transaction's block (a stale node behind the API), the count is clamped to
+// "1" and stale is true.
+func calculateConfirmations(latestBlock, txBlock string) (confirmations string, stale bool) {
 	if latestBlock == "" || txBlock == "" || txBlock == "0x0" {
-		return ""
+		return "", false
 	}
 
 	latest := stringToBigInt(latestBlock)
 	tx := stringToBigInt(txBlock)
 
 	if latest == nil || tx == nil {
-		return "error"
+		return "error", false
 	}
 
-	diff := new(big.Int).Sub(latest, tx)
-	if diff.Sign() < 0 {
-		return "0"
+	// Both values are freshly parsed, so compute in place rather than allocating per step
+	conf := latest.Sub(latest, tx)
+	if conf.Sign() < 0 {
+		return "1", true
 	}
-
-	conf := new(big.Int).Add(diff, big.NewInt(1))
-	return conf.String()
+	return conf.Add(conf, big.NewInt(1)).String(), false
 }
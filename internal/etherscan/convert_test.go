@@ -2,14 +2,15 @@ package etherscan
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 )
 
-func TestHexToFloat(t *testing.T) {
+func TestHexToUnits(t *testing.T) {
 	tests := []struct {
 		name       string
 		hex        string
-		divisor    float64
+		decimals   int
 		wantVal    string
 		wantBackup string
 		wantDone   bool
@@ -17,63 +18,79 @@ func TestHexToFloat(t *testing.T) {
 		{
 			name:       "Empty",
 			hex:        "",
-			divisor:    1e18,
+			decimals:   18,
 			wantBackup: "",
 			wantDone:   true,
 		},
 		{
 			name:       "NoPrefix",
 			hex:        "123",
-			divisor:    1e18,
+			decimals:   18,
 			wantBackup: "123",
 			wantDone:   true,
 		},
 		{
 			name:       "PrefixOnly",
 			hex:        "0x",
-			divisor:    1e18,
+			decimals:   18,
 			wantBackup: "0 ETH",
 			wantDone:   true,
 		},
 		{
 			name:     "ETH",
 			hex:      "0xde0b6b3a7640000", // 1e18
-			divisor:  1e18,
+			decimals: 18,
 			wantVal:  "1",
 			wantDone: false,
 		},
 		{
 			name:     "Gwei",
 			hex:      "0x3b9aca00", // 1e9
-			divisor:  1e9,
+			decimals: 9,
 			wantVal:  "1",
 			wantDone: false,
 		},
+		{
+			name:     "Beyond float64 precision",
+			hex:      "0x6765c793fa10079cffffffe", // 1999999999999999999999999998 Wei
+			decimals: 18,
+			wantVal:  "1999999999.999999999999999998",
+			wantDone: false,
+		},
 		{
 			name:       "InvalidHex",
 			hex:        "0xxyz",
-			divisor:    1e18,
-			wantBackup: "0xxyz",
+			decimals:   18,
+			wantBackup: "invalid",
+			wantDone:   true,
+		},
+		{
+			name:       "NegativeHex",
+			hex:        "0x-1",
+			decimals:   18,
+			wantBackup: "invalid",
+			wantDone:   true,
+		},
+		{
+			name:       "DoublePrefix",
+			hex:        "0x0x1",
+			decimals:   18,
+			wantBackup: "invalid",
 			wantDone:   true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotVal, gotBackup, gotDone := hexToFloat(tt.hex, tt.divisor)
+			gotVal, gotBackup, gotDone := hexToUnits(tt.hex, tt.decimals)
 			if gotDone != tt.wantDone {
-				t.Errorf("hexToFloat() gotDone = %v, want %v", gotDone, tt.wantDone)
+				t.Errorf("hexToUnits() gotDone = %v, want %v", gotDone, tt.wantDone)
 			}
 			if gotBackup != tt.wantBackup {
-				t.Errorf("hexToFloat() gotBackup = %v, want %v", gotBackup, tt.wantBackup)
+				t.Errorf("hexToUnits() gotBackup = %v, want %v", gotBackup, tt.wantBackup)
 			}
-			if gotVal != nil {
-				gotStr := gotVal.Text('f', -1)
-				if gotStr != tt.wantVal {
-					t.Errorf("hexToFloat() gotVal = %v, want %v", gotStr, tt.wantVal)
-				}
-			} else if tt.wantVal != "" {
-				t.Errorf("hexToFloat() gotVal = nil, want %v", tt.wantVal)
+			if gotVal != tt.wantVal {
+				t.Errorf("hexToUnits() gotVal = %v, want %v", gotVal, tt.wantVal)
 			}
 		})
 	}
@@ -103,29 +120,52 @@ func TestHexToDecimal(t *testing.T) {
 
 func TestCalculateConfirmations(t *testing.T) {
 	tests := []struct {
-		latest string
-		tx     string
-		want   string
+		latest    string
+		tx        string
+		want      string
+		wantStale bool
 	}{
-		{"10", "10", "1"},
-		{"0xa", "0xa", "1"},
-		{"10", "9", "2"},
-		{"0xa", "0x9", "2"},
-		{"10", "11", "0"},
-		{"", "10", ""},
-		{"10", "", ""},
-		{"10", "0x0", ""},
-		{"invalid", "10", "error"},
+		{"10", "10", "1", false},
+		{"0xa", "0xa", "1", false},
+		{"10", "9", "2", false},
+		{"0xa", "0x9", "2", false},
+		{"10", "11", "1", true},
+		{"0xa", "0xf", "1", true},
+		{"", "10", "", false},
+		{"10", "", "", false},
+		{"10", "0x0", "", false},
+		{"invalid", "10", "error", false},
 	}
 
 	for _, tt := range tests {
-		got := calculateConfirmations(tt.latest, tt.tx)
-		if got != tt.want {
-			t.Errorf("calculateConfirmations(%s, %s) = %s; want %s", tt.latest, tt.tx, got, tt.want)
+		got, stale := calculateConfirmations(tt.latest, tt.tx)
+		if got != tt.want || stale != tt.wantStale {
+			t.Errorf("calculateConfirmations(%s, %s) = %s, %v; want %s, %v", tt.latest, tt.tx, got, stale, tt.want, tt.wantStale)
 		}
 	}
 }
 
+// hugeBlock is a block number far beyond any real chain, to catch costs that grow with the number of digits.
+var hugeBlock = "0x" + strings.Repeat("f", 4096)
+
+func BenchmarkCalculateConfirmations(b *testing.B) {
+	cases := []struct {
+		name   string
+		latest string
+		tx     string
+	}{
+		{"Typical", "0x1260a48", "0x1260a08"},
+		{"Huge", hugeBlock, "0x1"},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			for b.Loop() {
+				calculateConfirmations(c.latest, c.tx)
+			}
+		})
+	}
+}
+
 func TestStringToBigInt(t *testing.T) {
 	tests := []struct {
 		s    string
@@ -134,7 +174,15 @@ func TestStringToBigInt(t *testing.T) {
 		{"10", big.NewInt(10)},
 		{"0xa", big.NewInt(10)},
 		{"0x10", big.NewInt(16)},
+		{"0XFF", big.NewInt(255)},
+		{"0x00a", big.NewInt(10)},
 		{"invalid", nil},
+		{"0x-1", nil},
+		{"-5", nil},
+		{"+5", nil},
+		{"0x+1", nil},
+		{"0x0x1", nil},
+		{" 1", nil},
 	}
 
 	for _, tt := range tests {
@@ -163,7 +211,7 @@ func TestCalculateBurntFees(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := calculateBurntFees(tt.gasUsed, tt.baseFee)
+		got := calculateBurntFees(tt.gasUsed, tt.baseFee, 18)
 		if got != tt.expected {
 			t.Errorf("calculateBurntFees(%s, %s) = %s; want %s", tt.gasUsed, tt.baseFee, got, tt.expected)
 		}
@@ -190,9 +238,150 @@ func TestCalculateSavings(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := calculateSavings(tt.gasUsed, tt.maxFee, tt.effectivePrice)
+		got := calculateSavings(tt.gasUsed, tt.maxFee, tt.effectivePrice, 18)
 		if got != tt.expected {
 			t.Errorf("calculateSavings(%s, %s, %s) = %s; want %s", tt.gasUsed, tt.maxFee, tt.effectivePrice, got, tt.expected)
 		}
 	}
 }
+
+func TestFormatUnits(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	tests := []struct {
+		name     string
+		value    *big.Int
+		decimals int
+		expected string
+	}{
+		{"Nil", nil, 18, "0"},
+		{"Zero", big.NewInt(0), 18, "0"},
+		{"One Wei", big.NewInt(1), 18, "0.000000000000000001"},
+		{"One ETH", big.NewInt(1e18), 18, "1"},
+		{"Negative", big.NewInt(-1500000000), 9, "-1.5"},
+		{"No Decimals", big.NewInt(42), 0, "42"},
+		{
+			"Max Uint256",
+			maxUint256,
+			18,
+			"115792089237316195423570985008687907853269984665640564039457.584007913129639935",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatUnits(tt.value, tt.decimals); got != tt.expected {
+				t.Errorf("formatUnits(%v, %d) = %s; want %s", tt.value, tt.decimals, got, tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkFormatUnits(b *testing.B) {
+	cases := []struct {
+		name     string
+		v        *big.Int
+		decimals int
+	}{
+		{"Wei to ETH", stringToBigInt("0xde0b6b3a7640001"), defaultNativeDecimals},
+		{"Wei to Gwei", stringToBigInt("0x6fc23ac00"), gweiDecimals},
+		{"Huge", stringToBigInt(hugeBlock), defaultNativeDecimals},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			for b.Loop() {
+				formatUnits(c.v, c.decimals)
+			}
+		})
+	}
+}
+
+func TestFormatPercent(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		name     string
+		part     *big.Int
+		whole    *big.Int
+		expected string
+		ok       bool
+	}{
+		{"Half", big.NewInt(50000), big.NewInt(100000), "50.00", true},
+		{"Rounds Up", big.NewInt(2), big.NewInt(3), "66.67", true},
+		{"Rounds Down", big.NewInt(1), big.NewInt(3), "33.33", true},
+		{"Block Gas Limit", big.NewInt(29_999_999), big.NewInt(30_000_000), "100.00", true},
+		{"Beyond float64 precision", new(big.Int).Sub(huge, big.NewInt(1)), huge, "100.00", true},
+		{"Tiny Fraction", big.NewInt(1), huge, "0.00", true},
+		{"Zero Whole", big.NewInt(1), big.NewInt(0), "", false},
+		{"Nil", nil, big.NewInt(1), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := formatPercent(tt.part, tt.whole)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("formatPercent(%v, %v) = %q, %v; want %q, %v", tt.part, tt.whole, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+// fuzzSeeds are API-shaped strings plus the edge cases the parsers must survive.
+var fuzzSeeds = []string{
+	"", "0x", "0X", "0x0", "0x1", "0xabc", "0xABC", "0x123", "123", "0",
+	"0xzz", "0x-1", "-1", "+1", "0x+1", "0x0x1", "1e18", " 0x1", "0x1 ",
+	"0x" + strings.Repeat("f", 200), strings.Repeat("9", 200), "null", "Pending",
+}
+
+func FuzzStringToBigInt(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		bi := stringToBigInt(s)
+		if bi == nil {
+			return
+		}
+		if bi.Sign() < 0 {
+			t.Errorf("stringToBigInt(%q) = %v; want a non-negative value", s, bi)
+		}
+		// Any accepted value must survive a round trip through its canonical form
+		if s != "0x" && s != "0X" {
+			if again := stringToBigInt("0x" + bi.Text(16)); again == nil || again.Cmp(bi) != 0 {
+				t.Errorf("stringToBigInt(%q) = %v does not round-trip", s, bi)
+			}
+		}
+	})
+}
+
+func FuzzHexToDecimal(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got := hexToDecimal(s)
+		if got != s && strings.TrimLeft(got, "0123456789") != "" {
+			t.Errorf("hexToDecimal(%q) = %q; want the input or a decimal number", s, got)
+		}
+	})
+}
+
+func FuzzHexToUnits(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s, 18)
+		f.Add(s, 9)
+	}
+	f.Add("0x1", 0)
+	f.Add("0x1", -1)
+	f.Fuzz(func(t *testing.T, s string, decimals int) {
+		// Decimals come from the network registry, never the API, so keep them realistic
+		decimals = max(-1, min(decimals, 36))
+		value, _, done := hexToUnits(s, decimals)
+		if done {
+			return
+		}
+		if _, ok := new(big.Rat).SetString(value); !ok {
+			t.Errorf("hexToUnits(%q, %d) = %q; want a decimal number", s, decimals, value)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+// Package etherscan provides debug-mode request id correlation for proxy calls.
+package etherscan
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// SetDebugLogger enables debug mode, in which every request carries a unique
+// JSON-RPC id that is logged and checked against the response id.
+// Parameters:
+//   - l: The logger to write request ids to. Nil disables debug mode.
+func (c *Client) SetDebugLogger(l *log.Logger) {
+	c.debug = l
+}
+
+// tagRequest appends a fresh request id to url and logs it.
+// It returns the url unchanged and id 0 when debug mode is off.
+func (c *Client) tagRequest(url string) (string, int64) {
+	if c.debug == nil {
+		return url, 0
+	}
+	id := c.nextID.Add(1)
+	c.debug.Printf("etherscan: request id=%d action=%s", id, actionFromURL(url))
+	return fmt.Sprintf("%s&id=%d", url, id), id
+}
+
+// checkResponseID verifies that a response echoes the request id it was sent with.
+// Responses without an id (e.g., account module endpoints) are not checked.
+// A mismatch usually means a proxy returned a response meant for another request.
+func (c *Client) checkResponseID(id int64, raw json.RawMessage) error {
+	if c.debug == nil || len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	got := strings.Trim(string(raw), `"`)
+	c.debug.Printf("etherscan: response id=%s", got)
+	if got != strconv.FormatInt(id, 10) {
+		return fmt.Errorf("response id %s does not match request id %d", got, id)
+	}
+	return nil
+}
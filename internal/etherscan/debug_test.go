@@ -0,0 +1,80 @@
+package etherscan
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDebugRequestID(t *testing.T) {
+	tests := []struct {
+		name        string
+		responseID  func(requestID string) string
+		expectedErr string
+	}{
+		{
+			name:       "Matching Id",
+			responseID: func(id string) string { return id },
+		},
+		{
+			name:       "Matching String Id",
+			responseID: func(id string) string { return `"` + id + `"` },
+		},
+		{
+			name:        "Mismatched Id",
+			responseID:  func(string) string { return "999" },
+			expectedErr: "response id 999 does not match request id 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				id := tt.responseID(r.URL.Query().Get("id"))
+				w.Write([]byte(`{"jsonrpc":"2.0","id":` + id + `,"result":"0xb"}`)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			var logs bytes.Buffer
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetDebugLogger(log.New(&logs, "", 0))
+
+			_, err := client.FetchLatestBlockNumber(t.Context())
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("Expected error containing '%s', got %v", tt.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !strings.Contains(logs.String(), "request id=1 action=eth_blockNumber") {
+				t.Errorf("expected request id in debug log, got %q", logs.String())
+			}
+			if strings.Contains(logs.String(), "apikey") {
+				t.Errorf("debug log must not contain the API key, got %q", logs.String())
+			}
+		})
+	}
+}
+
+func TestDebugRequestID_Disabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("id") {
+			t.Errorf("expected no request id outside debug mode, got %s", r.URL.Query().Get("id"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":999,"result":"0xb"}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err != nil {
+		t.Fatalf("unexpected error outside debug mode: %v", err)
+	}
+}
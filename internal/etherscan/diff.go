@@ -0,0 +1,58 @@
+// Package etherscan provides field-by-field comparison of transactions.
+package etherscan
+
+// FieldComparison holds one field's display value on two transactions.
+type FieldComparison struct {
+	Name string
+	A    string
+	B    string
+}
+
+// Differs reports whether the field has different values on the two transactions.
+func (f FieldComparison) Differs() bool {
+	return f.A != f.B
+}
+
+// comparedFields lists the fields compared by CompareTransactions, in display order.
+var comparedFields = []struct {
+	name  string
+	value func(tx *Transaction) string
+}{
+	{"Status", func(tx *Transaction) string { return tx.Status }},
+	{"Type", func(tx *Transaction) string { return tx.Type }},
+	{"Block Number", func(tx *Transaction) string { return tx.BlockNumber }},
+	{"From", func(tx *Transaction) string { return string(tx.From) }},
+	{"To", func(tx *Transaction) string { return string(tx.To) }},
+	{"Value", func(tx *Transaction) string { return tx.Value }},
+	{"Gas Limit", func(tx *Transaction) string { return tx.Gas }},
+	{"Gas Usage", func(tx *Transaction) string { return tx.GasUsed }},
+	{"Gas Price", func(tx *Transaction) string { return tx.GasPrice }},
+	{"Transaction Fee", func(tx *Transaction) string { return tx.TransactionFee }},
+	{"Base Fee", func(tx *Transaction) string { return tx.BaseFeePerGas }},
+	{"Max Fee", func(tx *Transaction) string { return tx.MaxFeePerGas }},
+	{"Max Priority Fee", func(tx *Transaction) string { return tx.MaxPriorityFeePerGas }},
+	{"Burnt Fees", func(tx *Transaction) string { return tx.BurntFees }},
+	{"Nonce", func(tx *Transaction) string { return tx.Nonce }},
+}
+
+// CompareTransactions lines up the display values of two transactions field by field,
+// e.g. to see why one cost more than the other.
+// Parameters:
+//   - a: The first transaction. A nil transaction has empty values.
+//   - b: The second transaction. A nil transaction has empty values.
+//
+// Returns:
+//   - One FieldComparison per compared field, in display order.
+func CompareTransactions(a, b *Transaction) []FieldComparison {
+	fields := make([]FieldComparison, len(comparedFields))
+	for i, f := range comparedFields {
+		fields[i].Name = f.name
+		if a != nil {
+			fields[i].A = f.value(a)
+		}
+		if b != nil {
+			fields[i].B = f.value(b)
+		}
+	}
+	return fields
+}
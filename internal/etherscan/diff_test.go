@@ -0,0 +1,41 @@
+package etherscan
+
+import "testing"
+
+func TestCompareTransactions(t *testing.T) {
+	a := &Transaction{Status: "success", GasUsed: "21000", GasPrice: "1 Gwei", TransactionFee: "0.000021 ETH", From: "0xaaa"}
+	b := &Transaction{Status: "success", GasUsed: "50000", GasPrice: "2 Gwei", TransactionFee: "0.0001 ETH", From: "0xaaa"}
+
+	fields := CompareTransactions(a, b)
+	if len(fields) != len(comparedFields) {
+		t.Fatalf("expected %d fields, got %d", len(comparedFields), len(fields))
+	}
+
+	differs := map[string]bool{}
+	for _, f := range fields {
+		differs[f.Name] = f.Differs()
+	}
+	expected := map[string]bool{
+		"Status":          false,
+		"From":            false,
+		"Gas Usage":       true,
+		"Gas Price":       true,
+		"Transaction Fee": true,
+		"Nonce":           false,
+	}
+	for name, want := range expected {
+		if got, ok := differs[name]; !ok || got != want {
+			t.Errorf("%s: Differs() = %v (present %v); want %v", name, got, ok, want)
+		}
+	}
+
+	// A missing side compares as empty values
+	for _, f := range CompareTransactions(a, nil) {
+		if f.B != "" {
+			t.Errorf("%s: expected empty value for nil transaction, got %q", f.Name, f.B)
+		}
+		if f.Name == "Gas Usage" && f.A != "21000" {
+			t.Errorf("Gas Usage: A = %q; want 21000", f.A)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Package etherscan provides a hook for enriching fetched transactions with caller-supplied data.
+package etherscan
+
+import (
+	"context"
+	"strings"
+)
+
+// Enricher adds caller-supplied data to a fetched transaction, such as labels
+// for known addresses (see Transaction.SetLabel). A returned error is reported
+// as a warning on the transaction rather than failing the fetch.
+type Enricher func(ctx context.Context, tx *Transaction) error
+
+// SetEnricher sets a callback invoked on every transaction FetchTransaction
+// assembles. By default no enricher is set.
+// Parameters:
+//   - e: The enrichment callback, or nil to disable enrichment.
+func (c *Client) SetEnricher(e Enricher) {
+	c.enricher = e
+}
+
+// enrich runs the client's enricher on tx, turning errors and panics into warnings.
+// Parameters:
+//   - ctx: The context for the fetch.
+//   - tx: The assembled transaction to enrich.
+func (c *Client) enrich(ctx context.Context, tx *Transaction) {
+	if c.enricher == nil {
+		return
+	}
+
+	endStep := beginStep(ctx, stepEnrich)
+	defer endStep()
+
+	// Enrichers are user code, so a panic must not take down the TUI
+	defer func() {
+		if r := recover(); r != nil {
+			tx.AddWarning("enrichment failed: panic: %v", r)
+		}
+	}()
+
+	if err := c.enricher(ctx, tx); err != nil {
+		tx.AddWarning("enrichment failed: %v", err)
+	}
+}
+
+// SetLabel attaches a human-readable label to an address, e.g. "Uniswap V2: Router".
+// Addresses are matched case-insensitively.
+// Parameters:
+//   - addr: The address to label.
+//   - label: The label to show next to the address. An empty label removes it.
+func (tx *Transaction) SetLabel(addr Address, label string) {
+	key := Address(strings.ToLower(string(addr)))
+	if label == "" {
+		delete(tx.Labels, key)
+		return
+	}
+	if tx.Labels == nil {
+		tx.Labels = map[Address]string{}
+	}
+	tx.Labels[key] = label
+}
+
+// Label returns the label attached to an address, or "" if it has none.
+// Parameters:
+//   - addr: The address to look up.
+//
+// Returns:
+//   - The address's label.
+func (tx *Transaction) Label(addr Address) string {
+	return tx.Labels[Address(strings.ToLower(string(addr)))]
+}
+
+// AddressLabeler returns an Enricher that labels the sender, recipient and any
+// approved spender from a fixed address-to-label map. Addresses are matched
+// case-insensitively, so checksummed keys work.
+// Parameters:
+//   - labels: The labels to apply, keyed by address.
+//
+// Returns:
+//   - An Enricher applying the labels.
+func AddressLabeler(labels map[string]string) Enricher {
+	normalized := make(map[Address]string, len(labels))
+	for addr, label := range labels {
+		normalized[Address(strings.ToLower(addr))] = label
+	}
+
+	return func(_ context.Context, tx *Transaction) error {
+		addrs := []Address{tx.From, tx.To}
+		if approval, ok := DecodeApproval(tx.Input); ok {
+			addrs = append(addrs, approval.Spender)
+		}
+		for _, addr := range addrs {
+			if label, ok := normalized[Address(strings.ToLower(string(addr)))]; ok {
+				tx.SetLabel(addr, label)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFetchTransaction_Enricher(t *testing.T) {
+	tests := []struct {
+		name         string
+		enricher     Enricher
+		wantLabel    string
+		wantWarnings []string
+	}{
+		{
+			name: "no enricher",
+		},
+		{
+			name: "labels an address",
+			enricher: func(_ context.Context, tx *Transaction) error {
+				tx.SetLabel("0xBBB", "Known Recipient")
+				return nil
+			},
+			wantLabel: "Known Recipient",
+		},
+		{
+			name: "error becomes a warning",
+			enricher: func(_ context.Context, tx *Transaction) error {
+				tx.SetLabel(tx.To, "Partial")
+				return errors.New("label service unavailable")
+			},
+			wantLabel:    "Partial",
+			wantWarnings: []string{"enrichment failed: label service unavailable"},
+		},
+		{
+			name: "panic becomes a warning",
+			enricher: func(context.Context, *Transaction) error {
+				panic("boom")
+			},
+			wantWarnings: []string{"enrichment failed: panic: boom"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetEnricher(tt.enricher)
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tx.Label(tx.To); got != tt.wantLabel {
+				t.Errorf("Label(%s) = %q; want %q", tx.To, got, tt.wantLabel)
+			}
+			if !slices.Equal(tx.Warnings, tt.wantWarnings) {
+				t.Errorf("Warnings = %q; want %q", tx.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestTransactionLabels(t *testing.T) {
+	var tx Transaction
+	if got := tx.Label("0xabc"); got != "" {
+		t.Errorf("Label() on an unlabelled transaction = %q; want empty", got)
+	}
+
+	tx.SetLabel("0xABC", "Exchange")
+	if got := tx.Label("0xabc"); got != "Exchange" {
+		t.Errorf("Label() = %q; want case-insensitive match", got)
+	}
+
+	tx.SetLabel("0xabc", "")
+	if got := tx.Label("0xABC"); got != "" {
+		t.Errorf("Label() after removal = %q; want empty", got)
+	}
+}
+
+func TestAddressLabeler(t *testing.T) {
+	enrich := AddressLabeler(map[string]string{
+		"0x28C6c06298d514Db089934071355E5743bf21d60": "Binance Hot Wallet",
+		"0x1111111254EEB25477B68FB85ED929F73A960582": "1inch Router",
+		"0x0000000000000000000000000000000000000001": "Unused",
+	})
+
+	tx := &Transaction{
+		From: "0x28c6c06298d514db089934071355e5743bf21d60",
+		To:   "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+		// approve(0x1111111254eeb25477b68fb85ed929f73a960582, 1)
+		Input: "0x095ea7b30000000000000000000000001111111254eeb25477b68fb85ed929f73a9605820000000000000000000000000000000000000000000000000000000000000001",
+	}
+	if err := enrich(t.Context(), tx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		addr Address
+		want string
+	}{
+		{tx.From, "Binance Hot Wallet"},
+		{"0x28C6c06298d514Db089934071355E5743bf21d60", "Binance Hot Wallet"},
+		{tx.To, ""},
+		{"0x1111111254eeb25477b68fb85ed929f73a960582", "1inch Router"},
+	}
+	for _, tt := range tests {
+		if got := tx.Label(tt.addr); got != tt.want {
+			t.Errorf("Label(%s) = %q; want %q", tt.addr, got, tt.want)
+		}
+	}
+	if len(tx.Labels) != 2 {
+		t.Errorf("expected only addresses in the transaction to be labelled, got %v", tx.Labels)
+	}
+}
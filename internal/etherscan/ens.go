@@ -0,0 +1,206 @@
+// Package etherscan provides ENS name resolution built on eth_call.
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ensRegistry is the ENS registry contract on Mainnet.
+const ensRegistry Address = "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"
+
+// ENS function selectors (first 4 bytes of the keccak256 of the signature).
+const (
+	selectorResolver = "0x0178b8bf" // resolver(bytes32) on the registry
+	selectorAddr     = "0x3b3b57de" // addr(bytes32) on a resolver
+	selectorENSName  = "0x691f3431" // name(bytes32) on a reverse resolver
+)
+
+// zeroAddress is returned by ENS contracts for an unset resolver or address.
+const zeroAddress Address = "0x0000000000000000000000000000000000000000"
+
+// SetENSNames enables reverse resolving the sender's and recipient's ENS names
+// with each Mainnet transaction. It is off by default since each address costs
+// up to four extra API calls the first time it is seen.
+// Parameters:
+//   - enabled: Whether to resolve ENS names.
+func (c *Client) SetENSNames(enabled bool) {
+	c.ensNames = enabled
+}
+
+// ResolveENS returns the address an ENS name points to on the client's network.
+// Names are lowercased but not otherwise normalized.
+// Parameters:
+//   - ctx: The context for the requests.
+//   - name: The ENS name (e.g., "vitalik.eth").
+//
+// Returns:
+//   - The resolved address, or "" if the name has no resolver or address.
+//   - An error if a request fails.
+func (c *Client) ResolveENS(ctx context.Context, name string) (Address, error) {
+	ctx = c.withNetwork(ctx)
+	node := namehash(name)
+	resolver, err := c.ensResolver(ctx, node)
+	if err != nil || resolver == "" {
+		return "", err
+	}
+
+	result, err := c.Call(ctx, resolver, selectorAddr+node)
+	if err != nil {
+		return "", err
+	}
+	addr, ok := decodeAddressWord(strings.TrimPrefix(result, "0x"))
+	if !ok {
+		return "", fmt.Errorf("invalid addr result: %s", result)
+	}
+	if addr == zeroAddress {
+		return "", nil
+	}
+	return addr, nil
+}
+
+// ReverseResolveENS returns the primary ENS name of an address on Mainnet.
+// A reverse record can claim any name, so it is only returned if the name
+// resolves back to the address. Results are cached for the client's lifetime.
+// Parameters:
+//   - ctx: The context for the requests.
+//   - address: The address to look up.
+//
+// Returns:
+//   - The verified ENS name, or "" if there is none or the network doesn't support ENS.
+//   - An error if a request fails.
+func (c *Client) ReverseResolveENS(ctx context.Context, address Address) (string, error) {
+	ctx = c.withNetwork(ctx)
+	if !c.networkFor(ctx).ENS {
+		return "", nil
+	}
+	hexPart, ok := addressHex(string(address))
+	if !ok {
+		return "", fmt.Errorf("invalid address %q: want 40 hex digits", address)
+	}
+	key := Address(strings.ToLower(string(address)))
+
+	c.ensMu.Lock()
+	name, cached := c.ensCache[key]
+	c.ensMu.Unlock()
+	if cached {
+		return name, nil
+	}
+
+	name, err := c.reverseResolve(ctx, key, strings.ToLower(hexPart))
+	if err != nil {
+		return "", err
+	}
+
+	c.ensMu.Lock()
+	if c.ensCache == nil {
+		c.ensCache = map[Address]string{}
+	}
+	c.ensCache[key] = name
+	c.ensMu.Unlock()
+	return name, nil
+}
+
+// reverseResolve looks up and verifies the reverse record of an address without caching.
+func (c *Client) reverseResolve(ctx context.Context, address Address, hexPart string) (string, error) {
+	node := namehash(hexPart + ".addr.reverse")
+	resolver, err := c.ensResolver(ctx, node)
+	if err != nil || resolver == "" {
+		return "", err
+	}
+
+	result, err := c.Call(ctx, resolver, selectorENSName+node)
+	if err != nil {
+		return "", err
+	}
+	name, err := decodeString(result)
+	if err != nil || name == "" {
+		return "", err
+	}
+
+	forward, err := c.ResolveENS(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(string(forward), string(address)) {
+		return "", nil
+	}
+	return name, nil
+}
+
+// ensResolver returns the resolver set for a node in the registry, or "" if none is set.
+func (c *Client) ensResolver(ctx context.Context, node string) (Address, error) {
+	result, err := c.Call(ctx, ensRegistry, selectorResolver+node)
+	if err != nil {
+		return "", err
+	}
+	resolver, ok := decodeAddressWord(strings.TrimPrefix(result, "0x"))
+	if !ok {
+		return "", fmt.Errorf("invalid resolver result: %s", result)
+	}
+	if resolver == zeroAddress {
+		return "", nil
+	}
+	return resolver, nil
+}
+
+// annotateENS records the verified ENS names of the transaction's sender and recipient.
+// Lookups that fail are reported as a warning rather than failing the fetch.
+func (c *Client) annotateENS(ctx context.Context, tx *Transaction) {
+	endStep := beginStep(ctx, stepENS)
+	defer endStep()
+
+	for _, addr := range []Address{tx.From, tx.To} {
+		if addr == "" {
+			continue
+		}
+		name, err := c.ReverseResolveENS(ctx, addr)
+		if err != nil {
+			tx.AddWarning("could not resolve ENS name of %s: %v", addr, err)
+			continue
+		}
+		tx.SetENSName(addr, name)
+	}
+}
+
+// SetENSName records the primary ENS name of an address, matched case-insensitively.
+// Parameters:
+//   - addr: The address.
+//   - name: The ENS name. An empty name removes it.
+func (tx *Transaction) SetENSName(addr Address, name string) {
+	key := Address(strings.ToLower(string(addr)))
+	if name == "" {
+		delete(tx.ENSNames, key)
+		return
+	}
+	if tx.ENSNames == nil {
+		tx.ENSNames = map[Address]string{}
+	}
+	tx.ENSNames[key] = name
+}
+
+// ENSName returns the primary ENS name of an address, or "" if it has none.
+// Parameters:
+//   - addr: The address to look up.
+//
+// Returns:
+//   - The address's ENS name.
+func (tx *Transaction) ENSName(addr Address) string {
+	return tx.ENSNames[Address(strings.ToLower(string(addr)))]
+}
+
+// namehash computes the ENS namehash of a name as a 64-digit hex word without "0x".
+func namehash(name string) string {
+	node := make([]byte, 32)
+	if name == "" {
+		return hex.EncodeToString(node)
+	}
+
+	labels := strings.Split(strings.ToLower(name), ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = keccak256(node, keccak256([]byte(labels[i])))
+	}
+	return hex.EncodeToString(node)
+}
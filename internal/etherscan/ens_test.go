@@ -0,0 +1,127 @@
+package etherscan
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNamehash(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"eth", "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae"},
+		{"foo.eth", "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+		{"Foo.ETH", "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+	}
+
+	for _, tt := range tests {
+		if got := namehash(tt.name); got != tt.want {
+			t.Errorf("namehash(%q) = %s; want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestENSSelectors(t *testing.T) {
+	selectors := map[string]string{
+		"resolver(bytes32)": selectorResolver,
+		"addr(bytes32)":     selectorAddr,
+		"name(bytes32)":     selectorENSName,
+	}
+	for sig, selector := range selectors {
+		if got := "0x" + hex.EncodeToString(keccak256([]byte(sig))[:4]); got != selector {
+			t.Errorf("selector for %s = %s; want %s", sig, selector, got)
+		}
+	}
+}
+
+// ensServer mocks the registry and one resolver. reverseName is the name the
+// reverse record claims for owner, and forward is the address that name resolves to.
+func ensServer(owner Address, reverseName string, forward Address, calls *atomic.Int32) *httptest.Server {
+	const resolver = "0x4976fb03c32e5b8cfe2b6ccb31c09ba78ebaba41"
+	word := func(addr Address) string {
+		return "0x" + strings.Repeat("0", 24) + strings.TrimPrefix(strings.ToLower(string(addr)), "0x")
+	}
+	reverseNode := namehash(strings.TrimPrefix(strings.ToLower(string(owner)), "0x") + ".addr.reverse")
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		q := r.URL.Query()
+		to, data := strings.ToLower(q.Get("to")), q.Get("data")
+
+		result := word(zeroAddress)
+		switch {
+		case to == strings.ToLower(string(ensRegistry)) && strings.HasPrefix(data, selectorResolver):
+			result = word(resolver)
+		case to == resolver && data == selectorENSName+reverseNode:
+			// ABI-encoded string: offset, length, then the bytes padded to a whole word
+			encoded := hex.EncodeToString([]byte(reverseName))
+			result = fmt.Sprintf("0x%064x%064x%s%s", 32, len(reverseName), encoded, strings.Repeat("0", 64-len(encoded)%64))
+		case to == resolver && data == selectorAddr+namehash(reverseName):
+			result = word(forward)
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + result + `"}`)) // nolint:errcheck // mock server
+	}))
+}
+
+func TestReverseResolveENS(t *testing.T) {
+	const owner Address = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+	const other Address = "0x1111111111111111111111111111111111111111"
+
+	tests := []struct {
+		name    string
+		chainID int
+		reverse string
+		forward Address
+		want    string
+	}{
+		{"Verified", 1, "vitalik.eth", owner, "vitalik.eth"},
+		{"Forward Mismatch", 1, "vitalik.eth", other, ""},
+		{"No Reverse Record", 1, "", owner, ""},
+		{"Testnet Skipped", 11155111, "vitalik.eth", owner, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := ensServer(owner, tt.reverse, tt.forward, &calls)
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetChainID(tt.chainID)
+
+			got, err := client.ReverseResolveENS(t.Context(), owner)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ReverseResolveENS() = %q; want %q", got, tt.want)
+			}
+
+			// The result, including "no name", is cached
+			before := calls.Load()
+			again, _ := client.ReverseResolveENS(t.Context(), Address(strings.ToLower(string(owner))))
+			if again != got || calls.Load() != before {
+				t.Errorf("expected cached result %q without requests, got %q after %d requests", got, again, calls.Load()-before)
+			}
+		})
+	}
+}
+
+func TestTransaction_ENSName(t *testing.T) {
+	tx := &Transaction{From: "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"}
+	tx.SetENSName(tx.From, "vitalik.eth")
+	if got := tx.ENSName("0xd8da6bf26964af9d7eed9e03e53415d37aa96045"); got != "vitalik.eth" {
+		t.Errorf("ENSName() = %q; want vitalik.eth", got)
+	}
+	tx.SetENSName(tx.From, "")
+	if got := tx.ENSName(tx.From); got != "" {
+		t.Errorf("expected ENS name to be removed, got %q", got)
+	}
+}
@@ -0,0 +1,184 @@
+// Package etherscan provides ERC-20 contract read helpers built on eth_call.
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// ERC-20 function selectors (first 4 bytes of the keccak256 of the signature).
+const (
+	selectorName      = "0x06fdde03" // name()
+	selectorSymbol    = "0x95d89b41" // symbol()
+	selectorDecimals  = "0x313ce567" // decimals()
+	selectorBalanceOf = "0x70a08231" // balanceOf(address)
+	selectorError     = "0x08c379a0" // Error(string), the standard revert payload
+)
+
+// FetchTokenBalance returns the ERC-20 token balance of holder in the token's base units.
+// Parameters:
+//   - ctx: The context for the request.
+//   - token: The ERC-20 contract address.
+//   - holder: The address whose balance to read.
+//
+// Returns:
+//   - The raw balance (not adjusted for decimals).
+//   - An error if the call fails or returns malformed data.
+func (c *Client) FetchTokenBalance(ctx context.Context, token, holder Address) (*big.Int, error) {
+	arg, err := encodeAddress(holder)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Call(ctx, token, selectorBalanceOf+arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeUint256(result)
+}
+
+// FetchTokenDecimals returns the number of decimals an ERC-20 token uses.
+// Parameters:
+//   - ctx: The context for the request.
+//   - token: The ERC-20 contract address.
+//
+// Returns:
+//   - The token decimals.
+//   - An error if the call fails or returns malformed data.
+func (c *Client) FetchTokenDecimals(ctx context.Context, token Address) (int, error) {
+	result, err := c.Call(ctx, token, selectorDecimals)
+	if err != nil {
+		return 0, err
+	}
+
+	d, err := decodeUint256(result)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsInt64() || d.Int64() > 255 {
+		return 0, fmt.Errorf("invalid decimals: %s", d)
+	}
+
+	return int(d.Int64()), nil
+}
+
+// FetchTokenSymbol returns the symbol of an ERC-20 token.
+// Parameters:
+//   - ctx: The context for the request.
+//   - token: The ERC-20 contract address.
+//
+// Returns:
+//   - The token symbol.
+//   - An error if the call fails or returns malformed data.
+func (c *Client) FetchTokenSymbol(ctx context.Context, token Address) (string, error) {
+	result, err := c.Call(ctx, token, selectorSymbol)
+	if err != nil {
+		return "", err
+	}
+	return decodeString(result)
+}
+
+// FetchTokenName returns the name of an ERC-20 token.
+// Parameters:
+//   - ctx: The context for the request.
+//   - token: The ERC-20 contract address.
+//
+// Returns:
+//   - The token name.
+//   - An error if the call fails or returns malformed data.
+func (c *Client) FetchTokenName(ctx context.Context, token Address) (string, error) {
+	result, err := c.Call(ctx, token, selectorName)
+	if err != nil {
+		return "", err
+	}
+	return decodeString(result)
+}
+
+// encodeAddress ABI-encodes an address as a 32-byte left-padded hex word without "0x".
+func encodeAddress(addr Address) (string, error) {
+	a := strings.TrimPrefix(strings.ToLower(string(addr)), "0x")
+	if len(a) != 40 {
+		return "", fmt.Errorf("invalid address: %s", addr)
+	}
+	if _, err := hex.DecodeString(a); err != nil {
+		return "", fmt.Errorf("invalid address: %s", addr)
+	}
+	return strings.Repeat("0", 24) + a, nil
+}
+
+// decodeUint256 decodes the first 32-byte word of an ABI-encoded result.
+func decodeUint256(result string) (*big.Int, error) {
+	data := strings.TrimPrefix(result, "0x")
+	if len(data) < 64 {
+		return nil, fmt.Errorf("unexpected call result length: %d", len(data))
+	}
+	bi, ok := new(big.Int).SetString(data[:64], 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid call result: %s", result)
+	}
+	return bi, nil
+}
+
+// decodeString decodes an ABI-encoded dynamic string result.
+// Some older tokens return a fixed bytes32 instead, which is also accepted.
+func decodeString(result string) (string, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(result, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid call result: %w", err)
+	}
+
+	if len(b) == 32 {
+		s := strings.TrimRight(string(b), "\x00")
+		if !utf8.ValidString(s) {
+			return "", errors.New("invalid bytes32 string result")
+		}
+		return s, nil
+	}
+
+	if len(b) < 64 {
+		return "", fmt.Errorf("unexpected call result length: %d", len(b))
+	}
+
+	offset := new(big.Int).SetBytes(b[:32])
+	if offset.Cmp(big.NewInt(int64(len(b)-32))) > 0 {
+		return "", errors.New("string offset out of range")
+	}
+	start := int(offset.Int64())
+
+	length := new(big.Int).SetBytes(b[start : start+32])
+	if length.Cmp(big.NewInt(int64(len(b)-start-32))) > 0 {
+		return "", errors.New("string length out of range")
+	}
+	end := start + 32 + int(length.Int64())
+
+	return string(b[start+32 : end]), nil
+}
+
+// parseRevertMessage extracts the reason from a node's "execution reverted" error message.
+// It returns false if the message is not a revert.
+func parseRevertMessage(msg string) (string, bool) {
+	_, rest, ok := strings.Cut(msg, "execution reverted")
+	if !ok {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(rest, ":")), true
+}
+
+// decodeRevertData decodes standard Error(string) revert data, returning "" for anything else.
+func decodeRevertData(data string) string {
+	payload, ok := strings.CutPrefix(data, selectorError)
+	if !ok {
+		return ""
+	}
+	reason, err := decodeString("0x" + payload)
+	if err != nil {
+		return ""
+	}
+	return reason
+}
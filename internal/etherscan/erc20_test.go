@@ -0,0 +1,220 @@
+package etherscan
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// abiString is the ABI encoding of the dynamic string "USDC".
+const abiString = "0x" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000000000004" +
+	"5553444300000000000000000000000000000000000000000000000000000000"
+
+// hugeOffset and hugeLength carry a word of 0x7fffffffffffffff, which overflows an int64 once 32 is added to it.
+var (
+	hugeOffset = "0x" + strings.Repeat("0", 48) + "7fffffffffffffff" + strings.Repeat("0", 64)
+	hugeLength = "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		strings.Repeat("0", 48) + "7fffffffffffffff"
+)
+
+func TestDecodeString(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectedErr bool
+	}{
+		{"Dynamic String", abiString, "USDC", false},
+		{"Bytes32", "0x4d4b520000000000000000000000000000000000000000000000000000000000", "MKR", false},
+		{"Empty", "0x", "", true},
+		{"Invalid Hex", "0xzz", "", true},
+		{"Offset Out Of Range", "0x" + strings.Repeat("f", 128), "", true},
+		{"Huge Offset", hugeOffset, "", true},
+		{"Huge Length", hugeLength, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := decodeString(tt.input)
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("decodeString(%q) error = %v; expectedErr %v", tt.input, err, tt.expectedErr)
+			}
+			if result != tt.expected {
+				t.Errorf("decodeString(%q) = %q; want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func FuzzDecodeString(f *testing.F) {
+	for _, s := range []string{abiString, hugeOffset, hugeLength, "0x", "0x" + strings.Repeat("f", 128)} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		// Call results come straight from the contract, so any input must fail cleanly rather than panic
+		_, _ = decodeString(s)
+	})
+}
+
+func TestEncodeAddress(t *testing.T) {
+	got, err := encodeAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	if got != want {
+		t.Errorf("encodeAddress = %s; want %s", got, want)
+	}
+
+	if _, err := encodeAddress("0x123"); err == nil {
+		t.Error("expected error for short address")
+	}
+}
+
+func TestERC20Helpers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("action") != "eth_call" || r.URL.Query().Get("tag") != "latest" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock server
+			return
+		}
+		data := r.URL.Query().Get("data")
+		switch {
+		case strings.HasPrefix(data, selectorBalanceOf):
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x00000000000000000000000000000000000000000000000000000000000f4240"}`)) // nolint:errcheck // mock server
+		case data == selectorDecimals:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0000000000000000000000000000000000000000000000000000000000000006"}`)) // nolint:errcheck // mock server
+		case data == selectorSymbol:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + abiString + `"}`)) // nolint:errcheck // mock server
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`)) // nolint:errcheck // mock server
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	token := Address("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+
+	balance, err := client.FetchTokenBalance(t.Context(), token, "0x0000000000000000000000000000000000000001")
+	if err != nil {
+		t.Fatalf("FetchTokenBalance: unexpected error: %v", err)
+	}
+	if balance.String() != "1000000" {
+		t.Errorf("Expected balance 1000000, got %s", balance)
+	}
+
+	decimals, err := client.FetchTokenDecimals(t.Context(), token)
+	if err != nil {
+		t.Fatalf("FetchTokenDecimals: unexpected error: %v", err)
+	}
+	if decimals != 6 {
+		t.Errorf("Expected decimals 6, got %d", decimals)
+	}
+
+	symbol, err := client.FetchTokenSymbol(t.Context(), token)
+	if err != nil {
+		t.Fatalf("FetchTokenSymbol: unexpected error: %v", err)
+	}
+	if symbol != "USDC" {
+		t.Errorf("Expected symbol USDC, got %s", symbol)
+	}
+
+	if _, err := client.FetchTokenName(t.Context(), token); err == nil || !strings.Contains(err.Error(), "execution reverted") {
+		t.Errorf("Expected execution reverted error, got %v", err)
+	}
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+		ok       bool
+	}{
+		{"With Reason", "execution reverted: insufficient balance", "insufficient balance", true},
+		{"No Reason", "execution reverted", "", true},
+		{"Wrapped", "Etherscan API error: execution reverted: paused", "paused", true},
+		{"Not A Revert", "header not found", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, ok := parseRevertMessage(tt.message)
+			if reason != tt.expected || ok != tt.ok {
+				t.Errorf("parseRevertMessage(%q) = %q, %v; want %q, %v", tt.message, reason, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+
+	if got := decodeRevertData(selectorError + strings.TrimPrefix(abiString, "0x")); got != "USDC" {
+		t.Errorf("decodeRevertData(Error(string)) = %q; want USDC", got)
+	}
+	if got := decodeRevertData("0x12345678"); got != "" {
+		t.Errorf("decodeRevertData(custom error) = %q; want empty", got)
+	}
+}
+
+func TestFetchRevertReason(t *testing.T) {
+	const from Address = "0x28c6c06298d514db089934071355e5743bf21d60"
+	failed := &Transaction{
+		From:        from,
+		To:          "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		ValueWei:    "1000000000000000000",
+		Gas:         "21000",
+		Input:       "0xa9059cbb",
+		BlockNumber: "11",
+	}
+
+	tests := []struct {
+		name     string
+		tx       *Transaction
+		response string
+		expected string
+		wantErr  bool
+		errIs    error
+	}{
+		{"Reason", failed, `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted: insufficient balance"}}`, "insufficient balance", false, nil},
+		{"No Reason", failed, `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}}`, "", false, nil},
+		{"Reason As Result", failed, `{"jsonrpc":"2.0","id":1,"result":"` + selectorError + strings.TrimPrefix(abiString, "0x") + `"}`, "USDC", false, nil},
+		{"Replay Succeeded", failed, `{"jsonrpc":"2.0","id":1,"result":"0x"}`, "", true, ErrReplayNotReverted},
+		{"Replay Failed", failed, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`, "", true, nil},
+		{"Contract Creation", &Transaction{BlockNumber: "11"}, "", "", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				q := r.URL.Query()
+				// The replay must run against the state before the transaction's block, as its sender
+				want := map[string]string{"tag": "0xa", "from": string(from), "value": "0xde0b6b3a7640000", "gas": "0x5208", "data": "0xa9059cbb"}
+				for k, v := range want {
+					if q.Get(k) != v {
+						t.Errorf("expected %s=%s in the replay, got %q", k, v, q.Get(k))
+					}
+				}
+				w.Write([]byte(tt.response)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetRetryPolicy(0, 0)
+
+			reason, err := client.FetchRevertReason(t.Context(), tt.tx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FetchRevertReason() error = %v; wantErr %v", err, tt.wantErr)
+			}
+			if tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Errorf("FetchRevertReason() error = %v; want %v", err, tt.errIs)
+			}
+			if reason != tt.expected {
+				t.Errorf("FetchRevertReason() = %q; want %q", reason, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package etherscan defines typed errors returned by the Etherscan client.
+package etherscan
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrQuotaExceeded indicates that the API key's daily request quota is used up.
+// Unlike the per-second rate limit it is not retried, since it won't recover quickly.
+var ErrQuotaExceeded = errors.New("daily API quota exceeded; requests will fail until the quota resets")
+
+// ErrRateLimited indicates that the per-second rate limit was still hit after every retry.
+var ErrRateLimited = errors.New("rate limited by the Etherscan API")
+
+// ErrNoAPIKey indicates that no API key was configured, so no request was made.
+var ErrNoAPIKey = errors.New("ETHERSCAN_API_KEY environment variable is not set")
+
+// ErrInvalidAPIKey indicates that the API rejected the configured API key.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// ErrTransactionNotFound indicates that the current network has no transaction with the requested hash.
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrWrongNetwork indicates a transaction not found while other networks are in
+// use, so it may have been sent on one of them. Errors matching it also match
+// ErrTransactionNotFound.
+var ErrWrongNetwork = errors.New("transaction may be on another network")
+
+// ErrInvalidTxHash indicates that a transaction hash is malformed, so it was rejected
+// without asking the API.
+var ErrInvalidTxHash = errors.New("invalid transaction hash")
+
+// ErrUnsupportedChain indicates that a chain ID is not in the chain registry, so the client can't switch to it.
+var ErrUnsupportedChain = errors.New("unsupported chain")
+
+// ErrOfflineMode indicates that a request was refused because strict offline
+// mode is enabled. It is never a NetworkError, since no connection was attempted.
+var ErrOfflineMode = errors.New("offline mode: network requests are disabled")
+
+// NetworkError indicates that a request failed at the transport level
+// (e.g., DNS failure, connection refused, timeout) rather than being
+// rejected by the Etherscan API.
+type NetworkError struct {
+	Err error
+}
+
+// Error returns the error message.
+func (e *NetworkError) Error() string {
+	return "network error: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying transport error.
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorKind classifies a message returned by the Etherscan API.
+type ErrorKind int
+
+const (
+	// KindUnknown is any message not recognized below, including a bare "NOTOK".
+	KindUnknown ErrorKind = iota
+	// KindRateLimited is the per-second rate limit, which clears within seconds and is retried.
+	KindRateLimited
+	// KindQuotaExceeded is the daily quota, which won't recover soon and is not retried.
+	KindQuotaExceeded
+	// KindInvalidKey is a missing or rejected API key.
+	KindInvalidKey
+	// KindNotFound is a lookup the API reports as not found, e.g. an unknown transaction hash.
+	KindNotFound
+)
+
+// String returns the name of the kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case KindRateLimited:
+		return "rate limited"
+	case KindQuotaExceeded:
+		return "quota exceeded"
+	case KindInvalidKey:
+		return "invalid key"
+	case KindNotFound:
+		return "not found"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyAPIMessage classifies a message from the Etherscan API, such as the
+// result of a "NOTOK" response. The retry loop and APIError both rely on it, so
+// whether a message is retried and how it is typed always agree.
+// Parameters:
+//   - msg: The API message, e.g. "Max calls per sec rate limit reached (5/sec)".
+//
+// Returns:
+//   - The kind of the message, or KindUnknown if it isn't recognized.
+func ClassifyAPIMessage(msg string) ErrorKind {
+	lower := strings.ToLower(msg)
+	switch {
+	// Checked before the rate limit, since the daily quota message also mentions a rate limit
+	case strings.Contains(lower, "daily limit") || strings.Contains(lower, "daily rate limit"):
+		return KindQuotaExceeded
+	case strings.Contains(lower, "rate limit"):
+		return KindRateLimited
+	case strings.Contains(lower, "invalid api key") || strings.Contains(lower, "missing/invalid api key"):
+		return KindInvalidKey
+	case strings.HasPrefix(lower, "error!") && strings.Contains(lower, "not found"):
+		return KindNotFound
+	default:
+		return KindUnknown
+	}
+}
+
+// APIError is a message the Etherscan API returned in place of a result.
+// It matches ErrRateLimited, ErrQuotaExceeded, ErrInvalidAPIKey or
+// ErrTransactionNotFound with errors.Is when its kind does.
+type APIError struct {
+	Kind         ErrorKind
+	Message      string
+	Hint         string // optional advice shown after the message
+	WrongNetwork bool   // not found while other networks are in use; also matches ErrWrongNetwork
+}
+
+// newAPIError classifies an API message as an APIError.
+func newAPIError(msg string) *APIError {
+	return &APIError{Kind: ClassifyAPIMessage(msg), Message: msg}
+}
+
+// Error returns the error message.
+func (e *APIError) Error() string {
+	if e.Hint != "" {
+		return "Etherscan API error: " + e.Message + " (" + e.Hint + ")"
+	}
+	return "Etherscan API error: " + e.Message
+}
+
+// Is reports whether the error's kind corresponds to target.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrTransactionNotFound:
+		return e.Kind == KindNotFound
+	case ErrWrongNetwork:
+		return e.Kind == KindNotFound && e.WrongNetwork
+	case ErrRateLimited:
+		return e.Kind == KindRateLimited
+	case ErrQuotaExceeded:
+		return e.Kind == KindQuotaExceeded
+	case ErrInvalidAPIKey:
+		return e.Kind == KindInvalidKey
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestClassifyAPIMessage(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want ErrorKind
+	}{
+		{"Max rate limit reached", KindRateLimited},
+		{"Max calls per sec rate limit reached (5/sec)", KindRateLimited},
+		{"Max rate limit reached, please use API Key for higher rate limit", KindRateLimited},
+		{"Max daily rate limit reached. 100000 (100%) of daily limit used", KindQuotaExceeded},
+		{"Daily limit reached", KindQuotaExceeded},
+		{"Invalid API Key", KindInvalidKey},
+		{"Missing/Invalid API Key", KindInvalidKey},
+		{"Error! Transaction hash not found", KindNotFound},
+		{"Error! Block not found", KindNotFound},
+		{"Error! Invalid transaction hash", KindUnknown},
+		{"NOTOK", KindUnknown},
+		{"Query Timeout occured. Please select a smaller result dataset", KindUnknown},
+		{"", KindUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			if got := ClassifyAPIMessage(tt.msg); got != tt.want {
+				t.Errorf("ClassifyAPIMessage(%q) = %v; want %v", tt.msg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          *APIError
+		wantMessage  string
+		wantNotFound bool
+		wantQuota    bool
+	}{
+		{"NotFound", newAPIError("Error! Transaction hash not found"), "Etherscan API error: Error! Transaction hash not found", true, false},
+		{"Quota", newAPIError("Daily limit reached"), "Etherscan API error: Daily limit reached", false, true},
+		{"InvalidKey", newAPIError("Invalid API Key"), "Etherscan API error: Invalid API Key", false, false},
+		{"Hint", &APIError{Kind: KindNotFound, Message: "Error! Transaction hash not found", Hint: "check the network"}, "Etherscan API error: Error! Transaction hash not found (check the network)", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.wantMessage {
+				t.Errorf("Error() = %q; want %q", got, tt.wantMessage)
+			}
+			if got := errors.Is(tt.err, ErrTransactionNotFound); got != tt.wantNotFound {
+				t.Errorf("errors.Is(ErrTransactionNotFound) = %v; want %v", got, tt.wantNotFound)
+			}
+			if got := errors.Is(tt.err, ErrQuotaExceeded); got != tt.wantQuota {
+				t.Errorf("errors.Is(ErrQuotaExceeded) = %v; want %v", got, tt.wantQuota)
+			}
+		})
+	}
+}
+
+func TestAPIError_Sentinels(t *testing.T) {
+	sentinels := []error{ErrRateLimited, ErrQuotaExceeded, ErrInvalidAPIKey, ErrTransactionNotFound, ErrWrongNetwork}
+	tests := []struct {
+		name string
+		err  *APIError
+		want []error
+	}{
+		{"RateLimited", newAPIError("Max calls per sec rate limit reached (5/sec)"), []error{ErrRateLimited}},
+		{"Quota", newAPIError("Daily limit reached"), []error{ErrQuotaExceeded}},
+		{"InvalidKey", newAPIError("Missing/Invalid API Key"), []error{ErrInvalidAPIKey}},
+		{"NotFound", newAPIError("Error! Transaction hash not found"), []error{ErrTransactionNotFound}},
+		{"WrongNetwork", &APIError{Kind: KindNotFound, Message: "Error! Transaction hash not found", WrongNetwork: true}, []error{ErrTransactionNotFound, ErrWrongNetwork}},
+		{"Unknown", newAPIError("NOTOK"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, target := range sentinels {
+				if got, want := errors.Is(tt.err, target), slices.Contains(tt.want, target); got != want {
+					t.Errorf("errors.Is(%v) = %v; want %v", target, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_NoAPIKey(t *testing.T) {
+	_, err := NewClient("").FetchTransaction(t.Context(), testHash)
+	if !errors.Is(err, ErrNoAPIKey) {
+		t.Errorf("expected ErrNoAPIKey, got %v", err)
+	}
+}
+
+func TestFetchTransaction_NotFoundOnlyNetwork(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.Routes{"eth_getTransactionByHash": etherscantest.TxNotFound})
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetChains([]int{1})
+
+	_, err := client.FetchTransaction(t.Context(), testHash)
+	if !errors.Is(err, ErrTransactionNotFound) || errors.Is(err, ErrWrongNetwork) {
+		t.Errorf("expected a not found error without a wrong network, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// Package etherscantest provides canned Etherscan API responses and a mock server for tests.
+package etherscantest
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// Fixture names for the canned responses in testdata.
+const (
+	TxSuccess        = "tx_success"
+	TxApprove        = "tx_approve_unlimited" // ERC-20 approve with a max-uint256 amount
+	TxPending        = "tx_pending"
+	TxPreEIP155      = "tx_pre_eip155" // early mainnet transaction: no type, chainId or replay protection
+	TxNotFound       = "tx_not_found"
+	TxCreation       = "tx_contract_creation" // no recipient
+	TxBlob           = "tx_blob"              // EIP-4844 transaction carrying two blobs
+	ReceiptSuccess   = "receipt_success"
+	ReceiptFailed    = "receipt_failed"
+	ReceiptRoot      = "receipt_pre_byzantium"     // state root instead of a status field
+	ReceiptLogs      = "receipt_with_logs"         // five logs: three Transfers, an Approval and an unknown event
+	ReceiptTransfer  = "receipt_token_transfer"    // a single ERC-20 Transfer of 1 USDC
+	ReceiptBlob      = "receipt_blob"              // 262,144 blob gas used at 1 Gwei
+	ReceiptCreation  = "receipt_contract_creation" // deployed contract at 0x5fbdb2315678afecb367f032d93f642f64180aa3
+	Block            = "block"
+	BlockFrontier    = "block_frontier" // no baseFeePerGas
+	BlockNumber      = "block_number"
+	BlockNumberStale = "block_number_stale"   // one block behind TxSuccess, as from a lagging node
+	BlockNumberMain  = "block_number_mainnet" // a recent mainnet block, for historical transactions
+	CodeEOA          = "code_eoa"
+	CodeContract     = "code_contract"
+	TransactionCount = "transaction_count"
+	NullResult       = "null_result"
+	RateLimit        = "rate_limit"
+	ErrorReverted    = "error_reverted"
+	EtherPrice       = "eth_price"    // stats module ethprice result: $3,200.50
+	TxList           = "txlist"       // an address's two latest transactions: a transfer and a failed contract creation
+	TxListEmpty      = "txlist_empty" // an address without transactions
+)
+
+//go:embed testdata/*.json
+var fixtures embed.FS
+
+// Routes maps an API action (e.g., "eth_getTransactionByHash") to a fixture name.
+type Routes map[string]string
+
+// DefaultRoutes returns routes describing a successful, mined transaction sent to an EOA.
+func DefaultRoutes() Routes {
+	return Routes{
+		"eth_getTransactionByHash":  TxSuccess,
+		"eth_getTransactionReceipt": ReceiptSuccess,
+		"eth_getBlockByNumber":      Block,
+		"eth_blockNumber":           BlockNumber,
+		"eth_getCode":               CodeEOA,
+		"eth_getTransactionCount":   TransactionCount,
+	}
+}
+
+// Fixture returns the contents of the named fixture, failing the test if it does not exist.
+// Parameters:
+//   - t: The test using the fixture.
+//   - name: The fixture name without the .json extension.
+//
+// Returns:
+//   - The raw fixture bytes.
+func Fixture(t testing.TB, name string) []byte {
+	t.Helper()
+	b, err := fixtures.ReadFile("testdata/" + name + ".json")
+	if err != nil {
+		t.Fatalf("etherscantest: unknown fixture %q: %v", name, err)
+	}
+	return b
+}
+
+// Server is a mock Etherscan API that answers each request with the fixture routed to its action.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	routes Routes
+	calls  map[string]int
+}
+
+// NewServer starts a mock Etherscan API server that is closed when the test finishes.
+// Actions without a route respond with a null result.
+// Parameters:
+//   - t: The test owning the server.
+//   - routes: The action-to-fixture mapping.
+//
+// Returns:
+//   - The running Server.
+func NewServer(t testing.TB, routes Routes) *Server {
+	t.Helper()
+
+	// Resolve every fixture up front so a typo fails the test immediately
+	bodies := map[string][]byte{"": Fixture(t, NullResult)}
+	for _, name := range routes {
+		bodies[name] = Fixture(t, name)
+	}
+
+	s := &Server{routes: routes, calls: map[string]int{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+
+		s.mu.Lock()
+		s.calls[action]++
+		name := s.routes[action]
+		s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(bodies[name]) // nolint:errcheck // mock server
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Calls returns how many requests the server received for the given action.
+func (s *Server) Calls(action string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[action]
+}
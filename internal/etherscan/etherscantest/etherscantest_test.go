@@ -0,0 +1,57 @@
+package etherscantest
+
+import (
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFixturesAreValidJSON(t *testing.T) {
+	names, err := fs.Glob(fixtures, "testdata/*.json")
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	if len(names) == 0 {
+		t.Fatal("expected embedded fixtures")
+	}
+	for _, name := range names {
+		b, err := fixtures.ReadFile(name)
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		if !json.Valid(b) {
+			t.Errorf("fixture %s is not valid JSON", name)
+		}
+	}
+}
+
+func TestNewServer(t *testing.T) {
+	server := NewServer(t, Routes{"eth_blockNumber": BlockNumber})
+
+	get := func(action string) string {
+		t.Helper()
+		resp, err := http.Get(server.URL + "?action=" + action) // nolint:noctx // test helper
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close() // nolint:errcheck // test helper
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		return string(b)
+	}
+
+	if body := get("eth_blockNumber"); !strings.Contains(body, `"result":"0xb"`) {
+		t.Errorf("expected block number fixture, got %s", body)
+	}
+	if body := get("eth_unknown"); !strings.Contains(body, `"result":null`) {
+		t.Errorf("expected null result for unrouted action, got %s", body)
+	}
+	if server.Calls("eth_blockNumber") != 1 || server.Calls("eth_unknown") != 1 {
+		t.Errorf("unexpected call counts: %d, %d", server.Calls("eth_blockNumber"), server.Calls("eth_unknown"))
+	}
+}
@@ -0,0 +1,96 @@
+package etherscan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// unknownEvent names logs whose topic0 is not in knownEvents, or that have no topics at all.
+const unknownEvent = "unknown"
+
+// transferTopic is the topic0 of Transfer(address,address,uint256), which ERC-20
+// and ERC-721 tokens share.
+const transferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+// knownEvents maps the topic0 of common events (the keccak-256 hash of the
+// event signature) to the event's name. Events sharing a name, such as the
+// Uniswap V2 and V3 Swap events, are counted together.
+var knownEvents = map[string]string{
+	transferTopic: "Transfer", // Transfer(address,address,uint256), ERC-20 and ERC-721
+	"0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925": "Approval",       // Approval(address,address,uint256)
+	"0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31": "ApprovalForAll", // ApprovalForAll(address,address,bool)
+	"0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62": "TransferSingle", // ERC-1155
+	"0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb": "TransferBatch",  // ERC-1155
+	"0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c": "Deposit",        // WETH Deposit(address,uint256)
+	"0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65": "Withdrawal",     // WETH Withdrawal(address,uint256)
+	"0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822": "Swap",           // Uniswap V2
+	"0xc42079f94a6350d7e6235f29174924f928cc2ac818eb64fed8004e115fbcca67": "Swap",           // Uniswap V3
+	"0x1c411e9a96e071241c2f21f7726b17ae89e3cab4c78be50e062b03a9fffbbad1": "Sync",           // Uniswap V2 Sync(uint112,uint112)
+}
+
+// EventCount is the number of a transaction's logs that share an event name.
+type EventCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// summarizeEvents counts a receipt's logs by event name, matching each log's
+// topic0 against knownEvents without decoding its arguments.
+// Parameters:
+//   - logs: The logs from the transaction receipt.
+//
+// Returns:
+//   - The counts, most frequent first with ties by name, and unknown events last.
+//   - nil if there are no logs.
+func summarizeEvents(logs []receiptLog) []EventCount {
+	counts := make(map[string]int)
+	for _, l := range logs {
+		name := unknownEvent
+		if len(l.Topics) > 0 {
+			if known, ok := knownEvents[strings.ToLower(l.Topics[0])]; ok {
+				name = known
+			}
+		}
+		counts[name]++
+	}
+
+	var events []EventCount
+	for name, count := range counts {
+		events = append(events, EventCount{Name: name, Count: count})
+	}
+	slices.SortFunc(events, func(a, b EventCount) int {
+		if (a.Name == unknownEvent) != (b.Name == unknownEvent) {
+			if a.Name == unknownEvent {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Name, b.Name))
+	})
+	return events
+}
+
+// FormatEvents renders an event summary on one line,
+// e.g. "Emitted 5 events (3 Transfer, 1 Approval, 1 unknown)".
+// Parameters:
+//   - events: The event counts, as stored in Transaction.Events.
+//
+// Returns:
+//   - The summary, or "" if there are no events.
+func FormatEvents(events []EventCount) string {
+	total := 0
+	parts := make([]string, 0, len(events))
+	for _, e := range events {
+		total += e.Count
+		parts = append(parts, fmt.Sprintf("%d %s", e.Count, e.Name))
+	}
+	switch total {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("Emitted 1 event (%s)", events[0].Name)
+	}
+	return fmt.Sprintf("Emitted %d events (%s)", total, strings.Join(parts, ", "))
+}
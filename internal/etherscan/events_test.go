@@ -0,0 +1,54 @@
+package etherscan
+
+import (
+	"slices"
+	"testing"
+)
+
+const (
+	topicTransfer = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	topicSwapV2   = "0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822"
+	topicSwapV3   = "0xc42079f94a6350d7e6235f29174924f928cc2ac818eb64fed8004e115fbcca67"
+)
+
+func TestSummarizeEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		topics [][]string // topics of each log
+		want   []EventCount
+	}{
+		{"no logs", nil, nil},
+		{"anonymous event", [][]string{{}}, []EventCount{{"unknown", 1}}},
+		{"unknown sorts last", [][]string{{"0x01"}, {"0x02"}, {topicTransfer}}, []EventCount{{"Transfer", 1}, {"unknown", 2}}},
+		{"ties by name", [][]string{{topicTransfer}, {topicSwapV2}}, []EventCount{{"Swap", 1}, {"Transfer", 1}}},
+		{"shared name", [][]string{{topicSwapV2}, {topicSwapV3}, {topicTransfer}}, []EventCount{{"Swap", 2}, {"Transfer", 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs []receiptLog
+			for _, topics := range tt.topics {
+				logs = append(logs, receiptLog{Topics: topics})
+			}
+			if got := summarizeEvents(logs); !slices.Equal(got, tt.want) {
+				t.Errorf("summarizeEvents() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatEvents(t *testing.T) {
+	tests := []struct {
+		events []EventCount
+		want   string
+	}{
+		{nil, ""},
+		{[]EventCount{{"Transfer", 1}}, "Emitted 1 event (Transfer)"},
+		{[]EventCount{{"Transfer", 2}}, "Emitted 2 events (2 Transfer)"},
+		{[]EventCount{{"Transfer", 3}, {"Approval", 1}, {"unknown", 1}}, "Emitted 5 events (3 Transfer, 1 Approval, 1 unknown)"},
+	}
+	for _, tt := range tests {
+		if got := FormatEvents(tt.events); got != tt.want {
+			t.Errorf("FormatEvents(%v) = %q; want %q", tt.events, got, tt.want)
+		}
+	}
+}
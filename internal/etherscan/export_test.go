@@ -0,0 +1,220 @@
+package etherscan
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// txlistServer serves txlist pages of the given sizes, in order, and counts requests.
+func txlistServer(t *testing.T, pageSizes []int, calls *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		*calls++
+		txs := []accountTransaction{}
+		if page >= 1 && page <= len(pageSizes) {
+			for i := range pageSizes[page-1] {
+				txs = append(txs, accountTransaction{
+					Hash:        "0x" + strconv.Itoa(page) + "_" + strconv.Itoa(i),
+					BlockNumber: "11",
+					TimeStamp:   "1708459968",
+					From:        "0xaaa",
+					To:          "0xbbb",
+					Value:       "1500000000000000000",
+					GasUsed:     "21000",
+					GasPrice:    "1000000000",
+					IsError:     strconv.Itoa(i % 2),
+				})
+			}
+		}
+		result, _ := json.Marshal(txs)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"1","message":"OK","result":` + string(result) + `}`)) // nolint:errcheck // mock server
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWriteAccountTransactionsCSV(t *testing.T) {
+	var calls int
+	server := txlistServer(t, []int{exportPageSize, 2}, &calls)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	var buf bytes.Buffer
+	n, err := client.WriteAccountTransactionsCSV(t.Context(), &buf, "0xaaa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != exportPageSize+2 {
+		t.Errorf("Expected %d transactions, got %d", exportPageSize+2, n)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 page requests, got %d", calls)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if strings.Join(records[0], ",") != "hash,block,timestamp,from,to,value,fee,status" {
+		t.Errorf("unexpected header: %v", records[0])
+	}
+	want := "0x1_0,11,2024-02-20T20:12:48Z,0xaaa,0xbbb,1.5 ETH,0.000021 ETH,success"
+	if got := strings.Join(records[1], ","); got != want {
+		t.Errorf("Expected first row %q, got %q", want, got)
+	}
+	if records[2][7] != "failed" {
+		t.Errorf("Expected second row to be failed, got %q", records[2][7])
+	}
+}
+
+func TestWriteAccountTransactionsCSV_ResultCap(t *testing.T) {
+	pages := make([]int, 12)
+	for i := range pages {
+		pages[i] = exportPageSize
+	}
+	var calls int
+	server := txlistServer(t, pages, &calls)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	var buf bytes.Buffer
+	n, err := client.WriteAccountTransactionsCSV(t.Context(), &buf, "0xaaa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != exportMaxResults || calls != exportMaxResults/exportPageSize {
+		t.Errorf("Expected %d transactions in %d pages, got %d in %d", exportMaxResults, exportMaxResults/exportPageSize, n, calls)
+	}
+}
+
+func TestExportAccountTransactionsCSV(t *testing.T) {
+	var calls int
+	server := txlistServer(t, []int{3}, &calls)
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	path, n, err := client.ExportAccountTransactionsCSV(t.Context(), t.TempDir(), "0xaaa")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 || !strings.HasSuffix(path, "0xaaa-transactions.csv") {
+		t.Errorf("Expected 3 transactions in 0xaaa-transactions.csv, got %d in %s", n, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading export: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 4 {
+		t.Errorf("Expected 4 lines (header + 3 rows), got %d", lines)
+	}
+}
+
+// exportTx is a fully processed transaction, as shown in the TUI.
+var exportTx = &Transaction{
+	Hash:              "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+	BlockNumber:       "46147",
+	From:              "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+	To:                "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+	Value:             "♦ 0.031337 ETH",
+	ValueWei:          "31337000000000000",
+	Gas:               "21000",
+	GasPrice:          "⛽ 50000 Gwei (0.00005 ETH)",
+	GasPriceWei:       "50000000000000",
+	Nonce:             "0",
+	TransactionIndex:  "0",
+	Type:              "0 (Legacy)",
+	Confirmations:     "12",
+	Finalized:         true,
+	Status:            "success",
+	Timestamp:         "2015-08-07T03:30:33Z",
+	GasUsed:           "21000",
+	TransactionFee:    "1.05 ETH",
+	TransactionFeeWei: "1050000000000000000",
+	EtherPriceUSD:     3200.5,
+	Events:            []EventCount{{Name: "Transfer", Count: 2}},
+	Labels:            map[Address]string{"0xa1e4380a3b1f749673e270229993ee55f35663b4": "Miner"},
+	Warnings:          []string{"block details unavailable: timeout"},
+}
+
+func TestExportJSON_RoundTrip(t *testing.T) {
+	data, err := ExportJSON(exportTx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasSuffix(data, []byte("}\n")) || !bytes.Contains(data, []byte("\n  \"hash\"")) {
+		t.Errorf("expected indented JSON ending in a newline, got %s", data)
+	}
+
+	var got Transaction
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("decoding export: %v", err)
+	}
+	if !reflect.DeepEqual(&got, exportTx) {
+		t.Errorf("round trip = %+v; want %+v", got, *exportTx)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	data, err := ExportCSV(exportTx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("parsing export: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected a header and one row, got %d records", len(records))
+	}
+
+	want := map[string]string{
+		"hash":          string(exportTx.Hash),
+		"status":        "success",
+		"block":         "46147",
+		"timestamp":     "2015-08-07T03:30:33Z",
+		"value":         "0.031337 ETH",
+		"fee":           "1.05 ETH",
+		"gas_price":     "50000 Gwei",
+		"type":          "0 (Legacy)",
+		"confirmations": "12",
+	}
+	header, row := records[0], records[1]
+	if !slices.Equal(header, transactionCSVHeader) || len(row) != len(header) {
+		t.Fatalf("unexpected header %v for row %v", header, row)
+	}
+	for i, column := range header {
+		if expected, ok := want[column]; ok && row[i] != expected {
+			t.Errorf("%s = %q; want %q", column, row[i], expected)
+		}
+	}
+	// Snapshots saved without the raw price fall back to the formatted one
+	old := *exportTx
+	old.GasPriceWei = ""
+	data, err = ExportCSV(&old)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if row := strings.Split(strings.Split(string(data), "\n")[1], ","); row[slices.Index(header, "gas_price")] != "50000 Gwei" {
+		t.Errorf("expected the formatted gas price without GasPriceWei, got row %v", row)
+	}
+}
+
+func TestExport_NilTransaction(t *testing.T) {
+	if _, err := ExportJSON(nil); err == nil {
+		t.Error("ExportJSON(nil): expected an error")
+	}
+	if _, err := ExportCSV(nil); err == nil {
+		t.Error("ExportCSV(nil): expected an error")
+	}
+}
@@ -0,0 +1,108 @@
+// Package etherscan defines how the client decides that a transaction is finalized.
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// defaultFinalityConfirmations is roughly two epochs on Ethereum mainnet, after
+// which a block is normally finalized by the beacon chain.
+const defaultFinalityConfirmations = 64
+
+// finalizedTag is the block tag that resolves to the chain's latest finalized block.
+const finalizedTag = "finalized"
+
+// Finality configures how transactions are marked as finalized.
+type Finality struct {
+	// UseFinalizedTag compares the transaction block against the chain's "finalized"
+	// block, falling back to Confirmations if the chain doesn't support the tag.
+	UseFinalizedTag bool
+	// Confirmations is the number of confirmations after which a transaction is finalized.
+	Confirmations int
+}
+
+// DefaultFinality returns the count-based finality settings.
+func DefaultFinality() Finality {
+	return Finality{Confirmations: defaultFinalityConfirmations}
+}
+
+// ParseFinality parses a finality setting: "finalized" to use the chain's finalized
+// block tag, or a positive number of confirmations.
+// Parameters:
+//   - s: The setting to parse. Empty returns the default.
+//
+// Returns:
+//   - The parsed Finality.
+//   - An error if the setting is neither "finalized" nor a positive integer.
+func ParseFinality(s string) (Finality, error) {
+	switch s {
+	case "":
+		return DefaultFinality(), nil
+	case finalizedTag:
+		return Finality{UseFinalizedTag: true, Confirmations: defaultFinalityConfirmations}, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return Finality{}, fmt.Errorf("invalid finality %q: want %q or a positive number of confirmations", s, finalizedTag)
+	}
+	return Finality{Confirmations: n}, nil
+}
+
+// SetFinality sets how the client marks transactions as finalized.
+// Parameters:
+//   - f: The finality settings.
+func (c *Client) SetFinality(f Finality) {
+	if f.Confirmations <= 0 {
+		f.Confirmations = defaultFinalityConfirmations
+	}
+	c.finality = f
+}
+
+// Finality returns the client's finality settings.
+func (c *Client) Finality() Finality {
+	return c.finality
+}
+
+// FetchFinalizedBlockNumber retrieves the number of the chain's latest finalized block.
+// Parameters:
+//   - ctx: The context for the request.
+//
+// Returns:
+//   - The finalized block number as a hex string.
+//   - An error if the request fails or the chain doesn't support the "finalized" tag.
+func (c *Client) FetchFinalizedBlockNumber(ctx context.Context) (string, error) {
+	block, _, err := c.fetchBlock(ctx, finalizedTag)
+	if err != nil {
+		return "", err
+	}
+	if block.Number == "" {
+		return "", fmt.Errorf("block number not found in %s block", finalizedTag)
+	}
+	return block.Number, nil
+}
+
+// isFinalized reports whether a mined transaction is finalized under the client's settings.
+// Parameters:
+//   - ctx: The context for the request.
+//   - txBlock: The transaction's block number (hex or decimal).
+//   - confirmations: The transaction's confirmation count (decimal).
+func (c *Client) isFinalized(ctx context.Context, txBlock, confirmations string) bool {
+	block := stringToBigInt(txBlock)
+	if block == nil || block.Sign() <= 0 {
+		return false
+	}
+
+	if c.finality.UseFinalizedTag {
+		if finalized, err := c.FetchFinalizedBlockNumber(ctx); err == nil {
+			if f := stringToBigInt(finalized); f != nil {
+				return block.Cmp(f) <= 0
+			}
+		}
+		// The chain doesn't support the tag; fall back to counting confirmations
+	}
+
+	n, err := strconv.Atoi(confirmations)
+	return err == nil && n >= c.finality.Confirmations
+}
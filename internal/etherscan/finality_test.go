@@ -0,0 +1,90 @@
+package etherscan
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseFinality(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    Finality
+		expectedErr bool
+	}{
+		{"", Finality{Confirmations: 64}, false},
+		{"finalized", Finality{UseFinalizedTag: true, Confirmations: 64}, false},
+		{"12", Finality{Confirmations: 12}, false},
+		{"0", Finality{}, true},
+		{"safe", Finality{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFinality(tt.input)
+		if (err != nil) != tt.expectedErr {
+			t.Fatalf("ParseFinality(%q) error = %v; expectedErr %v", tt.input, err, tt.expectedErr)
+		}
+		if got != tt.expected {
+			t.Errorf("ParseFinality(%q) = %+v; want %+v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestIsFinalized(t *testing.T) {
+	tests := []struct {
+		name          string
+		finality      Finality
+		finalizedBody string
+		txBlock       string
+		confirmations string
+		expected      bool
+	}{
+		{"Count Reached", Finality{Confirmations: 10}, "", "0xb", "10", true},
+		{"Count Not Reached", Finality{Confirmations: 10}, "", "0xb", "9", false},
+		{"Pending", Finality{Confirmations: 1}, "", "", "", false},
+		{
+			name:          "At Finalized Block",
+			finality:      Finality{UseFinalizedTag: true, Confirmations: 64},
+			finalizedBody: `{"jsonrpc":"2.0","id":1,"result":{"number":"0xb","timestamp":"0x65d507c0","transactions":[]}}`,
+			txBlock:       "0xb",
+			confirmations: "1",
+			expected:      true,
+		},
+		{
+			name:          "After Finalized Block",
+			finality:      Finality{UseFinalizedTag: true, Confirmations: 1},
+			finalizedBody: `{"jsonrpc":"2.0","id":1,"result":{"number":"0xa","timestamp":"0x65d507c0","transactions":[]}}`,
+			txBlock:       "0xb",
+			confirmations: "100",
+			expected:      false,
+		},
+		{
+			name:          "Tag Unsupported Falls Back To Count",
+			finality:      Finality{UseFinalizedTag: true, Confirmations: 5},
+			finalizedBody: `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid block tag"}}`,
+			txBlock:       "0xb",
+			confirmations: "5",
+			expected:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("tag") != "finalized" {
+					t.Errorf("unexpected request: %s", r.URL.RawQuery)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(tt.finalizedBody)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetFinality(tt.finality)
+
+			if got := client.isFinalized(t.Context(), tt.txBlock, tt.confirmations); got != tt.expected {
+				t.Errorf("isFinalized(%s, %s) = %v; want %v", tt.txBlock, tt.confirmations, got, tt.expected)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package etherscan
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 )
@@ -10,16 +11,17 @@ import (
 // formatValue converts a hex string (Wei) to a human-readable ETH string.
 // Parameters:
 //   - hexStr: The hex value in Wei.
+//   - decimals: The number of decimals of the chain's native unit.
 //
 // Returns:
 //   - A formatted string with the ETH symbol and value.
-func formatValue(hexStr string) string {
-	eth, s, done := hexToFloat(hexStr, 1e18)
+func formatValue(hexStr string, decimals int) string {
+	eth, s, done := hexToUnits(hexStr, decimals)
 	if done {
 		return s
 	}
 
-	return fmt.Sprintf("♦ %s ETH", eth.Text('f', -1))
+	return fmt.Sprintf("♦ %s ETH", eth)
 }
 
 // formatGwei converts a hex string (Wei) to Gwei as a string.
@@ -32,11 +34,80 @@ func formatGwei(hexStr string) string {
 	if hexStr == "" {
 		return ""
 	}
-	gwei, s, done := hexToFloat(hexStr, 1e9)
+	gwei, s, done := hexToUnits(hexStr, gweiDecimals)
 	if done {
 		return s
 	}
-	return gwei.Text('f', -1)
+	return gwei
+}
+
+// GasPrice is a gas price in both units it's shown in, as exact decimals.
+type GasPrice struct {
+	Gwei string // e.g. "1.5"
+	ETH  string // e.g. "0.0000000015"
+}
+
+// ParseGasPrice converts a gas price in Wei to Gwei and ETH, so it can be shown
+// without taking apart a formatted string.
+// Parameters:
+//   - wei: The gas price in Wei, decimal or hex (e.g., Transaction.GasPriceWei).
+//
+// Returns:
+//   - The gas price in Gwei and ETH.
+//   - False if wei is empty, malformed or negative.
+func ParseGasPrice(wei string) (GasPrice, bool) {
+	v := stringToBigInt(wei)
+	if v == nil || v.Sign() < 0 {
+		return GasPrice{}, false
+	}
+	return GasPrice{Gwei: formatUnits(v, gweiDecimals), ETH: formatUnits(v, defaultNativeDecimals)}, true
+}
+
+// GasPriceAmounts returns the transaction's gas price in Gwei and ETH.
+// Returns:
+//   - The gas price, parsed from the formatted GasPrice for snapshots saved before GasPriceWei existed.
+//   - False if the gas price wasn't fetched or can't be parsed.
+func (tx *Transaction) GasPriceAmounts() (GasPrice, bool) {
+	if p, ok := ParseGasPrice(tx.GasPriceWei); ok {
+		return p, true
+	}
+	var p GasPrice
+	if _, err := fmt.Sscanf(strings.TrimPrefix(tx.GasPrice, "⛽ "), "%s Gwei (%s ETH)", &p.Gwei, &p.ETH); err != nil {
+		return GasPrice{}, false
+	}
+	return p, true
+}
+
+// GasPriceGwei returns the transaction's gas price in Gwei without the glyph or
+// ETH equivalent shown on screen, e.g. "1.5 Gwei", for copying and exports.
+// Returns:
+//   - The gas price, or "" if it wasn't fetched or can't be parsed.
+func (tx *Transaction) GasPriceGwei() string {
+	if p, ok := tx.GasPriceAmounts(); ok {
+		return p.Gwei + " Gwei"
+	}
+	return ""
+}
+
+// ValueAmount returns the transaction's value in ETH without the glyph shown on
+// screen, e.g. "0.5 ETH", for copying and exports.
+// Returns:
+//   - The value, taken from the formatted Value for snapshots saved before ValueWei existed.
+func (tx *Transaction) ValueAmount() string {
+	if s := FormatAmount(tx.ValueWei, UnitEther); s != "" {
+		return s
+	}
+	return strings.TrimPrefix(tx.Value, "♦ ")
+}
+
+// FeeAmount returns the transaction fee in ETH, e.g. "0.00042 ETH", for copying and exports.
+// Returns:
+//   - The fee, taken from the formatted TransactionFee for snapshots saved before TransactionFeeWei existed.
+func (tx *Transaction) FeeAmount() string {
+	if s := FormatAmount(tx.TransactionFeeWei, UnitEther); s != "" {
+		return s
+	}
+	return tx.TransactionFee
 }
 
 // formatGasPrice converts a hex string (Wei) to a formatted Gwei and ETH gas price string.
@@ -46,24 +117,22 @@ func formatGwei(hexStr string) string {
 // Returns:
 //   - A formatted string with gas pump emoji, Gwei value, and ETH value.
 func formatGasPrice(hexStr string) string {
-	gwei, s, done := hexToFloat(hexStr, 1e9)
-	if done {
+	if _, s, done := hexToUnits(hexStr, gweiDecimals); done {
 		return s
 	}
-
-	eth, _, _ := hexToFloat(hexStr, 1e18)
-
-	return fmt.Sprintf("⛽ %s Gwei (%s ETH)", gwei.Text('f', -1), eth.Text('f', -1))
+	p, _ := ParseGasPrice(hexStr)
+	return fmt.Sprintf("⛽ %s Gwei (%s ETH)", p.Gwei, p.ETH)
 }
 
 // formatTransactionFee calculates and formats the transaction fee in ETH.
 // Parameters:
 //   - gasUsedHex: The gas used in hex.
 //   - gasPriceHex: The gas price in hex.
+//   - decimals: The number of decimals of the chain's native unit.
 //
 // Returns:
 //   - The calculated fee in ETH as a formatted string.
-func formatTransactionFee(gasUsedHex, gasPriceHex string) string {
+func formatTransactionFee(gasUsedHex, gasPriceHex string, decimals int) string {
 	if gasUsedHex == "" || gasPriceHex == "" {
 		return ""
 	}
@@ -81,11 +150,21 @@ func formatTransactionFee(gasUsedHex, gasPriceHex string) string {
 	// Fee = gasUsed * gasPrice
 	feeWei := new(big.Int).Mul(gu, gp)
 
-	// 1 ETH = 10^18 Wei
-	feeEth := new(big.Float).SetInt(feeWei)
-	feeEth.Quo(feeEth, big.NewFloat(1e18))
+	// 1 ETH = 10^decimals Wei
+	return fmt.Sprintf("%s ETH", formatUnits(feeWei, decimals))
+}
 
-	return fmt.Sprintf("%s ETH", feeEth.Text('f', -1))
+// ShortHex abbreviates a hash or address to its first and last four hex digits.
+// Parameters:
+//   - s: The hash or address (with "0x" prefix).
+//
+// Returns:
+//   - The abbreviation (e.g., "0x5c50…2060"), or s itself if it's too short to abbreviate.
+func ShortHex(s string) string {
+	if len(s) <= 12 {
+		return s
+	}
+	return s[:6] + "…" + s[len(s)-4:]
 }
 
 // formatTransactionType returns a human-readable description for an Ethereum transaction type.
@@ -103,6 +182,11 @@ func formatTransactionType(hexStr string) string {
 		return hexStr
 	}
 
+	// Int64 silently wraps values that don't fit, so show those as-is
+	if !bi.IsInt64() {
+		return bi.String()
+	}
+
 	val := bi.Int64()
 	switch val {
 	case 0:
@@ -127,3 +211,143 @@ func formatTransactionType(hexStr string) string {
 func FormatLatestBlock(hexStr string) string {
 	return hexToDecimal(hexStr)
 }
+
+// FormatGasUsagePercent returns gas used as a percentage of the gas limit, rounded to two decimals.
+// Parameters:
+//   - gasUsed: The gas used (decimal or hex).
+//   - gasLimit: The gas limit (decimal or hex).
+//
+// Returns:
+//   - The percentage without the % sign (e.g., "50.00").
+//   - False if either value is invalid or the gas limit is zero.
+func FormatGasUsagePercent(gasUsed, gasLimit string) (string, bool) {
+	return formatPercent(stringToBigInt(gasUsed), stringToBigInt(gasLimit))
+}
+
+// FormatThousands inserts comma separators into a decimal integer string.
+// Parameters:
+//   - decimal: The decimal integer (e.g., "30000000").
+//
+// Returns:
+//   - The grouped string (e.g., "30,000,000"), or the input unchanged if it is not a plain integer.
+func FormatThousands(decimal string) string {
+	digits := strings.TrimPrefix(decimal, "-")
+	if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+		return decimal
+	}
+
+	var b strings.Builder
+	if len(digits) < len(decimal) {
+		b.WriteByte('-')
+	}
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// IsBlobTransaction reports whether a transaction is an EIP-4844 blob transaction.
+// Parameters:
+//   - tx: The transaction, with its type formatted (see formatTransactionType).
+//
+// Returns:
+//   - True for type 3 transactions.
+func IsBlobTransaction(tx *Transaction) bool {
+	return tx.Type == formatTransactionType("0x3")
+}
+
+// FormatBlobHashes summarizes a blob transaction's versioned hashes as a count
+// followed by the first hash, since the rest are rarely needed at a glance.
+// Parameters:
+//   - hashes: The blob versioned hashes.
+//
+// Returns:
+//   - "1 blob: <hash>" or "2 blobs, first <hash>", or "" if there are none.
+func FormatBlobHashes(hashes []Hash) string {
+	switch len(hashes) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("1 blob: %s", hashes[0])
+	default:
+		return fmt.Sprintf("%d blobs, first %s", len(hashes), hashes[0])
+	}
+}
+
+// DescribeNonce places a nonce in the context of the sender's transaction history.
+// Parameters:
+//   - nonce: The transaction nonce (decimal or hex).
+//   - txCount: The sender's mined transaction count (decimal or hex).
+//
+// Returns:
+//   - A description such as "sender's 43rd transaction, latest", or "" if either value is invalid.
+func DescribeNonce(nonce, txCount string) string {
+	n, count := stringToBigInt(nonce), stringToBigInt(txCount)
+	if n == nil || count == nil || n.Sign() < 0 {
+		return ""
+	}
+
+	var when string
+	switch last := new(big.Int).Sub(count, big.NewInt(1)); n.Cmp(last) {
+	case -1:
+		when = "historical"
+	case 0:
+		when = "latest"
+	default:
+		when = "future (pending)"
+	}
+
+	position := new(big.Int).Add(n, big.NewInt(1))
+	return fmt.Sprintf("sender's %s transaction, %s", ordinal(position), when)
+}
+
+// ordinal formats n with its English ordinal suffix (1st, 2nd, 3rd, 4th, 11th, ...).
+func ordinal(n *big.Int) string {
+	s := n.String()
+	mod100 := new(big.Int).Mod(n, big.NewInt(100)).Int64()
+	if mod100 >= 11 && mod100 <= 13 {
+		return s + "th"
+	}
+	switch mod100 % 10 {
+	case 1:
+		return s + "st"
+	case 2:
+		return s + "nd"
+	case 3:
+		return s + "rd"
+	default:
+		return s + "th"
+	}
+}
+
+// FormatUSD converts a raw Wei amount of ETH to an approximate USD figure, e.g. "~$3,200".
+// Amounts of $1,000 and more are rounded to whole dollars, smaller ones to cents.
+// Parameters:
+//   - wei: The amount in Wei (decimal or hex).
+//   - priceUSD: The price of one ETH in USD.
+//
+// Returns:
+//   - The formatted figure, "<$0.01" for dust, or "" if the amount is zero or
+//     invalid or there is no price.
+func FormatUSD(wei string, priceUSD float64) string {
+	v := stringToBigInt(wei)
+	if v == nil || v.Sign() <= 0 || !(priceUSD > 0) || math.IsInf(priceUSD, 0) {
+		return ""
+	}
+
+	eth := new(big.Float).Quo(new(big.Float).SetInt(v), new(big.Float).SetInt(pow10(defaultNativeDecimals)))
+	usd, _ := new(big.Float).Mul(eth, big.NewFloat(priceUSD)).Float64()
+
+	cents := math.Round(usd * 100)
+	switch {
+	case cents < 1:
+		return "<$0.01"
+	case cents < 100000:
+		return fmt.Sprintf("~$%.2f", cents/100)
+	default:
+		return "~$" + FormatThousands(fmt.Sprintf("%.0f", math.Round(usd)))
+	}
+}
@@ -1,23 +1,29 @@
 package etherscan
 
 import (
+	"strings"
 	"testing"
 )
 
 func TestFormatValue(t *testing.T) {
 	tests := []struct {
 		hex      string
+		decimals int
 		expected string
 	}{
-		{"0xde0b6b3a7640000", "♦ 1 ETH"},
-		{"0x0", "♦ 0 ETH"},
-		{"", ""},
+		{"0xde0b6b3a7640000", 18, "♦ 1 ETH"},
+		{"0x0", 18, "♦ 0 ETH"},
+		{"", 18, ""},
+		{"0xf4240", 6, "♦ 1 ETH"}, // hypothetical 6-decimal native unit
+		{"0x-de0b6b3a7640000", 18, "invalid"},
+		{"0xNaN", 18, "invalid"},
+		{"0x1.8", 18, "invalid"},
 	}
 
 	for _, tt := range tests {
-		got := formatValue(tt.hex)
+		got := formatValue(tt.hex, tt.decimals)
 		if got != tt.expected {
-			t.Errorf("formatValue(%s) = %s; want %s", tt.hex, got, tt.expected)
+			t.Errorf("formatValue(%s, %d) = %s; want %s", tt.hex, tt.decimals, got, tt.expected)
 		}
 	}
 }
@@ -47,6 +53,8 @@ func TestFormatGasPrice(t *testing.T) {
 	}{
 		{"0x3b9aca00", "⛽ 1 Gwei (0.000000001 ETH)"},
 		{"", ""},
+		{"0x-3b9aca00", "invalid"},
+		{"0xInf", "invalid"},
 	}
 
 	for _, tt := range tests {
@@ -57,6 +65,99 @@ func TestFormatGasPrice(t *testing.T) {
 	}
 }
 
+func TestParseGasPrice(t *testing.T) {
+	tests := []struct {
+		name string
+		wei  string
+		want GasPrice
+		ok   bool
+	}{
+		{"Decimal", "1500000000", GasPrice{Gwei: "1.5", ETH: "0.0000000015"}, true},
+		{"Hex", "0x3b9aca00", GasPrice{Gwei: "1", ETH: "0.000000001"}, true},
+		{"One Wei", "1", GasPrice{Gwei: "0.000000001", ETH: "0.000000000000000001"}, true},
+		{"Zero", "0", GasPrice{Gwei: "0", ETH: "0"}, true},
+		{"Beyond Float64", "123456789012345678901", GasPrice{Gwei: "123456789012.345678901", ETH: "123.456789012345678901"}, true},
+		{"Empty", "", GasPrice{}, false},
+		{"Malformed", "1 Gwei", GasPrice{}, false},
+		{"Negative", "-1", GasPrice{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseGasPrice(tt.wei)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("ParseGasPrice(%q) = %+v, %v; want %+v, %v", tt.wei, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestTransaction_GasPriceGwei(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   Transaction
+		want string
+	}{
+		{"Raw Price", Transaction{GasPriceWei: "1500000000", GasPrice: "⛽ 1.5 Gwei (0.0000000015 ETH)"}, "1.5 Gwei"},
+		// Snapshots saved before GasPriceWei existed only have the formatted price
+		{"Old Snapshot", Transaction{GasPrice: "⛽ 2 Gwei (0.000000002 ETH)"}, "2 Gwei"},
+		{"Old Snapshot Without Glyph", Transaction{GasPrice: "25 Gwei (0.000000025 ETH)"}, "25 Gwei"},
+		{"Unparsed", Transaction{GasPrice: "invalid"}, ""},
+		{"Not Fetched", Transaction{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tx.GasPriceGwei(); got != tt.want {
+				t.Errorf("GasPriceGwei() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransaction_Amounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		tx        Transaction
+		wantValue string
+		wantFee   string
+	}{
+		{"Raw Amounts", Transaction{ValueWei: "500000000000000000", Value: "♦ 0.5 ETH", TransactionFeeWei: "420000000000000", TransactionFee: "0.00042 ETH"}, "0.5 ETH", "0.00042 ETH"},
+		// Snapshots saved before the raw amounts existed only have the formatted ones
+		{"Old Snapshot", Transaction{Value: "♦ 1 ETH", TransactionFee: "0.001 ETH"}, "1 ETH", "0.001 ETH"},
+		{"Not Fetched", Transaction{}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tx.ValueAmount(); got != tt.wantValue {
+				t.Errorf("ValueAmount() = %q; want %q", got, tt.wantValue)
+			}
+			if got := tt.tx.FeeAmount(); got != tt.wantFee {
+				t.Errorf("FeeAmount() = %q; want %q", got, tt.wantFee)
+			}
+		})
+	}
+}
+
+func TestShortHex(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060", "0x5c50…2060"},
+		{"0x28c6c06298d514db089934071355e5743bf21d60", "0x28c6…1d60"},
+		{"0x1234567890", "0x1234567890"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ShortHex(tt.input); got != tt.expected {
+			t.Errorf("ShortHex(%q) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
 func TestFormatTransactionFee(t *testing.T) {
 	tests := []struct {
 		gasUsed  string
@@ -69,7 +170,7 @@ func TestFormatTransactionFee(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got := formatTransactionFee(tt.gasUsed, tt.gasPrice)
+		got := formatTransactionFee(tt.gasUsed, tt.gasPrice, 18)
 		if got != tt.expected {
 			t.Errorf("formatTransactionFee(%s, %s) = %s; want %s", tt.gasUsed, tt.gasPrice, got, tt.expected)
 		}
@@ -105,3 +206,255 @@ func TestFormatLatestBlock(t *testing.T) {
 		t.Errorf("FormatLatestBlock(0xa) = %s; want 10", got)
 	}
 }
+
+func TestFormatLargeValues(t *testing.T) {
+	// 120M ETH total supply expressed in Wei exceeds float64's exact-integer range
+	if got := formatValue("0x6342fd08f00f6378000000", 18); got != "♦ 120000000 ETH" {
+		t.Errorf("formatValue(total supply) = %s; want ♦ 120000000 ETH", got)
+	}
+	// 30M gas at 10,000 Gwei + 1 Wei
+	if got := formatTransactionFee("0x1c9c380", "0x9184e72a001", 18); got != "300.00000000003 ETH" {
+		t.Errorf("formatTransactionFee(max gas) = %s; want 300.00000000003 ETH", got)
+	}
+	if got := formatGasPrice("0x9184e72a001"); got != "⛽ 10000.000000001 Gwei (0.000010000000000001 ETH)" {
+		t.Errorf("formatGasPrice(10000 Gwei + 1 Wei) = %s", got)
+	}
+}
+
+func TestFormatGasUsagePercent(t *testing.T) {
+	tests := []struct {
+		gasUsed  string
+		gasLimit string
+		expected string
+		ok       bool
+	}{
+		{"21000", "21000", "100.00", true},
+		{"29999999", "30000000", "100.00", true},
+		{"0x5208", "0xa410", "50.00", true},
+		{"50000", "0", "", false},
+		{"abc", "100", "", false},
+		{"123456789012345678901234567890", "123456789012345678901234567891", "100.00", true},
+		{"9007199254740993", "18014398509481986", "50.00", true},
+		{"21000 gas", "42000", "", false},
+		{"21000", " 42000", "", false},
+		{"21000", "", "", false},
+		{"21000", "-42000", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := FormatGasUsagePercent(tt.gasUsed, tt.gasLimit)
+		if got != tt.expected || ok != tt.ok {
+			t.Errorf("FormatGasUsagePercent(%s, %s) = %q, %v; want %q, %v", tt.gasUsed, tt.gasLimit, got, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestFormatThousands(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"999", "999"},
+		{"1000", "1,000"},
+		{"500000", "500,000"},
+		{"30000000", "30,000,000"},
+		{"-1234567", "-1,234,567"},
+		{"", ""},
+		{"n/a", "n/a"},
+		{"0x5208", "0x5208"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatThousands(tt.input); got != tt.expected {
+			t.Errorf("FormatThousands(%q) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		wei      string
+		unit     Unit
+		expected string
+	}{
+		{"1000000000000000000", UnitEther, "1 ETH"},
+		{"1500000000000000000", UnitGwei, "1500000000 Gwei"},
+		{"21000", UnitGwei, "0.000021 Gwei"},
+		{"0x5208", UnitWei, "21000 Wei"},
+		{"123456789012345678901234567890", UnitWei, "123456789012345678901234567890 Wei"},
+		{"abc", UnitEther, ""},
+	}
+
+	for _, tt := range tests {
+		if got := FormatAmount(tt.wei, tt.unit); got != tt.expected {
+			t.Errorf("FormatAmount(%q, %v) = %q; want %q", tt.wei, tt.unit, got, tt.expected)
+		}
+	}
+
+	if UnitEther.Next() != UnitGwei || UnitGwei.Next() != UnitWei || UnitWei.Next() != UnitEther {
+		t.Error("expected units to cycle ETH → Gwei → Wei → ETH")
+	}
+}
+
+func TestFormatUSD(t *testing.T) {
+	tests := []struct {
+		wei      string
+		price    float64
+		expected string
+	}{
+		{"1000000000000000000", 3200, "~$3,200"},
+		{"1000000000000000000", 3200.5, "~$3,201"},
+		{"0xde0b6b3a7640000", 3200, "~$3,200"},
+		{"250000000000000000000", 3200, "~$800,000"},
+		{"21000000000000", 3200, "~$0.07"},
+		{"100000000000000000", 2500, "~$250.00"},
+		{"312499000000000000", 3200, "~$1,000"}, // $999.9968 rounds up past the cents form
+		{"1000000000", 3200, "<$0.01"},
+		{"0", 3200, ""},
+		{"", 3200, ""},
+		{"abc", 3200, ""},
+		{"1000000000000000000", 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := FormatUSD(tt.wei, tt.price); got != tt.expected {
+			t.Errorf("FormatUSD(%q, %v) = %q; want %q", tt.wei, tt.price, got, tt.expected)
+		}
+	}
+}
+
+func TestFormatBlobHashes(t *testing.T) {
+	first := Hash("0x01a5b1a5e3d8c2f4b6a7d9e0c1b2a3f4e5d6c7b8a9f0e1d2c3b4a5f6e7d8c9b0")
+	second := Hash("0x01c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3")
+	tests := []struct {
+		name     string
+		hashes   []Hash
+		expected string
+	}{
+		{"None", nil, ""},
+		{"One", []Hash{first}, "1 blob: " + string(first)},
+		{"Several", []Hash{first, second}, "2 blobs, first " + string(first)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatBlobHashes(tt.hashes); got != tt.expected {
+				t.Errorf("FormatBlobHashes() = %q; want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsBlobTransaction(t *testing.T) {
+	tests := []struct {
+		hexType  string
+		expected bool
+	}{
+		{"0x3", true},
+		{"0x03", true},
+		{"0x2", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		tx := &Transaction{Type: formatTransactionType(tt.hexType)}
+		if got := IsBlobTransaction(tx); got != tt.expected {
+			t.Errorf("IsBlobTransaction(type %q) = %v; want %v", tt.hexType, got, tt.expected)
+		}
+	}
+}
+
+func TestDescribeNonce(t *testing.T) {
+	tests := []struct {
+		nonce    string
+		txCount  string
+		expected string
+	}{
+		{"42", "43", "sender's 43rd transaction, latest"},
+		{"0", "10", "sender's 1st transaction, historical"},
+		{"1", "0x3", "sender's 2nd transaction, historical"},
+		{"10", "10", "sender's 11th transaction, future (pending)"},
+		{"111", "500", "sender's 112th transaction, historical"},
+		{"120", "121", "sender's 121st transaction, latest"},
+		{"abc", "10", ""},
+		{"5", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := DescribeNonce(tt.nonce, tt.txCount); got != tt.expected {
+			t.Errorf("DescribeNonce(%q, %q) = %q; want %q", tt.nonce, tt.txCount, got, tt.expected)
+		}
+	}
+}
+
+func FuzzFormatTransactionType(f *testing.F) {
+	for _, s := range []string{"", "0x", "0x0", "0x1", "0x2", "0x3", "0x4", "0x7e", "0xzz", "0x-1", "0x" + strings.Repeat("f", 40)} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got := formatTransactionType(s)
+		if got == "" && s != "" {
+			t.Errorf("formatTransactionType(%q) returned an empty string", s)
+		}
+		// Unparseable input is echoed back, but a parsed type is never negative
+		if strings.HasPrefix(got, "-") && got != s {
+			t.Errorf("formatTransactionType(%q) = %q; want no negative type", s, got)
+		}
+	})
+}
+
+// checkAmount reports whether a formatted amount is a fallback or a non-negative decimal after prefix.
+func checkAmount(t *testing.T, name, in, got, prefix string) {
+	t.Helper()
+	switch {
+	case got == "" || got == "invalid" || got == "0 ETH":
+		return
+	case !strings.HasPrefix(in, "0x"):
+		if got != in {
+			t.Errorf("%s(%q) = %q; want the input passed through", name, in, got)
+		}
+		return
+	}
+	amount, ok := strings.CutPrefix(got, prefix)
+	amount, _, _ = strings.Cut(amount, " ")
+	if !ok || amount == "" || strings.TrimLeft(amount, "0123456789.") != "" {
+		t.Errorf("%s(%q) = %q; want %q followed by a non-negative decimal", name, in, got, prefix)
+	}
+}
+
+func FuzzFormatValue(f *testing.F) {
+	for _, s := range append(fuzzSeeds, "0x-de0b6b3a7640000", "0xNaN", "0xInf", "0x1.8", "0x1p4") {
+		f.Add(s, 18)
+	}
+	f.Add("0x1", 0)
+	f.Fuzz(func(t *testing.T, s string, decimals int) {
+		decimals = max(-1, min(decimals, 36))
+		checkAmount(t, "formatValue", s, formatValue(s, decimals), "♦ ")
+	})
+}
+
+func FuzzFormatGasPrice(f *testing.F) {
+	for _, s := range append(fuzzSeeds, "0x-3b9aca00", "0xNaN", "0xInf", "0x1.8") {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		checkAmount(t, "formatGasPrice", s, formatGasPrice(s), "⛽ ")
+	})
+}
+
+func BenchmarkFormatValue(b *testing.B) {
+	for b.Loop() {
+		formatValue("0xde0b6b3a7640001", defaultNativeDecimals)
+	}
+}
+
+func BenchmarkFormatGasPrice(b *testing.B) {
+	for b.Loop() {
+		formatGasPrice("0x6fc23ac00")
+	}
+}
+
+func BenchmarkFormatThousands(b *testing.B) {
+	for b.Loop() {
+		FormatThousands("30000000")
+	}
+}
@@ -2,13 +2,19 @@
 package etherscan
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
+	"math/big"
+	"sync"
+	"time"
 )
 
+// maxBlockClockSkew is how far ahead of the local clock a block timestamp may be before it is rejected.
+const maxBlockClockSkew = time.Hour
+
 // buildTransaction takes a raw transaction response and converts it to a Transaction struct.
 // Parameters:
 //   - ctx: The context for the request.
@@ -23,7 +29,7 @@ import (
 //   - An error if building the transaction fails.
 func buildTransaction(ctx context.Context, hash Hash, proxyResp *ProxyResponse[json.RawMessage], c *Client) (Transaction, *Transaction, error) {
 	if len(proxyResp.Result) == 0 || string(proxyResp.Result) == "null" {
-		return Transaction{}, nil, errors.New("transaction not found or invalid response")
+		return Transaction{}, nil, fmt.Errorf("%w or invalid response", ErrTransactionNotFound)
 	}
 
 	// Try to unmarshal Result as a Transaction object
@@ -32,11 +38,14 @@ func buildTransaction(ctx context.Context, hash Hash, proxyResp *ProxyResponse[j
 		// If it's not a Transaction object, check if it's a string (e.g., an error message)
 		var msg string
 		if json.Unmarshal(proxyResp.Result, &msg) == nil {
-			// If the message contains "Error!" it's likely a transaction not found on this network
-			if strings.Contains(msg, "Error!") {
-				return Transaction{}, nil, fmt.Errorf("Etherscan API error: %s (Is the hash on the correct network?)", msg)
+			// A transaction not found on this network is only worth pointing out
+			// as a possible wrong network if another network is in use
+			apiErr := newAPIError(msg)
+			if apiErr.Kind == KindNotFound && !c.noNetworkHint && len(c.otherChains(c.networkFor(ctx).ChainID)) > 0 {
+				apiErr.WrongNetwork = true
+				apiErr.Hint = "Is the hash on the correct network?"
 			}
-			return Transaction{}, nil, fmt.Errorf("Etherscan API error: %s", msg)
+			return Transaction{}, nil, apiErr
 		}
 		return Transaction{}, nil, fmt.Errorf("unexpected response format for result: %w", err)
 	}
@@ -48,44 +57,160 @@ func buildTransaction(ctx context.Context, hash Hash, proxyResp *ProxyResponse[j
 	hexGasPrice := tx.GasPrice
 	hexMaxFeePerGas := tx.MaxFeePerGas
 
+	decimals := c.networkFor(ctx).NativeDecimals
+
+	// The sender's transaction count serves both the replacement check and the
+	// nonce context, so it's fetched at most once per lookup
+	senderTxCount := sync.OnceValues(func() (string, error) {
+		return c.FetchTransactionCount(ctx, tx.From, "latest")
+	})
+
 	// Convert hex fields to decimal
 	tx.BlockNumber = hexToDecimal(tx.BlockNumber)
-	tx.Value = formatValue(tx.Value)
+	tx.ValueWei = hexToDecimal(tx.Value)
+	tx.Value = formatValue(tx.Value, decimals)
 	tx.Gas = hexToDecimal(tx.Gas)
+	tx.GasPriceWei = hexToDecimal(tx.GasPrice)
 	tx.GasPrice = formatGasPrice(tx.GasPrice)
 	tx.Nonce = hexToDecimal(tx.Nonce)
 	tx.TransactionIndex = hexToDecimal(tx.TransactionIndex)
+	if tx.To != "" {
+		// A creation's input is init code, not a call
+		tx.Method = decodeMethodSelector(tx.Input)
+	}
+	legacy := tx.Type == "" || tx.Type == "0x0"
 	tx.Type = formatTransactionType(tx.Type)
+	if legacy && isPreEIP155(proxyResp.Result) {
+		tx.Type = "0 (Legacy, pre-EIP-155)"
+	}
 
-	latestBlock, lerr := c.FetchLatestBlockNumber(ctx)
-	if lerr == nil {
-		tx.Confirmations = calculateConfirmations(latestBlock, hexBlockNumber)
-	} else {
-		tx.Confirmations = lerr.Error()
+	// The latest block, receipt, block details and ETH price only depend on the
+	// transaction, so they are fetched at once and applied below in a fixed order
+	var wg sync.WaitGroup
+	var (
+		latestBlock string
+		latestErr   error
+		finalized   bool
+	)
+	if !c.skipConfirmations {
+		wg.Go(func() {
+			defer beginStep(ctx, stepConfirmations)()
+			latestBlock, latestErr = c.FetchLatestBlockNumber(ctx)
+			if latestErr == nil {
+				confirmations, _ := calculateConfirmations(latestBlock, hexBlockNumber)
+				finalized = c.isFinalized(ctx, hexBlockNumber, confirmations)
+			}
+		})
+	}
+	var (
+		receipt    receiptResultData
+		receiptErr error
+	)
+	if !c.skipReceipt {
+		wg.Go(func() {
+			defer beginStep(ctx, stepReceipt)()
+			receipt, receiptErr = c.fetchReceipt(ctx, hash)
+		})
+	}
+	var (
+		block     *blockResultData
+		timestamp string
+		blockErr  error
+	)
+	fetchBlock := !c.skipTimestamp && hexBlockNumber != "" && hexBlockNumber != "0x0"
+	if fetchBlock {
+		wg.Go(func() {
+			defer beginStep(ctx, stepBlock)()
+			block, timestamp, blockErr = c.fetchBlock(ctx, hexBlockNumber)
+		})
+	}
+	var price float64
+	if c.usdPrices && c.networkFor(ctx).EtherPrice {
+		wg.Go(func() {
+			defer beginStep(ctx, stepPrice)()
+			price, _ = c.FetchEtherPrice(ctx)
+		})
 	}
+	wg.Wait()
 
-	status, gasUsed, effectiveGasPrice, _, err := c.FetchTransactionReceipt(ctx, hash)
-	if err != nil {
-		tx.Status = "error"
-	} else {
-		tx.Status = status
+	// Without a price the amounts are simply shown in ETH alone
+	tx.EtherPriceUSD = price
+
+	if !c.skipConfirmations {
+		if latestErr == nil {
+			var stale bool
+			tx.Confirmations, stale = calculateConfirmations(latestBlock, hexBlockNumber)
+			if stale {
+				tx.AddWarning("latest block %s is behind the transaction's block %s; confirmations may be undercounted", hexToDecimal(latestBlock), tx.BlockNumber)
+			}
+			tx.Finalized = finalized
+		} else {
+			tx.Confirmations = latestErr.Error()
+		}
+	}
+
+	// Without the receipt, the status and every gas-used derived field stay empty
+	var gasUsed, effectiveGasPrice string
+	if !c.skipReceipt {
+		if receiptErr != nil {
+			tx.Status = "error"
+			tx.AddWarning("receipt unavailable: %v", receiptErr)
+		} else {
+			tx.Status, gasUsed, effectiveGasPrice, _, _ = receiptFields(receipt)
+			tx.Events = summarizeEvents(receipt.Logs)
+			tx.TokenTransfers = decodeTokenTransfers(receipt.Logs)
+			if tx.To == "" {
+				tx.ContractAddress = Address(receipt.ContractAddress)
+			}
+			if receipt.BlobGasUsed != "" {
+				tx.BlobGasUsed = hexToDecimal(receipt.BlobGasUsed)
+				tx.BlobGasPrice = formatGwei(receipt.BlobGasPrice)
+				tx.BlobFee = formatTransactionFee(receipt.BlobGasUsed, receipt.BlobGasPrice, decimals)
+			}
+		}
+	}
+	if tx.Status == "mined" {
+		tx.AddWarning("pre-Byzantium receipt has no status: success or failure can't be determined")
+	}
+	if tx.Status == "Pending" && tx.From != "" && replacementCheck(ctx) {
+		if count, cerr := senderTxCount(); cerr == nil {
+			if replaced, rerr := isReplaced(count, tx.Nonce); rerr == nil && replaced {
+				tx.Status = "replaced"
+			}
+		}
+	}
+	var endStep func()
+	if tx.Status == "failed" && tx.To != "" {
+		endStep = beginStep(ctx, stepRevert)
+		if reason, rerr := c.FetchRevertReason(ctx, &tx); rerr == nil {
+			tx.RevertReason = reason
+		} else {
+			tx.RevertReasonError = redactAPIKey(rerr.Error())
+		}
+		endStep()
 	}
 	tx.GasUsed = hexToDecimal(gasUsed)
-	tx.TransactionFee = formatTransactionFee(gasUsed, hexGasPrice)
+	tx.TransactionFee = formatTransactionFee(gasUsed, hexGasPrice, decimals)
+	if gu, gp := stringToBigInt(gasUsed), stringToBigInt(hexGasPrice); gu != nil && gp != nil {
+		tx.TransactionFeeWei = new(big.Int).Mul(gu, gp).String()
+	}
 
 	if hexMaxFeePerGas != "" {
-		tx.Savings = calculateSavings(gasUsed, hexMaxFeePerGas, effectiveGasPrice)
+		tx.Savings = calculateSavings(gasUsed, hexMaxFeePerGas, effectiveGasPrice, decimals)
 	}
 
-	if hexBlockNumber != "" && hexBlockNumber != "0x0" {
-		timestamp, baseFee, txHashes, err := c.FetchBlockDetails(ctx, hexBlockNumber)
-		if err == nil {
+	if fetchBlock {
+		if blockErr == nil {
 			tx.Timestamp = timestamp
-			tx.BaseFeePerGas = formatGwei(baseFee)
-			tx.BurntFees = calculateBurntFees(gasUsed, baseFee)
-			tx.BlockTransactionCount = fmt.Sprintf("%d", len(txHashes))
+			tx.BaseFeePerGas = formatGwei(block.BaseFeePerGas)
+			tx.BurntFees = calculateBurntFees(gasUsed, block.BaseFeePerGas, decimals)
+			tx.BlockTransactionCount = fmt.Sprintf("%d", len(block.Transactions))
+			if block.GasLimit != "" {
+				tx.BlockGasLimit = hexToDecimal(block.GasLimit)
+			}
 		} else {
-			tx.Timestamp = err.Error()
+			tx.Timestamp = blockErr.Error()
+			tx.AddWarning("block details unavailable: %v", blockErr)
 		}
 	}
 
@@ -95,21 +220,45 @@ func buildTransaction(ctx context.Context, hash Hash, proxyResp *ProxyResponse[j
 	if tx.MaxPriorityFeePerGas != "" {
 		tx.MaxPriorityFeePerGas = formatGwei(tx.MaxPriorityFeePerGas)
 	}
+	if tx.MaxFeePerBlobGas != "" {
+		tx.MaxFeePerBlobGas = formatGwei(tx.MaxFeePerBlobGas)
+	}
 
 	// For legacy transactions, gas price = max fee = max priority fee (informally)
 	// But Etherscan usually doesn't show them if they are not EIP-1559.
 	// We'll leave them empty if not present in the original tx response.
 
 	if tx.To != "" && tx.To != "0x0000000000000000000000000000000000000000" {
+		endStep = beginStep(ctx, stepAccount)
 		isContract, err := c.IsContract(ctx, tx.To)
+		endStep()
 		if err == nil {
 			if isContract {
 				tx.ToAccountType = "Smart Contract"
 			} else {
 				tx.ToAccountType = "EOA"
 			}
+		} else {
+			tx.AddWarning("could not check recipient account: %v", err)
 		}
 	}
+
+	if c.nonceContext && tx.From != "" {
+		endStep = beginStep(ctx, stepNonce)
+		count, err := senderTxCount()
+		endStep()
+		if err == nil {
+			tx.SenderTxCount = hexToDecimal(count)
+		}
+	}
+
+	if c.ensNames {
+		c.annotateENS(ctx, &tx)
+	}
+
+	if approval, ok := DecodeApproval(tx.Input); ok && approval.Unlimited() {
+		tx.AddWarning("unlimited approval: %s can spend all of this token", approval.Spender)
+	}
 	return tx, nil, nil
 }
 
@@ -118,7 +267,7 @@ func buildTransaction(ctx context.Context, hash Hash, proxyResp *ProxyResponse[j
 //   - proxyResp: The raw response from the Etherscan proxy for the receipt.
 //
 // Returns:
-//   - The transaction status (success, failed, or Pending).
+//   - The transaction status (success, failed, mined, or Pending).
 //   - An empty string (kept for signature compatibility).
 //   - An empty string (kept for signature compatibility).
 //   - An empty string (kept for signature compatibility).
@@ -130,66 +279,80 @@ func extractTransactionReceipt(proxyResp *ProxyResponse[receiptResultData]) (str
 	}
 
 	status := "Pending"
-	if proxyResp.Result.Status == "0x1" {
+	switch {
+	case proxyResp.Result.Status == "0x1":
 		status = "success"
-	} else if proxyResp.Result.Status == "0x0" {
+	case proxyResp.Result.Status == "0x0":
 		status = "failed"
+	case proxyResp.Result.Status == "" && proxyResp.Result.Root != "":
+		// Pre-Byzantium receipts carry a state root instead of a status,
+		// so the transaction was mined but its outcome isn't recorded
+		status = "mined"
 	}
 	return status, "", "", "", false, nil
 }
 
+// isEmptyResult reports whether a proxy result carries nothing: it is absent,
+// null or an empty object, however the surrounding JSON is formatted.
+// Parameters:
+//   - raw: The raw result from the Etherscan proxy.
+//
+// Returns:
+//   - True if the result is empty.
+func isEmptyResult(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || string(trimmed) == "null" {
+		return true
+	}
+	var obj map[string]json.RawMessage
+	return trimmed[0] == '{' && json.Unmarshal(trimmed, &obj) == nil && len(obj) == 0
+}
+
+// decodeResult unmarshals a proxy result object. The proxy reports some errors
+// as a plain string result (e.g., "Error! Invalid block number") instead of an
+// error object, so a string result is returned as an API error.
+// Parameters:
+//   - raw: The raw result from the Etherscan proxy.
+//   - kind: What the result describes (e.g., "block"), for error messages.
+//
+// Returns:
+//   - The decoded result.
+//   - An error if the result is an API message or has an unexpected format.
+func decodeResult[T any](raw json.RawMessage, kind string) (T, error) {
+	var result T
+	if err := json.Unmarshal(raw, &result); err != nil {
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil {
+			return result, newAPIError(msg)
+		}
+		return result, fmt.Errorf("unexpected response format for %s: %w", kind, err)
+	}
+	return result, nil
+}
+
 // extractBlockDetails parses block details from a raw proxy response.
 // Parameters:
 //   - proxyResp: The raw response from the Etherscan proxy for the block.
 //   - err: Any error that occurred during the initial request.
 //
 // Returns:
-//   - A struct containing Timestamp and BaseFeePerGas.
+//   - The parsed block data.
 //   - The Unix timestamp as an int64.
 //   - An empty string (kept for signature compatibility).
 //   - An empty string (kept for signature compatibility).
 //   - An error if parsing fails.
-func extractBlockDetails(proxyResp *ProxyResponse[json.RawMessage]) (struct {
-	Timestamp     string   `json:"timestamp"`
-	BaseFeePerGas string   `json:"baseFeePerGas"`
-	Transactions  []string `json:"transactions"`
-}, int64, string, string, error) {
+func extractBlockDetails(proxyResp *ProxyResponse[json.RawMessage]) (blockResultData, int64, string, string, error) {
 	if len(proxyResp.Result) == 0 || string(proxyResp.Result) == "null" {
-		return struct {
-			Timestamp     string   `json:"timestamp"`
-			BaseFeePerGas string   `json:"baseFeePerGas"`
-			Transactions  []string `json:"transactions"`
-		}{}, 0, "", "", errors.New("block not found")
+		return blockResultData{}, 0, "", "", errors.New("block not found")
 	}
 
-	var block struct {
-		Timestamp     string   `json:"timestamp"`
-		BaseFeePerGas string   `json:"baseFeePerGas"`
-		Transactions  []string `json:"transactions"`
-	}
-
-	if uerr := json.Unmarshal(proxyResp.Result, &block); uerr != nil {
-		var msg string
-		if json.Unmarshal(proxyResp.Result, &msg) == nil {
-			return struct {
-				Timestamp     string   `json:"timestamp"`
-				BaseFeePerGas string   `json:"baseFeePerGas"`
-				Transactions  []string `json:"transactions"`
-			}{}, 0, "", "", fmt.Errorf("Etherscan API error: %s", msg)
-		}
-		return struct {
-			Timestamp     string   `json:"timestamp"`
-			BaseFeePerGas string   `json:"baseFeePerGas"`
-			Transactions  []string `json:"transactions"`
-		}{}, 0, "", "", fmt.Errorf("unexpected response format for block: %w", uerr)
+	block, err := decodeResult[blockResultData](proxyResp.Result, "block")
+	if err != nil {
+		return blockResultData{}, 0, "", "", err
 	}
 
 	if block.Timestamp == "" {
-		return struct {
-			Timestamp     string   `json:"timestamp"`
-			BaseFeePerGas string   `json:"baseFeePerGas"`
-			Transactions  []string `json:"transactions"`
-		}{}, 0, "", "", errors.New("timestamp not found in block")
+		return blockResultData{}, 0, "", "", errors.New("timestamp not found in block")
 	}
 
 	lastTxHash := ""
@@ -201,11 +364,33 @@ func extractBlockDetails(proxyResp *ProxyResponse[json.RawMessage]) (struct {
 	var unixTime int64
 	_, serr := fmt.Sscanf(block.Timestamp, "0x%x", &unixTime)
 	if serr != nil {
-		return struct {
-			Timestamp     string   `json:"timestamp"`
-			BaseFeePerGas string   `json:"baseFeePerGas"`
-			Transactions  []string `json:"transactions"`
-		}{}, 0, "", "", fmt.Errorf("failed to parse timestamp: %w", serr)
+		return blockResultData{}, 0, "", "", fmt.Errorf("failed to parse timestamp: %w", serr)
+	}
+	// Zero is kept since genesis blocks legitimately use it; anything beyond a little clock skew is bad data
+	if unixTime < 0 || time.Unix(unixTime, 0).After(time.Now().Add(maxBlockClockSkew)) {
+		return blockResultData{}, 0, "", "", fmt.Errorf("implausible block timestamp %s", block.Timestamp)
 	}
 	return block, unixTime, "", lastTxHash, nil
 }
+
+// isPreEIP155 reports whether a raw transaction was signed without a chain id.
+// Before EIP-155 the signature's v value is always 27 or 28; replay-protected
+// legacy transactions encode the chain id in v instead.
+// Parameters:
+//   - raw: The raw transaction object from the Etherscan proxy.
+//
+// Returns:
+//   - True if the transaction has a v value of 27 or 28.
+func isPreEIP155(raw json.RawMessage) bool {
+	var sig struct {
+		V string `json:"v"`
+	}
+	if json.Unmarshal(raw, &sig) != nil {
+		return false
+	}
+	v := stringToBigInt(sig.V)
+	if v == nil || !v.IsInt64() {
+		return false
+	}
+	return v.Int64() == 27 || v.Int64() == 28
+}
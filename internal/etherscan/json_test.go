@@ -31,6 +31,14 @@ func TestExtractTransactionReceipt(t *testing.T) {
 			expectedStatus:  "failed",
 			expectedPending: false,
 		},
+		{
+			name: "Pre-Byzantium",
+			proxyResp: &ProxyResponse[receiptResultData]{
+				Result: receiptResultData{Root: "0x96a8e009d2b88b1483e6941e6812e32263b05683fac202abc622a3e31aed1957", GasUsed: "0x5208"},
+			},
+			expectedStatus:  "mined",
+			expectedPending: false,
+		},
 		{
 			name: "Pending",
 			proxyResp: &ProxyResponse[receiptResultData]{
@@ -81,11 +89,32 @@ func TestExtractBlockDetails(t *testing.T) {
 			json:        `{"baseFeePerGas":"0x7"}`,
 			expectedErr: "timestamp not found in block",
 		},
+		{
+			name:        "StringResult",
+			json:        `"Error! Invalid block number"`,
+			expectedErr: "Etherscan API error: Error! Invalid block number",
+		},
+		{
+			name:        "WrongType",
+			json:        `[1,2,3]`,
+			expectedErr: "unexpected response format for block",
+		},
 		{
 			name:        "InvalidTimestamp",
 			json:        `{"timestamp":"invalid"}`,
 			expectedErr: "failed to parse timestamp",
 		},
+		{
+			name:          "ZeroTimestamp",
+			json:          `{"timestamp":"0x0", "baseFeePerGas":"0x7"}`,
+			expectedTime:  0,
+			expectedBaseF: "0x7",
+		},
+		{
+			name:        "FutureTimestamp",
+			json:        `{"timestamp":"0xffffffff"}`,
+			expectedErr: "implausible block timestamp 0xffffffff",
+		},
 	}
 
 	for _, tt := range tests {
@@ -142,8 +171,7 @@ func TestBuildTransaction(t *testing.T) {
 	server := httptest.NewServer(mockHandler)
 	defer server.Close()
 
-	client := NewClient("test")
-	client.baseURL = server.URL
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
 
 	proxyResp := &ProxyResponse[json.RawMessage]{
 		Result: json.RawMessage(`{"hash":"0xabc","blockNumber":"0xa","value":"0xde0b6b3a7640000","gas":"0x5208","gasPrice":"0x3b9aca00","nonce":"0x1","transactionIndex":"0x0","type":"0x2","to":"0x123","maxFeePerGas":"0x4b9aca00"}`),
@@ -173,3 +201,27 @@ func TestBuildTransaction(t *testing.T) {
 		t.Errorf("expected savings to contain ETH, got %s", tx.Savings)
 	}
 }
+
+func TestIsPreEIP155(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{"v 27", `{"v":"0x1b"}`, true},
+		{"v 28", `{"v":"0x1c"}`, true},
+		{"EIP-155 mainnet", `{"v":"0x25"}`, false},
+		{"typed transaction y-parity", `{"v":"0x1"}`, false},
+		{"missing v", `{}`, false},
+		{"invalid v", `{"v":"0xzz"}`, false},
+		{"not an object", `"Error!"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPreEIP155(json.RawMessage(tt.raw)); got != tt.want {
+				t.Errorf("isPreEIP155(%s) = %v; want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
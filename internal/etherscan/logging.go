@@ -0,0 +1,81 @@
+// Package etherscan provides structured logging of API requests.
+package etherscan
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// maxLoggedBody is how much of an error response is logged.
+const maxLoggedBody = 200
+
+// WithLogger logs every API request (see SetLogger).
+// Parameters:
+//   - l: The logger to write to. Nil disables logging.
+//
+// Returns:
+//   - The Option.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Client) {
+		c.SetLogger(l)
+	}
+}
+
+// SetLogger logs every API request at debug level: its action and chain, the
+// HTTP status and elapsed time of each attempt, the backoff before each retry,
+// and the start of any error response. The API key is redacted throughout.
+// Parameters:
+//   - l: The logger to write to. Nil disables logging.
+func (c *Client) SetLogger(l *slog.Logger) {
+	if l == nil {
+		l = slog.New(slog.DiscardHandler)
+	}
+	c.logger = l
+}
+
+// requestLogger returns the client's logger annotated with the action and chain of a request URL.
+func (c *Client) requestLogger(rawURL string) *slog.Logger {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return c.logger
+	}
+	q := u.Query()
+	return c.logger.With(slog.String("action", q.Get("action")), slog.String("chain", q.Get("chainid")))
+}
+
+// logResponse logs an attempt's HTTP status and elapsed time, with the start
+// of the body if the API reported an error.
+func logResponse(l *slog.Logger, attempt, status int, elapsed time.Duration, body []byte) {
+	attrs := []any{slog.Int("attempt", attempt), slog.Int("status", status), slog.Duration("elapsed", elapsed)}
+	if isErrorResponse(status, body) {
+		attrs = append(attrs, slog.String("body", logSnippet(string(body))))
+	}
+	l.Debug("etherscan: response", attrs...)
+}
+
+// isErrorResponse reports whether a response is an HTTP error, a failed account
+// module call ("status":"0") or a JSON-RPC error.
+func isErrorResponse(status int, body []byte) bool {
+	if status >= 400 {
+		return true
+	}
+	var resp struct {
+		Status string          `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &resp) != nil {
+		return true
+	}
+	return resp.Status == "0" || len(resp.Error) > 0 && string(resp.Error) != "null"
+}
+
+// logSnippet redacts the API key from s and truncates it to maxLoggedBody bytes.
+func logSnippet(s string) string {
+	s = redactAPIKey(s)
+	if len(s) > maxLoggedBody {
+		return s[:maxLoggedBody] + "…"
+	}
+	return s
+}
@@ -0,0 +1,162 @@
+package etherscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingHandler keeps every record logged through it, with the attributes
+// added by Logger.With, so tests can inspect each field.
+type recordingHandler struct {
+	mu      *sync.Mutex
+	records *[]map[string]string
+	attrs   []slog.Attr
+}
+
+func newRecordingHandler() recordingHandler {
+	return recordingHandler{mu: new(sync.Mutex), records: new([]map[string]string)}
+}
+
+func (h recordingHandler) Enabled(_ context.Context, _ slog.Level) bool { return true }
+
+func (h recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	fields := map[string]string{"msg": r.Message}
+	for _, a := range h.attrs {
+		fields[a.Key] = a.Value.String()
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		fields[a.Key] = a.Value.String()
+		return true
+	})
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	*h.records = append(*h.records, fields)
+	return nil
+}
+
+func (h recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h.attrs = append(append([]slog.Attr(nil), h.attrs...), attrs...)
+	return h
+}
+
+func (h recordingHandler) WithGroup(_ string) slog.Handler { return h }
+
+// all returns the records logged so far.
+func (h recordingHandler) all() []map[string]string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]map[string]string(nil), *h.records...)
+}
+
+func TestClient_Logger(t *testing.T) {
+	const apiKey = "SECRETKEY123"
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			// A rate limit error echoing the request, as some proxies do
+			w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Max calls per sec rate limit reached (5/sec) for ` + r.URL.String() + `"}`)) // nolint:errcheck // mock server
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	h := newRecordingHandler()
+	client := NewClient(apiKey, WithBaseURL(server.URL), WithTuning(FastTuning()), WithLogger(slog.New(h)))
+	client.SetRetryPolicy(1, time.Millisecond)
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := h.all()
+	var msgs []string
+	for _, r := range records {
+		msgs = append(msgs, r["msg"])
+		for k, v := range r {
+			if strings.Contains(v, apiKey) {
+				t.Errorf("record %q logged the API key in %s: %q", r["msg"], k, v)
+			}
+		}
+		if r["action"] != "eth_blockNumber" || r["chain"] != "1" {
+			t.Errorf("record %q has action %q chain %q; want eth_blockNumber on 1", r["msg"], r["action"], r["chain"])
+		}
+	}
+	want := []string{"etherscan: response", "etherscan: retrying", "etherscan: response"}
+	if strings.Join(msgs, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("logged %v; want %v", msgs, want)
+	}
+
+	failed, retry, ok := records[0], records[1], records[2]
+	if failed["status"] != "200" || failed["attempt"] != "1" || !strings.Contains(failed["body"], "apikey=REDACTED") {
+		t.Errorf("expected the failed attempt with a redacted body, got %v", failed)
+	}
+	if retry["attempt"] != "2" || retry["backoff"] != "1ms" {
+		t.Errorf("expected the retry's attempt and backoff, got %v", retry)
+	}
+	if ok["status"] != "200" || ok["elapsed"] == "" {
+		t.Errorf("expected the status and elapsed time, got %v", ok)
+	}
+	if _, logged := ok["body"]; logged {
+		t.Errorf("expected no body for a successful response, got %q", ok["body"])
+	}
+}
+
+func TestClient_Logger_NetworkError(t *testing.T) {
+	const apiKey = "SECRETKEY123"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close() // Every request fails to connect, with the URL in the error
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient(apiKey, WithBaseURL(server.URL), WithTuning(FastTuning()), WithLogger(logger))
+	client.SetRetryPolicy(1, time.Millisecond)
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+
+	if strings.Contains(buf.String(), apiKey) {
+		t.Errorf("expected the API key redacted, got %s", buf.String())
+	}
+	var failures int
+	for line := range strings.Lines(buf.String()) {
+		var r map[string]any
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("malformed log line %q: %v", line, err)
+		}
+		if r["msg"] == "etherscan: request failed" {
+			failures++
+		}
+	}
+	if failures != 2 {
+		t.Errorf("expected both attempts logged as failed, got %d in %s", failures, buf.String())
+	}
+}
+
+func TestLogSnippet(t *testing.T) {
+	long := strings.Repeat("x", maxLoggedBody+10)
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"Short", "Invalid API Key", "Invalid API Key"},
+		{"Redacted", "GET /api?apikey=abc&action=x", "GET /api?apikey=REDACTED&action=x"},
+		{"Truncated", long, long[:maxLoggedBody] + "…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logSnippet(tt.in); got != tt.want {
+				t.Errorf("logSnippet() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Package etherscan provides decoding of well-known contract calls from transaction input data.
+package etherscan
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Well-known ERC-20 and ERC-721 function selectors for state-changing calls.
+const (
+	selectorApprove              = "0x095ea7b3" // approve(address,uint256)
+	selectorTransfer             = "0xa9059cbb" // transfer(address,uint256)
+	selectorTransferFrom         = "0x23b872dd" // transferFrom(address,address,uint256)
+	selectorSafeTransferFrom     = "0x42842e0e" // safeTransferFrom(address,address,uint256)
+	selectorSafeTransferFromData = "0xb88d4fde" // safeTransferFrom(address,address,uint256,bytes)
+)
+
+// knownMethods maps function selectors to their signatures.
+var knownMethods = map[string]string{
+	selectorApprove:              "approve(address,uint256)",
+	selectorTransfer:             "transfer(address,uint256)",
+	selectorTransferFrom:         "transferFrom(address,address,uint256)",
+	selectorSafeTransferFrom:     "safeTransferFrom(address,address,uint256)",
+	selectorSafeTransferFromData: "safeTransferFrom(address,address,uint256,bytes)",
+}
+
+// maxUint256 is the amount wallets and dapps use to request an unlimited approval.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Approval is a decoded ERC-20 approve(spender, amount) call.
+type Approval struct {
+	Spender Address
+	Amount  *big.Int
+}
+
+// Unlimited reports whether the approval grants the maximum uint256 allowance.
+func (a Approval) Unlimited() bool {
+	return a.Amount != nil && a.Amount.Cmp(maxUint256) == 0
+}
+
+// DecodeMethod returns the signature of a well-known method called by the input data.
+// Parameters:
+//   - input: The transaction input data (hex).
+//
+// Returns:
+//   - The method signature (e.g., "approve(address,uint256)").
+//   - False if the input is too short or the selector is not known.
+func DecodeMethod(input string) (string, bool) {
+	if len(input) < len(selectorApprove) {
+		return "", false
+	}
+	signature, ok := knownMethods[strings.ToLower(input[:len(selectorApprove)])]
+	return signature, ok
+}
+
+// decodeMethodSelector names the method a contract call's input data invokes.
+// Parameters:
+//   - input: The transaction input data (hex).
+//
+// Returns:
+//   - The method signature if its selector is well known, otherwise the raw
+//     selector (e.g., "0x12345678"); "" if the input is too short to hold one.
+func decodeMethodSelector(input string) string {
+	if len(input) < len(selectorApprove) || !strings.HasPrefix(input, "0x") {
+		return ""
+	}
+	selector := strings.ToLower(input[:len(selectorApprove)])
+	if stringToBigInt(selector) == nil {
+		return ""
+	}
+	if signature, ok := DecodeMethod(selector); ok {
+		return signature
+	}
+	return selector
+}
+
+// IsContractInteraction reports whether a transaction carries calldata, meaning it
+// calls or deploys a contract rather than being a plain native transfer.
+// Parameters:
+//   - tx: The transaction to classify.
+//
+// Returns:
+//   - True if the transaction's input is more than "0x".
+func IsContractInteraction(tx *Transaction) bool {
+	return strings.TrimPrefix(strings.ToLower(tx.Input), "0x") != ""
+}
+
+// DecodeApproval decodes the arguments of an ERC-20 approve call.
+// Parameters:
+//   - input: The transaction input data (hex).
+//
+// Returns:
+//   - A pointer to the decoded Approval.
+//   - False if the input is not a well-formed approve call.
+func DecodeApproval(input string) (*Approval, bool) {
+	args, ok := strings.CutPrefix(strings.ToLower(input), selectorApprove)
+	if !ok || len(args) < 128 {
+		return nil, false
+	}
+
+	spender, ok := decodeAddressWord(args[:64])
+	if !ok {
+		return nil, false
+	}
+	amount, err := decodeUint256(args[64:128])
+	if err != nil {
+		return nil, false
+	}
+
+	return &Approval{Spender: spender, Amount: amount}, true
+}
+
+// decodeAddressWord decodes an address from a left-padded 32-byte ABI word.
+func decodeAddressWord(word string) (Address, bool) {
+	if len(word) != 64 || strings.Trim(word[:24], "0") != "" {
+		return "", false
+	}
+	if stringToBigInt("0x"+word[24:]) == nil {
+		return "", false
+	}
+	return Address("0x" + word[24:]), true
+}
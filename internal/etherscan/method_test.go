@@ -0,0 +1,117 @@
+package etherscan
+
+import (
+	"strings"
+	"testing"
+)
+
+const spenderWord = "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+
+func TestDecodeMethod(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"Approve", selectorApprove + spenderWord, "approve(address,uint256)", true},
+		{"Transfer Upper Case", "0xA9059CBB" + spenderWord, "transfer(address,uint256)", true},
+		{"Unknown Selector", "0x12345678", "", false},
+		{"Empty", "0x", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := DecodeMethod(tt.input)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("DecodeMethod(%q) = %q, %v; want %q, %v", tt.input, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestDecodeMethodSelector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Transfer", selectorTransfer + spenderWord, "transfer(address,uint256)"},
+		{"Approve", selectorApprove + spenderWord, "approve(address,uint256)"},
+		{"Transfer From", selectorTransferFrom, "transferFrom(address,address,uint256)"},
+		{"ERC-721 Safe Transfer", selectorSafeTransferFrom + spenderWord, "safeTransferFrom(address,address,uint256)"},
+		{"ERC-721 Safe Transfer With Data", "0xB88D4FDE", "safeTransferFrom(address,address,uint256,bytes)"},
+		{"Unknown Selector", "0x12345678" + spenderWord, "0x12345678"},
+		{"Unknown Selector Upper Case", "0xABCDEF01", "0xabcdef01"},
+		{"Empty", "", ""},
+		{"No Calldata", "0x", ""},
+		{"Short", "0xa9059c", ""},
+		{"Not Hex", "0xzzzzzzzz", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeMethodSelector(tt.input); got != tt.want {
+				t.Errorf("decodeMethodSelector(%q) = %q; want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeApproval(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		ok        bool
+		unlimited bool
+		amount    string
+	}{
+		{"Unlimited", selectorApprove + spenderWord + strings.Repeat("f", 64), true, true, maxUint256.String()},
+		{"Limited", selectorApprove + spenderWord + strings.Repeat("0", 58) + "0f4240", true, false, "1000000"},
+		{"Truncated", selectorApprove + spenderWord, false, false, ""},
+		{"Dirty Address Padding", selectorApprove + "1" + spenderWord[1:] + strings.Repeat("f", 64), false, false, ""},
+		{"Not Approve", selectorTransfer + spenderWord + strings.Repeat("f", 64), false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approval, ok := DecodeApproval(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("DecodeApproval ok = %v; want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if approval.Spender != "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48" {
+				t.Errorf("Expected spender 0xa0b8…eb48, got %s", approval.Spender)
+			}
+			if approval.Unlimited() != tt.unlimited {
+				t.Errorf("Unlimited() = %v; want %v", approval.Unlimited(), tt.unlimited)
+			}
+			if approval.Amount.String() != tt.amount {
+				t.Errorf("Expected amount %s, got %s", tt.amount, approval.Amount)
+			}
+		})
+	}
+}
+
+func TestIsContractInteraction(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{"ETH Transfer", "0x", false},
+		{"Missing Input", "", false},
+		{"Contract Call", selectorTransfer + spenderWord + strings.Repeat("0", 64), true},
+		{"Uppercase Prefix", "0X" + selectorApprove[2:], true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsContractInteraction(&Transaction{Input: tt.input}); got != tt.want {
+				t.Errorf("IsContractInteraction(%q) = %v; want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Package etherscan defines the EVM networks known to the client.
+package etherscan
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// defaultNativeDecimals is the number of decimals used by the native unit of most EVM chains (Wei -> ETH).
+const defaultNativeDecimals = 18
+
+// Network describes an EVM chain and how its native unit is displayed.
+type Network struct {
+	ChainID        int
+	Name           string
+	ShortName      string // compact name for the network switcher, empty for generic networks
+	NativeDecimals int
+	ExplorerURL    string // base URL of the chain's Etherscan site, empty if unknown
+
+	// FinalityConfirmations is roughly how many confirmations it takes for a
+	// block to be finalized, or 0 if unknown.
+	FinalityConfirmations int
+	// FinalizedTag reports whether the chain resolves the "finalized" block tag.
+	FinalizedTag bool
+	// ENS reports whether the ENS registry is deployed and supported on the chain.
+	ENS bool
+	// EtherPrice reports whether the native currency is ETH as priced by Etherscan,
+	// so amounts can be shown in USD.
+	EtherPrice bool
+}
+
+// NetworkByID returns the Network for the given chain ID.
+// Unknown chains fall back to 18 native decimals and a generic name.
+// Parameters:
+//   - id: The Ethereum chain ID.
+//
+// Returns:
+//   - The matching Network.
+func NetworkByID(id int) Network {
+	if n, ok := knownNetworks[id]; ok {
+		return n
+	}
+	return Network{ChainID: id, Name: fmt.Sprintf("Chain %d", id), NativeDecimals: defaultNativeDecimals}
+}
+
+// Capabilities lists the optional features the network supports, for display.
+// Returns:
+//   - Short feature names, e.g. "finalized tag" and "ENS".
+func (n Network) Capabilities() []string {
+	var caps []string
+	if n.FinalizedTag {
+		caps = append(caps, "finalized tag")
+	}
+	if n.ENS {
+		caps = append(caps, "ENS")
+	}
+	return caps
+}
+
+// WriteChainsTable writes networks to w as an aligned text table.
+// Parameters:
+//   - w: The destination for the table.
+//   - chains: The networks to list, typically SupportedChains().
+//
+// Returns:
+//   - An error if writing fails.
+func WriteChainsTable(w io.Writer, chains []Network) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHAIN ID\tNAME\tEXPLORER\tFINALITY\tCAPABILITIES")
+	for _, n := range chains {
+		finality := "n/a"
+		if n.FinalityConfirmations > 0 {
+			finality = fmt.Sprintf("%d confirmations", n.FinalityConfirmations)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", n.ChainID, n.Name, cmp.Or(n.ExplorerURL, "n/a"), finality, cmp.Or(strings.Join(n.Capabilities(), ", "), "-"))
+	}
+	return tw.Flush()
+}
+
+// IsKnownNetwork reports whether the chain ID is in the chain registry.
+// Parameters:
+//   - id: The Ethereum chain ID.
+//
+// Returns:
+//   - True if the chain is in the registry, so the client can switch to it.
+func IsKnownNetwork(id int) bool {
+	_, ok := knownNetworks[id]
+	return ok
+}
+
+// TransactionURL returns the link to a transaction on the network's block explorer.
+// Parameters:
+//   - hash: The transaction hash.
+//
+// Returns:
+//   - The explorer URL, or "" if the network has no known explorer.
+func (n Network) TransactionURL(hash Hash) string {
+	if n.ExplorerURL == "" {
+		return ""
+	}
+	return n.ExplorerURL + "/tx/" + string(hash)
+}
+
+// ParseChainIDs parses a comma-separated list of chain IDs, such as "1,11155111".
+// Parameters:
+//   - s: The list to parse. Empty returns nil.
+//
+// Returns:
+//   - The chain IDs in the order given.
+//   - An error if an entry is not a positive integer.
+func ParseChainIDs(s string) ([]int, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var ids []int
+	for part := range strings.SplitSeq(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid chain ID %q: want a positive integer", strings.TrimSpace(part))
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// SetChains sets the networks in use. Only these are probed for a transaction
+// missing on the current network, and the "correct network" hint is dropped
+// when no other network is in use. By default every known network is in use.
+// Parameters:
+//   - ids: The chain IDs in use. Nil or empty restores the default.
+func (c *Client) SetChains(ids []int) {
+	c.chains = ids
+}
+
+// SetNetworkHint enables the suggestion to check the network when the API
+// reports a hash as not found. It is on by default.
+// Parameters:
+//   - enabled: Whether to add the hint.
+func (c *Client) SetNetworkHint(enabled bool) {
+	c.noNetworkHint = !enabled
+}
+
+// otherChains returns the chain IDs in use other than current, in ascending order.
+func (c *Client) otherChains(current int) []int {
+	ids := c.chains
+	if len(ids) == 0 {
+		ids = slices.Collect(maps.Keys(knownNetworks))
+	}
+	ids = slices.Compact(slices.Sorted(slices.Values(ids)))
+	return slices.DeleteFunc(ids, func(id int) bool { return id == current })
+}
+
+// networkKey is the context key for the network pinned to a fetch.
+type networkKey struct{}
+
+// withNetwork pins the client's current network to ctx, unless one is already
+// pinned, so every request of a multi-call fetch uses the same chain even if
+// the network is switched while it is in flight.
+func (c *Client) withNetwork(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(networkKey{}).(Network); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, networkKey{}, c.Network())
+}
+
+// networkFor returns the network pinned to ctx, or the client's current network if none is.
+func (c *Client) networkFor(ctx context.Context) Network {
+	if n, ok := ctx.Value(networkKey{}).(Network); ok {
+		return n
+	}
+	return c.Network()
+}
@@ -0,0 +1,173 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNetworkByID(t *testing.T) {
+	tests := []struct {
+		id        int
+		wantName  string
+		wantKnown bool
+	}{
+		{1, "Mainnet", true},
+		{11155111, "Sepolia", true},
+		{137, "Polygon PoS", true},
+		{999, "Chain 999", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantName, func(t *testing.T) {
+			n := NetworkByID(tt.id)
+			if n.ChainID != tt.id || n.Name != tt.wantName || n.NativeDecimals != defaultNativeDecimals {
+				t.Errorf("NetworkByID(%d) = %+v", tt.id, n)
+			}
+			if got := IsKnownNetwork(tt.id); got != tt.wantKnown {
+				t.Errorf("IsKnownNetwork(%d) = %v; want %v", tt.id, got, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestNetwork_TransactionURL(t *testing.T) {
+	tests := []struct {
+		id   int
+		want string
+	}{
+		{1, "https://etherscan.io/tx/0xabc"},
+		{11155111, "https://sepolia.etherscan.io/tx/0xabc"},
+		{8453, "https://basescan.org/tx/0xabc"},
+		{999, ""},
+	}
+
+	for _, tt := range tests {
+		if got := NetworkByID(tt.id).TransactionURL("0xabc"); got != tt.want {
+			t.Errorf("TransactionURL on chain %d = %q; want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestSupportedChains(t *testing.T) {
+	chains := SupportedChains()
+	if len(chains) != len(knownNetworks) {
+		t.Fatalf("expected %d chains, got %d", len(knownNetworks), len(chains))
+	}
+	// Mainnet and Sepolia come first, as the most used
+	if chains[0].ChainID != 1 || chains[1].ChainID != 11155111 {
+		t.Errorf("expected Mainnet then Sepolia first, got %s then %s", chains[0].Name, chains[1].Name)
+	}
+	for _, n := range chains {
+		if n.ExplorerURL == "" || n.ShortName == "" || n.NativeDecimals != defaultNativeDecimals {
+			t.Errorf("expected explorer, short name and decimals for %s, got %+v", n.Name, n)
+		}
+	}
+
+	// Callers get a copy they can't use to change the registry
+	chains[0].Name = "changed"
+	if NetworkByID(chains[0].ChainID).Name == "changed" {
+		t.Error("expected SupportedChains to return a copy")
+	}
+}
+
+func TestClient_SetChainID(t *testing.T) {
+	client := NewClient("test")
+	if err := client.SetChainID(42161); err != nil || client.Network().Name != "Arbitrum One" {
+		t.Fatalf("SetChainID(42161) = %v, network %s; want Arbitrum One", err, client.Network().Name)
+	}
+	if err := client.SetChainID(999); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("SetChainID(999) = %v; want ErrUnsupportedChain", err)
+	}
+	if client.ChainID() != 42161 {
+		t.Errorf("expected an unsupported chain to leave the chain unchanged, got %d", client.ChainID())
+	}
+}
+
+func TestWithChainID_Unsupported(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithChainID(999))
+	if client.ChainID() != 1 {
+		t.Errorf("expected the chain to stay Mainnet, got %d", client.ChainID())
+	}
+	// The option can't fail, so the first request reports the unsupported chain instead of quietly querying Mainnet
+	if _, err := client.FetchLatestBlockNumber(t.Context()); !errors.Is(err, ErrUnsupportedChain) {
+		t.Errorf("FetchLatestBlockNumber() error = %v; want ErrUnsupportedChain", err)
+	}
+	if server.Calls("eth_blockNumber") != 0 {
+		t.Error("expected no request to be sent for an unsupported chain")
+	}
+
+	// Picking a supported chain clears the error
+	if err := client.SetChainID(11155111); err != nil {
+		t.Fatalf("SetChainID(11155111) = %v", err)
+	}
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err != nil {
+		t.Errorf("FetchLatestBlockNumber() after SetChainID = %v; want no error", err)
+	}
+}
+
+func TestClient_NextChain(t *testing.T) {
+	tests := []struct {
+		name    string
+		chains  []int
+		current int
+		want    int
+	}{
+		{"Registry Order", nil, 1, 11155111},
+		{"Wraps Around", nil, 10, 1},
+		{"Chains In Use", []int{137, 999, 1}, 1, 137},
+		{"In Use Wraps", []int{137, 1}, 137, 1},
+		{"Current Not In Use", []int{8453, 10}, 1, 8453},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test", WithChainID(tt.current))
+			client.SetChains(tt.chains)
+			if got := client.NextChain().ChainID; got != tt.want {
+				t.Errorf("NextChain() = %d; want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteChainsTable(t *testing.T) {
+	var b strings.Builder
+	chains := append(SupportedChains()[:2], NetworkByID(999))
+	if err := WriteChainsTable(&b, chains); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `CHAIN ID  NAME       EXPLORER                      FINALITY          CAPABILITIES
+1         Mainnet    https://etherscan.io          64 confirmations  finalized tag, ENS
+11155111  Sepolia    https://sepolia.etherscan.io  64 confirmations  finalized tag
+999       Chain 999  n/a                           n/a               -
+`
+	if b.String() != expected {
+		t.Errorf("unexpected table:\n%s\nwant:\n%s", b.String(), expected)
+	}
+}
+
+func TestParseChainIDs(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []int
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"1", []int{1}, false},
+		{"1, 11155111,137", []int{1, 11155111, 137}, false},
+		{"1,mainnet", nil, true},
+		{"0", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseChainIDs(tt.input)
+		if (err != nil) != tt.wantErr || !slices.Equal(got, tt.want) {
+			t.Errorf("ParseChainIDs(%q) = %v, %v; want %v, error %v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
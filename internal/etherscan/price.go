@@ -0,0 +1,99 @@
+// Package etherscan provides the ETH price used to show approximate USD values.
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// priceTTL is how long a fetched ETH price is reused before it is fetched again.
+const priceTTL = time.Minute
+
+// etherPriceResult is the result of the stats module's ethprice action.
+type etherPriceResult struct {
+	ETHBTC          string `json:"ethbtc"`
+	ETHBTCTimestamp string `json:"ethbtc_timestamp"`
+	ETHUSD          string `json:"ethusd"`
+	ETHUSDTimestamp string `json:"ethusd_timestamp"`
+}
+
+// SetUSDPrices enables fetching the ETH price with each Mainnet transaction, so
+// its value and fee can be shown in USD (see Transaction.EtherPriceUSD). It is
+// off by default; the price is fetched at most once a minute.
+// Parameters:
+//   - enabled: Whether to fetch the ETH price.
+func (c *Client) SetUSDPrices(enabled bool) {
+	c.usdPrices = enabled
+}
+
+// FetchEtherPrice retrieves the latest ETH price in USD. Only chains whose native
+// currency is ETH as priced by Etherscan (see Network.EtherPrice) have one.
+// Prices are cached for a minute.
+// Parameters:
+//   - ctx: The context for the request.
+//
+// Returns:
+//   - The price of one ETH in USD, or 0 if the network has no ETH price.
+//   - An error if the request fails or the price can't be parsed.
+func (c *Client) FetchEtherPrice(ctx context.Context) (float64, error) {
+	ctx = c.withNetwork(ctx)
+	network := c.networkFor(ctx)
+	if !network.EtherPrice {
+		return 0, nil
+	}
+	if c.apiKey == "" {
+		return 0, ErrNoAPIKey
+	}
+
+	c.priceMu.Lock()
+	price, fetched := c.price, c.priceAt
+	c.priceMu.Unlock()
+	if price > 0 && time.Since(fetched) < priceTTL {
+		return price, nil
+	}
+
+	url := fmt.Sprintf("%s?chainid=%d&module=stats&action=ethprice&apikey=%s", c.baseURL, network.ChainID, c.apiKey)
+
+	resp, err := doRequest[json.RawMessage](ctx, c, url)
+	if err != nil {
+		return 0, err
+	}
+
+	price, err = parseEtherPrice(resp.Result)
+	if err != nil {
+		return 0, err
+	}
+
+	c.priceMu.Lock()
+	c.price, c.priceAt = price, time.Now()
+	c.priceMu.Unlock()
+	return price, nil
+}
+
+// parseEtherPrice extracts the USD price from an ethprice result.
+// Parameters:
+//   - result: The raw result of the ethprice action.
+//
+// Returns:
+//   - The price of one ETH in USD.
+//   - An error if the result is an API error message or has no valid price.
+func parseEtherPrice(result json.RawMessage) (float64, error) {
+	var data etherPriceResult
+	if err := json.Unmarshal(result, &data); err != nil {
+		var msg string
+		if json.Unmarshal(result, &msg) == nil {
+			return 0, newAPIError(msg)
+		}
+		return 0, fmt.Errorf("unexpected response format for ETH price: %w", err)
+	}
+
+	price, err := strconv.ParseFloat(data.ETHUSD, 64)
+	if err != nil || price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return 0, fmt.Errorf("invalid ETH price %q", data.ETHUSD)
+	}
+	return price, nil
+}
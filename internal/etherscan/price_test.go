@@ -0,0 +1,115 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseEtherPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  string
+		want    float64
+		wantErr string
+	}{
+		{"Price", `{"ethbtc":"0.05","ethusd":"3200.5"}`, 3200.5, ""},
+		{"APIError", `"Invalid API Key"`, 0, "Invalid API Key"},
+		{"Empty", `{"ethbtc":"0.05","ethusd":""}`, 0, "invalid ETH price"},
+		{"Zero", `{"ethusd":"0"}`, 0, "invalid ETH price"},
+		{"NaN", `{"ethusd":"NaN"}`, 0, "invalid ETH price"},
+		{"Malformed", `[1,2]`, 0, "unexpected response format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEtherPrice(json.RawMessage(tt.result))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseEtherPrice() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchEtherPrice(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.Routes{"ethprice": etherscantest.EtherPrice})
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	for range 2 {
+		price, err := client.FetchEtherPrice(t.Context())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if price != 3200.5 {
+			t.Errorf("FetchEtherPrice() = %v; want 3200.5", price)
+		}
+	}
+	if got := server.Calls("ethprice"); got != 1 {
+		t.Errorf("expected the price to be cached after 1 request, got %d", got)
+	}
+
+	// A stale price is fetched again
+	client.priceAt = time.Now().Add(-priceTTL)
+	if _, err := client.FetchEtherPrice(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.Calls("ethprice"); got != 2 {
+		t.Errorf("expected an expired price to be fetched again, got %d requests", got)
+	}
+
+	// Testnets have no ETH price, so no request is made
+	client.SetChainID(11155111)
+	if price, err := client.FetchEtherPrice(t.Context()); err != nil || price != 0 {
+		t.Errorf("FetchEtherPrice() on Sepolia = %v, %v; want 0, nil", price, err)
+	}
+	if got := server.Calls("ethprice"); got != 2 {
+		t.Errorf("expected no request on Sepolia, got %d requests", got)
+	}
+}
+
+func TestFetchTransaction_EtherPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID int
+		price   string // fixture for the ethprice action, "" for none
+		want    float64
+	}{
+		{"Mainnet", 1, etherscantest.EtherPrice, 3200.5},
+		{"Price Unavailable", 1, etherscantest.RateLimit, 0},
+		{"Testnet", 11155111, etherscantest.EtherPrice, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["ethprice"] = tt.price
+			server := etherscantest.NewServer(t, routes)
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithChainID(tt.chainID))
+			client.SetRetryPolicy(0, 0)
+			client.SetUSDPrices(true)
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tx.EtherPriceUSD != tt.want {
+				t.Errorf("EtherPriceUSD = %v; want %v", tx.EtherPriceUSD, tt.want)
+			}
+			// A missing price only drops the USD figures, it isn't worth a warning
+			if len(tx.Warnings) != 0 {
+				t.Errorf("unexpected warnings: %v", tx.Warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Package etherscan provides probing of other known networks for a missing transaction.
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ProbeTransaction looks for a transaction on the networks in use (see SetChains)
+// other than the client's current one. Networks are probed in batches of the tuning's
+// ProbeConcurrency, one at a time by default, with the usual delay before each batch.
+// It is meant to follow an ErrTransactionNotFound, so a hash pasted while on the
+// wrong network can be redirected rather than dead-ending.
+// Parameters:
+//   - ctx: The context for the requests.
+//   - hash: The transaction hash to look for.
+//
+// Returns:
+//   - The first network, by chain ID, that has the transaction.
+//   - False if no other network in use has it, or a request failed.
+func (c *Client) ProbeTransaction(ctx context.Context, hash Hash) (Network, bool) {
+	ctx = c.withNetwork(ctx)
+	if c.apiKey == "" {
+		return Network{}, false
+	}
+
+	endStep := beginStep(ctx, stepProbe)
+	defer endStep()
+
+	for batch := range slices.Chunk(c.otherChains(c.networkFor(ctx).ChainID), max(c.tuning.ProbeConcurrency, 1)) {
+		if _, done, _ := throttle(ctx, c.tuning.ArtificialDelay); done {
+			return Network{}, false
+		}
+		found := make([]bool, len(batch))
+		errs := make([]error, len(batch))
+		var wg sync.WaitGroup
+		for i, id := range batch {
+			wg.Go(func() {
+				found[i], errs[i] = c.hasTransaction(ctx, id, hash)
+			})
+		}
+		wg.Wait()
+
+		// Results are checked in chain ID order, so the answer doesn't depend on which request returned first
+		for i, id := range batch {
+			if errs[i] != nil {
+				// A failed probe is no worse than not probing, so give up quietly
+				return Network{}, false
+			}
+			if found[i] {
+				return NetworkByID(id), true
+			}
+		}
+	}
+	return Network{}, false
+}
+
+// hasTransaction reports whether the chain with the given ID has a transaction with this hash.
+func (c *Client) hasTransaction(ctx context.Context, chainID int, hash Hash) (bool, error) {
+	url := fmt.Sprintf("%s?chainid=%d&module=proxy&action=eth_getTransactionByHash&txhash=%s&apikey=%s", c.baseURL, chainID, hash, c.apiKey)
+
+	proxyResp, err := doRequest[json.RawMessage](ctx, c, url)
+	if err != nil {
+		return false, err
+	}
+	var tx struct {
+		Hash Hash `json:"hash"`
+	}
+	if err := json.Unmarshal(proxyResp.Result, &tx); err != nil {
+		// A string result is an API message, e.g. an unsupported chain
+		var msg string
+		if json.Unmarshal(proxyResp.Result, &msg) == nil {
+			return false, errors.New(msg)
+		}
+		return false, err
+	}
+	return tx.Hash != "", nil
+}
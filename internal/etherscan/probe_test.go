@@ -0,0 +1,154 @@
+package etherscan
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProbeTransaction(t *testing.T) {
+	const hash Hash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+	tests := []struct {
+		name      string
+		foundOn   string // chainid query value that has the transaction
+		wantFound bool
+		wantChain int
+	}{
+		{"Found On Sepolia", "11155111", true, 11155111},
+		{"Found Nowhere", "", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var probed []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				chain := r.URL.Query().Get("chainid")
+				probed = append(probed, chain)
+				if chain == tt.foundOn {
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"` + string(hash) + `"}}`)) // nolint:errcheck // mock server
+					return
+				}
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetChains([]int{1, 11155111})
+
+			_, err := client.FetchTransaction(t.Context(), hash)
+			if !errors.Is(err, ErrTransactionNotFound) {
+				t.Fatalf("expected ErrTransactionNotFound on Mainnet, got %v", err)
+			}
+
+			probed = nil
+			n, found := client.ProbeTransaction(t.Context(), hash)
+			if found != tt.wantFound || n.ChainID != tt.wantChain {
+				t.Errorf("ProbeTransaction() = %+v, %v; want chain %d, %v", n, found, tt.wantChain, tt.wantFound)
+			}
+			// Only the other network in use is probed, never the current one
+			if len(probed) != 1 || probed[0] != "11155111" {
+				t.Errorf("expected a single probe of Sepolia, got %v", probed)
+			}
+		})
+	}
+}
+
+func TestProbeTransaction_Chains(t *testing.T) {
+	tests := []struct {
+		name       string
+		chains     []int
+		wantProbed []string
+	}{
+		{"Single Chain", []int{1}, nil},
+		{"Pinned Chains", []int{137, 1, 137}, []string{"137"}},
+		{"Default", nil, []string{"10", "137", "8453", "17000", "42161", "11155111"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var probed []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				probed = append(probed, r.URL.Query().Get("chainid"))
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			// One network at a time, so the probes arrive in a predictable order
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(Tuning{}))
+			client.SetChains(tt.chains)
+
+			if _, found := client.ProbeTransaction(t.Context(), "0xabc"); found {
+				t.Error("expected the transaction not to be found")
+			}
+			if !slices.Equal(probed, tt.wantProbed) {
+				t.Errorf("expected probes of %v, got %v", tt.wantProbed, probed)
+			}
+		})
+	}
+}
+
+func TestProbeTransaction_Concurrency(t *testing.T) {
+	const hash Hash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+	var (
+		mu             sync.Mutex
+		probed         []string
+		inFlight, peak int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chain := r.URL.Query().Get("chainid")
+		mu.Lock()
+		probed = append(probed, chain)
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		// Optimism answers last, but still wins over Polygon because its chain ID is lower
+		if chain == "10" {
+			time.Sleep(20 * time.Millisecond)
+		} else {
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		if chain == "10" || chain == "137" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"` + string(hash) + `"}}`)) // nolint:errcheck // mock server
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(Tuning{ProbeConcurrency: 2}))
+	client.SetChains([]int{1, 10, 137, 8453, 11155111})
+
+	n, found := client.ProbeTransaction(t.Context(), hash)
+	if !found || n.ChainID != 10 {
+		t.Errorf("ProbeTransaction() = %+v, %v; want chain 10", n, found)
+	}
+	// The first batch finds it, so Base and Sepolia in the second batch are never probed
+	slices.Sort(probed)
+	if want := []string{"10", "137"}; !slices.Equal(probed, want) {
+		t.Errorf("expected probes of %v, got %v", want, probed)
+	}
+	if peak != 2 {
+		t.Errorf("expected 2 probes in flight at once, got %d", peak)
+	}
+}
+
+func TestFastTuning(t *testing.T) {
+	fast, def := FastTuning(), DefaultTuning()
+	if fast.ArtificialDelay != 0 || fast.RateLimit != 0 {
+		t.Errorf("FastTuning() = %+v; want no delay and no rate limit", fast)
+	}
+	if fast.ProbeConcurrency <= def.ProbeConcurrency {
+		t.Errorf("FastTuning().ProbeConcurrency = %d; want more than the default %d", fast.ProbeConcurrency, def.ProbeConcurrency)
+	}
+}
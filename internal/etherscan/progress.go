@@ -0,0 +1,82 @@
+// Package etherscan provides step-level progress reporting for multi-request fetches.
+package etherscan
+
+import (
+	"context"
+	"sync"
+)
+
+// Labels reported for each fetch step.
+const (
+	stepTransaction   = "Fetching transaction…"
+	stepConfirmations = "Computing confirmations…"
+	stepReceipt       = "Fetching receipt…"
+	stepBlock         = "Fetching block details…"
+	stepAccount       = "Checking recipient account…"
+	stepRevert        = "Fetching revert reason…"
+	stepNonce         = "Checking sender history…"
+	stepENS           = "Resolving ENS names…"
+	stepPrice         = "Fetching ETH price…"
+	stepEnrich        = "Enriching transaction…"
+	stepProbe         = "Checking other networks…"
+	// stepDetails is reported instead of a specific label when several steps run at once.
+	stepDetails = "Fetching details…"
+)
+
+// Step is a progress event for one fetch step, reported when it starts and again when it finishes.
+type Step struct {
+	Label     string // what the step does
+	Done      bool   // true when the step has finished rather than started
+	Completed int    // steps finished so far in this fetch, including this one if Done
+}
+
+// ProgressFunc receives each fetch step as it starts and finishes.
+type ProgressFunc func(step Step)
+
+type progressKey struct{}
+
+// progress tracks how many steps are running so concurrent steps can be summarized,
+// and how many have finished so the UI can show how far along the fetch is.
+type progress struct {
+	mu        sync.Mutex
+	active    int
+	completed int
+	report    ProgressFunc
+}
+
+// WithProgress returns a context that reports fetch steps to fn.
+// Parameters:
+//   - ctx: The parent context.
+//   - fn: The callback invoked each time a step starts or finishes.
+//
+// Returns:
+//   - A derived context carrying the progress reporter.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, &progress{report: fn})
+}
+
+// beginStep reports that a fetch step has started and returns a function reporting it finished.
+// It is a no-op when the context carries no progress reporter.
+func beginStep(ctx context.Context, label string) func() {
+	p, ok := ctx.Value(progressKey{}).(*progress)
+	if !ok {
+		return func() {}
+	}
+
+	p.mu.Lock()
+	p.active++
+	started := label
+	if p.active > 1 {
+		started = stepDetails
+	}
+	p.report(Step{Label: started, Completed: p.completed})
+	p.mu.Unlock()
+
+	return func() {
+		p.mu.Lock()
+		p.active--
+		p.completed++
+		p.report(Step{Label: label, Done: true, Completed: p.completed})
+		p.mu.Unlock()
+	}
+}
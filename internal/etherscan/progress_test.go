@@ -0,0 +1,87 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestBeginStep(t *testing.T) {
+	t.Run("No Reporter", func(_ *testing.T) {
+		done := beginStep(context.Background(), stepReceipt)
+		done()
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		var got []Step
+		ctx := WithProgress(t.Context(), func(step Step) { got = append(got, step) })
+
+		beginStep(ctx, stepTransaction)()
+		beginStep(ctx, stepReceipt)()
+
+		want := []Step{
+			{Label: stepTransaction},
+			{Label: stepTransaction, Done: true, Completed: 1},
+			{Label: stepReceipt, Completed: 1},
+			{Label: stepReceipt, Done: true, Completed: 2},
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("got steps %+v; want %+v", got, want)
+		}
+	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		var got []string
+		ctx := WithProgress(t.Context(), func(step Step) {
+			if !step.Done {
+				got = append(got, step.Label)
+			}
+		})
+
+		doneReceipt := beginStep(ctx, stepReceipt)
+		doneBlock := beginStep(ctx, stepBlock)
+		doneReceipt()
+		doneBlock()
+		beginStep(ctx, stepAccount)()
+
+		want := []string{stepReceipt, stepDetails, stepAccount}
+		if !slices.Equal(got, want) {
+			t.Errorf("got steps %v; want %v", got, want)
+		}
+	})
+}
+
+func TestFetchTransaction_ReportsSteps(t *testing.T) {
+	server := etherscantest.NewServer(t, etherscantest.DefaultRoutes())
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+	var got []string
+	var completed []int
+	ctx := WithProgress(t.Context(), func(step Step) {
+		if step.Done {
+			completed = append(completed, step.Completed)
+		} else {
+			got = append(got, step.Label)
+		}
+	})
+	if _, err := client.FetchTransaction(ctx, testHash); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The confirmations, receipt and block are fetched at once, so which of them
+	// reports its own label, and which are summarized, depends on timing
+	if len(got) != 5 || got[0] != stepTransaction || got[4] != stepAccount {
+		t.Fatalf("got steps %v; want the transaction, three concurrent steps, then the account", got)
+	}
+	for _, step := range got[1:4] {
+		if !slices.Contains([]string{stepConfirmations, stepReceipt, stepBlock, stepDetails}, step) {
+			t.Errorf("unexpected concurrent step %q in %v", step, got)
+		}
+	}
+	// Every step reports finishing, counting up
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(completed, want) {
+		t.Errorf("got completed counts %v; want %v", completed, want)
+	}
+}
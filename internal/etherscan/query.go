@@ -0,0 +1,84 @@
+// Package etherscan provides classification of user search input.
+package etherscan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryKind identifies what a search input refers to.
+type QueryKind int
+
+const (
+	// QueryHash is a transaction hash (the default for unrecognized input).
+	QueryHash QueryKind = iota
+	// QueryAddressNonce is a sender address and nonce, written as "0xaddr#nonce".
+	QueryAddressNonce
+	// QueryAddress is a bare address, whose recent transactions are listed.
+	QueryAddress
+)
+
+// Query is a classified search input.
+type Query struct {
+	Kind    QueryKind
+	Hash    Hash    // set for QueryHash
+	Address Address // set for QueryAddressNonce and QueryAddress
+	Nonce   string  // decimal nonce, set for QueryAddressNonce
+}
+
+// Classify determines what a search input refers to so it can be dispatched
+// to the right lookup.
+// Parameters:
+//   - input: The raw search input.
+//
+// Returns:
+//   - The classified Query. Input that matches no other kind is treated as a hash.
+func Classify(input string) Query {
+	input = strings.TrimSpace(input)
+
+	if addr, nonce, ok := strings.Cut(input, "#"); ok && isAddress(addr) && isDecimal(nonce) {
+		return Query{Kind: QueryAddressNonce, Address: Address(addr), Nonce: nonce}
+	}
+	// 42 characters, so it can't be mistaken for a 66-character hash
+	if isAddress(input) {
+		return Query{Kind: QueryAddress, Address: Address(input)}
+	}
+
+	return Query{Kind: QueryHash, Hash: Hash(input)}
+}
+
+// ValidateTxHash checks that a transaction hash is well formed before it is
+// sent to the API, so a typo fails immediately instead of after a round trip.
+// Parameters:
+//   - hash: The hash as typed.
+//
+// Returns:
+//   - nil if hash is 0x followed by 64 hex digits.
+//   - An error wrapping ErrInvalidTxHash describing what is wrong otherwise.
+func ValidateTxHash(hash string) error {
+	const want = "expected 0x followed by 64 hex characters"
+	hexPart, ok := strings.CutPrefix(hash, "0x")
+	switch {
+	case !ok:
+		return fmt.Errorf("%w: %s, got no 0x prefix", ErrInvalidTxHash, want)
+	case len(hexPart) != 64:
+		return fmt.Errorf("%w: %s, got %d", ErrInvalidTxHash, want, len(hexPart))
+	case strings.TrimLeft(hexPart, "0123456789abcdefABCDEF") != "":
+		return fmt.Errorf("%w: %s, got non-hex characters", ErrInvalidTxHash, want)
+	}
+	return nil
+}
+
+// isAddress reports whether s is a 0x-prefixed 20-byte hex address.
+func isAddress(s string) bool {
+	if !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	_, ok := addressHex(s)
+	return ok
+}
+
+// isDecimal reports whether s is a non-empty string of decimal digits.
+func isDecimal(s string) bool {
+	return s != "" && strings.TrimLeft(s, "0123456789") == ""
+}
@@ -0,0 +1,88 @@
+package etherscan
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	const addr = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	tests := []struct {
+		name     string
+		input    string
+		expected Query
+	}{
+		{"Hash", "0xabc", Query{Kind: QueryHash, Hash: "0xabc"}},
+		{"Address And Nonce", addr + "#5", Query{Kind: QueryAddressNonce, Address: addr, Nonce: "5"}},
+		{"Trims Whitespace", "  " + addr + "#0 ", Query{Kind: QueryAddressNonce, Address: addr, Nonce: "0"}},
+		{"Missing Nonce", addr + "#", Query{Kind: QueryHash, Hash: addr + "#"}},
+		{"Hex Nonce", addr + "#0x5", Query{Kind: QueryHash, Hash: addr + "#0x5"}},
+		{"Short Address", "0xabc#5", Query{Kind: QueryHash, Hash: "0xabc#5"}},
+		{"Address", addr, Query{Kind: QueryAddress, Address: addr}},
+		{"Address Trims Whitespace", " " + addr + " ", Query{Kind: QueryAddress, Address: addr}},
+		{"Address Too Short", addr[:41], Query{Kind: QueryHash, Hash: Hash(addr[:41])}},
+		{"Address Not Hex", addr[:41] + "z", Query{Kind: QueryHash, Hash: Hash(addr[:41] + "z")}},
+		{"Full Hash", "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060", Query{Kind: QueryHash, Hash: "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.input); got != tt.expected {
+				t.Errorf("Classify(%q) = %+v; want %+v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateTxHash(t *testing.T) {
+	valid := "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+	tests := []struct {
+		name    string
+		hash    string
+		wantErr string
+	}{
+		{"Valid", valid, ""},
+		{"Valid Uppercase", "0x" + strings.ToUpper(valid[2:]), ""},
+		{"Too Short", valid[:65], "got 63"},
+		{"Too Long", valid + "0", "got 65"},
+		{"Empty", "", "no 0x prefix"},
+		{"Missing Prefix", valid[2:] + "00", "no 0x prefix"},
+		{"Non Hex", valid[:65] + "g", "non-hex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTxHash(tt.hash)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateTxHash(%q) = %v; want nil", tt.hash, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidTxHash) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateTxHash(%q) = %v; want ErrInvalidTxHash mentioning %q", tt.hash, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_InvalidHash(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	_, err := client.FetchTransaction(t.Context(), "0xabc")
+	if !errors.Is(err, ErrInvalidTxHash) {
+		t.Errorf("FetchTransaction() error = %v; want ErrInvalidTxHash", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("expected no requests for an invalid hash, got %d", n)
+	}
+}
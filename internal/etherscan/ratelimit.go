@@ -0,0 +1,60 @@
+// Package etherscan paces outbound API requests with a token bucket.
+package etherscan
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket: it holds up to burst tokens, refilled at rate
+// tokens per second, and each request takes one, waiting for it if none are left.
+// It is safe for concurrent use.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64   // tokens added per second
+	burst  float64   // most tokens held
+	tokens float64   // tokens available as of last; negative when requests are waiting
+	last   time.Time // when tokens was last brought up to date
+}
+
+// newRateLimiter creates a full bucket allowing rps requests per second with
+// bursts of up to burst. A rate of zero or less returns nil, which never waits.
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if rps <= 0 {
+		return nil
+	}
+	b := float64(max(burst, 1))
+	return &rateLimiter{rate: rps, burst: b, tokens: b, last: time.Now()}
+}
+
+// wait takes a token, blocking until one is available. It returns the context's
+// error, handing the token back, if ctx is done first.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,94 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimit_SpacesRequests(t *testing.T) {
+	const (
+		rps      = 20
+		burst    = 2
+		requests = 8
+	)
+
+	var mu sync.Mutex
+	var seen []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, time.Now())
+		mu.Unlock()
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()), WithRateLimit(rps, burst))
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Go(func() {
+			if _, err := client.doRequestWithRetry(t.Context(), server.URL+"?action=eth_blockNumber", true); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if len(seen) != requests {
+		t.Fatalf("expected %d requests, got %d", requests, len(seen))
+	}
+	slices.SortFunc(seen, func(a, b time.Time) int { return a.Compare(b) })
+	// After the burst, request i can't arrive before (i-burst+1) intervals have passed
+	interval := time.Second / rps
+	slack := interval / 4
+	for i := burst; i < requests; i++ {
+		if got, want := seen[i].Sub(seen[0]), time.Duration(i-burst+1)*interval-slack; got < want {
+			t.Errorf("request %d arrived %v after the first; want at least %v", i, got, want)
+		}
+	}
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	if err := (*rateLimiter)(nil).wait(t.Context()); err != nil {
+		t.Errorf("expected an unlimited limiter not to wait, got %v", err)
+	}
+	if newRateLimiter(0, 5) != nil {
+		t.Errorf("expected a zero rate to disable the limiter")
+	}
+
+	l := newRateLimiter(1, 1)
+	if err := l.wait(t.Context()); err != nil {
+		t.Fatalf("expected the first token without waiting, got %v", err)
+	}
+
+	// A cancelled search doesn't sit waiting for the next token
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait() = %v; want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected wait to return when the context ended, took %v", elapsed)
+	}
+	if l.tokens < -0.5 {
+		t.Errorf("expected the cancelled wait to hand its token back, got %v tokens", l.tokens)
+	}
+}
+
+func TestClient_RateLimitTuning(t *testing.T) {
+	if client := NewClient("test"); client.limiter == nil || client.Tuning().RateLimit != defaultRateLimit {
+		t.Errorf("expected the default rate limit, got %+v", client.Tuning())
+	}
+	if client := NewClient("test", WithTuning(FastTuning())); client.limiter != nil {
+		t.Errorf("expected fast mode to remove the rate limit")
+	}
+	if client := NewClient("test", WithRateLimit(2, 3)); client.Tuning().RateLimit != 2 || client.Tuning().RateBurst != 3 || client.Tuning().ArtificialDelay != defaultArtificialDelay {
+		t.Errorf("expected WithRateLimit to change only the limit, got %+v", client.Tuning())
+	}
+}
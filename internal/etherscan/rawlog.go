@@ -0,0 +1,142 @@
+// Package etherscan provides logging of raw API responses to a size-rotated file.
+package etherscan
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRawLogMaxBytes is the size at which a raw response log is rotated.
+	DefaultRawLogMaxBytes = 5 << 20
+	// DefaultRawLogBackups is the number of rotated raw response logs kept.
+	DefaultRawLogBackups = 3
+)
+
+// apiKeyParam matches the API key query parameter so it can be redacted from logged URLs.
+var apiKeyParam = regexp.MustCompile(`(?i)(apikey=)[^&]*`)
+
+// RotatingFile is an append-only log file that is rotated once it reaches a size limit.
+// The file at path is renamed to path.1, path.1 to path.2 and so on, dropping the oldest.
+// It is safe for concurrent use, and each Write is kept whole within one file.
+type RotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxBytes int64
+	backups  int
+	f        *os.File
+	size     int64
+}
+
+// NewRotatingFile opens path for appending, creating it if needed.
+// Parameters:
+//   - path: The log file to write.
+//   - maxBytes: The size at which the file is rotated.
+//   - backups: The number of rotated files to keep.
+//
+// Returns:
+//   - The opened rotating file.
+//   - An error if the file cannot be opened.
+func NewRotatingFile(path string, maxBytes int64, backups int) (*RotatingFile, error) {
+	r := &RotatingFile{path: path, maxBytes: maxBytes, backups: backups}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write appends p to the file, rotating first if p would push it past the size limit.
+// Parameters:
+//   - p: The bytes to write.
+//
+// Returns:
+//   - The number of bytes written.
+//   - An error if rotation or the write fails.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A single entry larger than the limit still goes into a fresh file rather than being split
+	if r.size > 0 && r.size+int64(len(p)) > r.maxBytes {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the current log file.
+// Returns:
+//   - An error if closing the file fails.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
+
+// open opens the log file for appending and records its current size.
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open raw response log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close() // nolint:errcheck // the stat error takes precedence
+		return fmt.Errorf("failed to open raw response log: %w", err)
+	}
+	r.f = f
+	r.size = info.Size()
+	return nil
+}
+
+// rotate shifts the backups along, moves the current file to path.1 and reopens path.
+func (r *RotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+	for i := r.backups - 1; i >= 1; i-- {
+		// Missing backups are expected until the log has rotated enough times
+		_ = os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if r.backups > 0 {
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return fmt.Errorf("failed to rotate raw response log: %w", err)
+		}
+	} else if err := os.Remove(r.path); err != nil {
+		return fmt.Errorf("failed to rotate raw response log: %w", err)
+	}
+	return r.open()
+}
+
+// SetRawResponseLog appends every raw API response, with its redacted request URL, to w.
+// Parameters:
+//   - w: The destination, typically a RotatingFile. Nil disables logging.
+func (c *Client) SetRawResponseLog(w io.Writer) {
+	c.rawLog = w
+}
+
+// logRawResponse writes one response to the raw response log, if enabled.
+// Logging is best-effort: a failing log must not fail the request.
+func (c *Client) logRawResponse(url string, status int, body []byte) {
+	if c.rawLog == nil {
+		return
+	}
+	var entry bytes.Buffer
+	fmt.Fprintf(&entry, "%s GET %s status=%d\n", time.Now().UTC().Format(time.RFC3339), redactAPIKey(url), status)
+	entry.Write(bytes.TrimSpace(body))
+	entry.WriteString("\n\n")
+	_, _ = c.rawLog.Write(entry.Bytes())
+}
+
+// redactAPIKey hides the API key in a request URL.
+func redactAPIKey(url string) string {
+	return apiKeyParam.ReplaceAllString(url, "${1}REDACTED")
+}
@@ -0,0 +1,94 @@
+package etherscan
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "raw.log")
+	r, err := NewRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatalf("NewRotatingFile failed: %v", err)
+	}
+
+	for _, entry := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := r.Write([]byte(entry)); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := r.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	// Each entry overflows the 10 byte limit, so every write after the first rotates
+	expected := map[string]string{
+		path:        "fourth\n",
+		path + ".1": "third\n",
+		path + ".2": "second\n",
+	}
+	for p, want := range expected {
+		got, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("reading %s: %v", p, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s = %q; want %q", filepath.Base(p), got, want)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected only 2 backups to be kept, stat .3: %v", err)
+	}
+}
+
+func TestRotatingFile_AppendsToExisting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "raw.log")
+	if err := os.WriteFile(path, []byte("earlier session\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewRotatingFile(path, 1<<10, 1)
+	if err != nil {
+		t.Fatalf("NewRotatingFile failed: %v", err)
+	}
+	if _, err := r.Write([]byte("this session\n")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	r.Close() // nolint:errcheck // test file
+
+	got, _ := os.ReadFile(path)
+	if string(got) != "earlier session\nthis session\n" {
+		t.Errorf("expected entries appended, got %q", got)
+	}
+}
+
+func TestRawResponseLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xb"}`)) // nolint:errcheck // mock server
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	client := NewClient("secret-key", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetRawResponseLog(&logs)
+
+	if _, err := client.FetchLatestBlockNumber(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := logs.String()
+	for _, want := range []string{"action=eth_blockNumber", "apikey=REDACTED", "status=200", `"result":"0xb"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected raw log to contain %q, got %q", want, got)
+		}
+	}
+	if strings.Contains(got, "secret-key") {
+		t.Errorf("raw log must not contain the API key, got %q", got)
+	}
+}
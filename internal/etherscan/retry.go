@@ -6,27 +6,101 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
 )
 
+const (
+	// defaultMaxRetries is how many times a failed read is retried after the first attempt.
+	defaultMaxRetries = 3
+	// defaultRetryBaseDelay is the backoff before the first retry; it doubles for each later one.
+	defaultRetryBaseDelay = time.Second
+	// maxRetryBackoff caps the doubling, so a large retry count keeps waiting rather than overflowing.
+	maxRetryBackoff = 30 * time.Second
+)
+
+// readActions lists the API actions that only read chain state, so repeating one
+// can't repeat a side effect. Actions missing here, such as eth_sendRawTransaction,
+// are never retried.
+var readActions = map[string]bool{
+	"eth_blockNumber":          true,
+	"eth_call":                 true,
+	"eth_estimateGas":          true,
+	"eth_getBlockByNumber":     true,
+	"eth_getCode":              true,
+	"eth_getTransactionByHash": true,
+	"eth_getTransactionByBlockNumberAndIndex": true,
+	"eth_getTransactionCount":                 true,
+	"eth_getTransactionReceipt":               true,
+	"balance":                                 true,
+	"ethprice":                                true,
+	"getLogs":                                 true,
+	"tokentx":                                 true,
+	"txlist":                                  true,
+	"txlistinternal":                          true,
+}
+
+// SetRetryPolicy sets how failed reads are retried: network errors and the
+// per-second rate limit are retried up to maxRetries times after the first
+// attempt, waiting baseDelay before the first retry and doubling it for each
+// later one, up to 30s. The defaults are 3 retries starting at 1s.
+// Parameters:
+//   - maxRetries: The number of retries. Negative values are treated as 0.
+//   - baseDelay: The backoff before the first retry. Non-positive values retry immediately.
+func (c *Client) SetRetryPolicy(maxRetries int, baseDelay time.Duration) {
+	c.maxRetries = max(0, maxRetries)
+	c.retryBaseDelay = max(0, baseDelay)
+}
+
+// isIdempotent reports whether a request for the given API action is safe to retry.
+func isIdempotent(action string) bool {
+	return readActions[action]
+}
+
+// retryBackoff returns the exponential backoff before the given retry (1 for the
+// first), e.g. 1s, 2s, 4s, capped at maxRetryBackoff.
+func retryBackoff(base time.Duration, retry int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	d := min(base, maxRetryBackoff)
+	for range retry - 1 {
+		if d >= maxRetryBackoff/2 {
+			return maxRetryBackoff
+		}
+		d *= 2
+	}
+	return d
+}
+
 // doRequestWithRetry performs an HTTP GET request with exponential backoff retries.
+// Each attempt waits its turn with the rate limiter and is bounded by the
+// timeout configured for the URL's API action.
 // Parameters:
 //   - ctx: The context for the request.
 //   - url: The URL to fetch.
+//   - idempotent: Whether the request may be retried. A non-idempotent request
+//     is attempted once, since a lost response doesn't mean it had no effect.
 //
 // Returns:
 //   - The response body as a byte slice.
 //   - An error if all retry attempts fail or the context is cancelled.
-func (c *Client) doRequestWithRetry(ctx context.Context, url string) ([]byte, error) {
-	maxRetries := 3
+func (c *Client) doRequestWithRetry(ctx context.Context, url string, idempotent bool) ([]byte, error) {
+	maxRetries := c.maxRetries
+	if !idempotent {
+		maxRetries = 0
+	}
+	timeout := c.timeoutForURL(url)
+	logger := c.requestLogger(url)
 	var lastErr error
 
 	for i := range maxRetries + 1 {
 		if i > 0 {
-			// Exponential backoff: 1s, 2s, 4s
-			backoff := time.Duration(1<<uint(i-1)) * time.Second
+			backoff := retryBackoff(c.retryBaseDelay, i)
+			logger.Debug("etherscan: retrying", slog.Int("attempt", i+1), slog.Duration("backoff", backoff),
+				slog.String("error", redactAPIKey(lastErr.Error())))
 			select {
 			case <-time.After(backoff):
 			case <-ctx.Done():
@@ -34,42 +108,49 @@ func (c *Client) doRequestWithRetry(ctx context.Context, url string) ([]byte, er
 			}
 		}
 
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		// Wait for the rate limiter outside the attempt's deadline, which only covers the request
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		// Each attempt gets its own deadline so a slow attempt doesn't starve the retries
+		reqCtx, cancel := context.WithTimeout(ctx, timeout)
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, url, nil)
 		if err != nil {
+			cancel()
 			return nil, err
 		}
 
+		start := time.Now()
 		resp, err := c.http.Do(req)
 		if err != nil {
-			lastErr = err
+			cancel()
+			// The error includes the request URL, and with it the API key
+			logger.Debug("etherscan: request failed", slog.Int("attempt", i+1), slog.Duration("elapsed", time.Since(start)),
+				slog.String("error", redactAPIKey(err.Error())))
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = &NetworkError{Err: err}
 			continue
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
+		cancel()
 		if err != nil {
 			lastErr = err
 			continue
 		}
+		logResponse(logger, i+1, resp.StatusCode, time.Since(start), body)
+		c.logRawResponse(url, resp.StatusCode, body)
 
-		// Check for rate limit error in body
-		bodyString := string(body)
-		if strings.Contains(bodyString, "Max calls per sec rate limit reached") || strings.Contains(bodyString, "rate limit") {
-			lastErr = fmt.Errorf("Etherscan API error: %s", strings.TrimSpace(bodyString))
-			if strings.Contains(bodyString, "{") {
-				// If it's JSON, try to extract message
-				var proxyResp ProxyResponse[json.RawMessage]
-				if json.Unmarshal(body, &proxyResp) == nil {
-					if proxyResp.Error != nil {
-						lastErr = fmt.Errorf("Etherscan API error: %s", proxyResp.Error.Message)
-					} else {
-						var msg string
-						if json.Unmarshal(proxyResp.Result, &msg) == nil {
-							lastErr = fmt.Errorf("Etherscan API error: %s", msg)
-						}
-					}
-				}
-			}
+		switch msg := apiMessage(body); ClassifyAPIMessage(msg) {
+		case KindQuotaExceeded:
+			// The daily quota won't recover within the backoff window, so fail fast
+			return nil, fmt.Errorf("%w (%s)", ErrQuotaExceeded, msg)
+		case KindRateLimited:
+			lastErr = newAPIError(msg)
 			continue
 		}
 
@@ -78,3 +159,25 @@ func (c *Client) doRequestWithRetry(ctx context.Context, url string) ([]byte, er
 
 	return nil, lastErr
 }
+
+// apiMessage extracts the API's message from a response body, falling back to the raw body.
+func apiMessage(body []byte) string {
+	msg := strings.TrimSpace(string(body))
+	if !strings.Contains(msg, "{") {
+		return msg
+	}
+
+	// If it's JSON, try to extract message
+	var proxyResp ProxyResponse[json.RawMessage]
+	if json.Unmarshal(body, &proxyResp) != nil {
+		return msg
+	}
+	if proxyResp.Error != nil {
+		return proxyResp.Error.Message
+	}
+	var result string
+	if json.Unmarshal(proxyResp.Result, &result) == nil {
+		return result
+	}
+	return msg
+}
@@ -2,6 +2,7 @@ package etherscan
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -25,13 +26,13 @@ func TestDoRequestWithRetry(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := NewClient("test")
-	client.baseURL = server.URL
+	client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+	client.SetRetryPolicy(3, time.Millisecond)
 
 	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 	defer cancel()
 
-	body, err := client.doRequestWithRetry(ctx, server.URL)
+	body, err := client.doRequestWithRetry(ctx, server.URL, true)
 	if err != nil {
 		t.Fatalf("doRequestWithRetry failed: %v", err)
 	}
@@ -44,3 +45,207 @@ func TestDoRequestWithRetry(t *testing.T) {
 		t.Errorf("expected 3 attempts, got %d", attempts)
 	}
 }
+
+func TestDoRequestWithRetry_RateLimitKinds(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		quotaExceeded bool
+	}{
+		{"Per-Second Limit Retries", `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`, false},
+		{"Daily Limit Fails Fast", `{"status":"0","message":"NOTOK","result":"Daily limit reached"}`, true},
+		{"Daily Rate Limit Fails Fast", `{"status":"0","message":"NOTOK","result":"Max daily rate limit reached. 100000 calls per day"}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := int32(0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				w.Write([]byte(tt.body)) // nolint:errcheck // mock
+			}))
+			defer server.Close()
+
+			client := NewClient("test")
+			client.SetRetryPolicy(3, time.Millisecond)
+
+			ctx, cancel := context.WithTimeout(t.Context(), 1500*time.Millisecond)
+			defer cancel()
+
+			_, err := client.doRequestWithRetry(ctx, server.URL, true)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := errors.Is(err, ErrQuotaExceeded); got != tt.quotaExceeded {
+				t.Errorf("errors.Is(err, ErrQuotaExceeded) = %v; want %v (err: %v)", got, tt.quotaExceeded, err)
+			}
+
+			n := atomic.LoadInt32(&attempts)
+			if tt.quotaExceeded && n != 1 {
+				t.Errorf("expected the daily limit to fail after 1 attempt, got %d", n)
+			}
+			if !tt.quotaExceeded && n < 2 {
+				t.Errorf("expected the per-second limit to be retried, got %d attempts", n)
+			}
+		})
+	}
+}
+
+func TestDoRequest_NonIdempotentNotRetried(t *testing.T) {
+	tests := []struct {
+		action       string
+		wantAttempts int32
+	}{
+		{"eth_sendRawTransaction", 1},
+		{"eth_getTransactionByHash", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			attempts := int32(0)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"Max calls per sec rate limit reached"}`)) // nolint:errcheck // mock
+					return
+				}
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)) // nolint:errcheck // mock
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetRetryPolicy(3, time.Millisecond)
+
+			_, err := doRequest[string](t.Context(), client, server.URL+"?module=proxy&action="+tt.action)
+			if n := atomic.LoadInt32(&attempts); n != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, n)
+			}
+			if tt.wantAttempts == 1 && err == nil {
+				t.Error("expected the rate-limited write to fail without a retry")
+			}
+			if tt.wantAttempts > 1 && err != nil {
+				t.Errorf("expected the retried read to succeed, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_RetryOnRateLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		firstResult  string
+		wantErr      bool
+		wantAttempts int32 // eth_getTransactionByHash requests
+	}{
+		{"Rate Limit Retried", "Max calls per sec rate limit reached", false, 2},
+		{"Not Found Fails Fast", "Error! Transaction hash not found", true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("action") {
+				case "eth_getTransactionByHash":
+					if attempts.Add(1) == 1 {
+						w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + tt.firstResult + `"}`)) // nolint:errcheck // mock
+						return
+					}
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc","blockNumber":"0xb"}}`)) // nolint:errcheck // mock
+				case "eth_blockNumber":
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xb"}`)) // nolint:errcheck // mock
+				default:
+					w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`)) // nolint:errcheck // mock
+				}
+			}))
+			defer server.Close()
+
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+			client.SetRetryPolicy(3, time.Millisecond)
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("FetchTransaction() error = %v; want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tx.Hash != "0xabc" {
+				t.Errorf("expected the retried fetch to return 0xabc, got %q", tx.Hash)
+			}
+			if n := attempts.Load(); n != tt.wantAttempts {
+				t.Errorf("expected %d eth_getTransactionByHash requests, got %d", tt.wantAttempts, n)
+			}
+		})
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"Max calls per sec rate limit reached"}`)) // nolint:errcheck // mock
+	}))
+	defer server.Close()
+
+	client := NewClient("test")
+	client.SetRetryPolicy(1, time.Millisecond)
+	if _, err := client.doRequestWithRetry(t.Context(), server.URL, true); err == nil {
+		t.Fatal("expected the rate limit to fail once retries run out")
+	}
+	if n := attempts.Load(); n != 2 {
+		t.Errorf("expected 2 attempts with 1 retry, got %d", n)
+	}
+
+	// Cancellation cuts a long backoff short
+	client.SetRetryPolicy(3, time.Hour)
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.doRequestWithRetry(ctx, server.URL, true); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the backoff to end with the context, got %v", err)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name  string
+		base  time.Duration
+		retry int
+		want  time.Duration
+	}{
+		{"First Retry", time.Second, 1, time.Second},
+		{"Doubles", time.Second, 3, 4 * time.Second},
+		{"Capped", time.Second, 10, maxRetryBackoff},
+		// A plain shift would overflow to a negative or zero wait here
+		{"Huge Retry Count", time.Second, 64, maxRetryBackoff},
+		{"Base Above Cap", time.Hour, 1, maxRetryBackoff},
+		{"No Base Delay", 0, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryBackoff(tt.base, tt.retry); got != tt.want {
+				t.Errorf("retryBackoff(%v, %d) = %v; want %v", tt.base, tt.retry, got, tt.want)
+			}
+		})
+	}
+
+	// Every retry of a high retry count still waits, and never less than the one before
+	prev := time.Duration(0)
+	for retry := 1; retry <= 1000; retry++ {
+		got := retryBackoff(time.Second, retry)
+		if got < prev || got <= 0 {
+			t.Fatalf("retryBackoff(1s, %d) = %v after %v; want a positive, non-decreasing backoff", retry, got, prev)
+		}
+		prev = got
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	for _, action := range []string{"eth_call", "eth_getTransactionByHash", "txlist"} {
+		if !isIdempotent(action) {
+			t.Errorf("expected %s to be idempotent", action)
+		}
+	}
+	for _, action := range []string{"eth_sendRawTransaction", ""} {
+		if isIdempotent(action) {
+			t.Errorf("expected %q not to be retried", action)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Package etherscan provides portable, read-only transaction snapshots.
+package etherscan
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// SnapshotVersion is the snapshot format version written by WriteSnapshot.
+// Bump it whenever a change to Snapshot or Transaction would make older readers misinterpret a file.
+const SnapshotVersion = 1
+
+// Snapshot is a self-contained record of a fetched transaction that can be shared and viewed offline.
+type Snapshot struct {
+	Version     int          `json:"version"`
+	ChainID     int          `json:"chainId"`
+	Network     string       `json:"network"`
+	FetchedAt   time.Time    `json:"fetchedAt"`
+	Transaction *Transaction `json:"transaction"`
+}
+
+// NewSnapshot records a transaction fetched by the client on its current network.
+// Parameters:
+//   - tx: The fully processed transaction.
+//   - fetchedAt: When the transaction was fetched.
+//
+// Returns:
+//   - A snapshot at the current format version.
+func (c *Client) NewSnapshot(tx *Transaction, fetchedAt time.Time) *Snapshot {
+	n := c.Network()
+	return &Snapshot{
+		Version:     SnapshotVersion,
+		ChainID:     n.ChainID,
+		Network:     n.Name,
+		FetchedAt:   fetchedAt.UTC(),
+		Transaction: tx,
+	}
+}
+
+// WriteSnapshot encodes a snapshot to w as indented JSON.
+// Parameters:
+//   - w: The destination for the snapshot.
+//   - s: The snapshot to write.
+//
+// Returns:
+//   - An error if encoding or writing fails.
+func WriteSnapshot(w io.Writer, s *Snapshot) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// ReadSnapshot decodes and validates a snapshot from r.
+// Parameters:
+//   - r: The source of the snapshot JSON.
+//
+// Returns:
+//   - The decoded snapshot.
+//   - An error if the data is not a snapshot or uses an unsupported version.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	switch {
+	case s.Version == 0:
+		return nil, errors.New("not a transaction snapshot: missing version")
+	case s.Version > SnapshotVersion:
+		return nil, fmt.Errorf("unsupported snapshot version %d (this build reads up to %d)", s.Version, SnapshotVersion)
+	case s.Transaction == nil:
+		return nil, errors.New("snapshot has no transaction")
+	}
+	return &s, nil
+}
+
+// SaveSnapshot writes a snapshot to the file at path, replacing it if it exists.
+// Parameters:
+//   - path: The file to write.
+//   - s: The snapshot to save.
+//
+// Returns:
+//   - An error if the file cannot be written.
+func SaveSnapshot(path string, s *Snapshot) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	err = WriteSnapshot(f, s)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot from the file at path.
+// Parameters:
+//   - path: The snapshot file to read.
+//
+// Returns:
+//   - The loaded snapshot.
+//   - An error if the file cannot be read or is not a valid snapshot.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer f.Close() // nolint:errcheck // read-only file
+	return ReadSnapshot(f)
+}
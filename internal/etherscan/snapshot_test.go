@@ -0,0 +1,66 @@
+package etherscan
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	client := NewClient("test-key")
+	client.SetChainID(11155111)
+	tx := &Transaction{
+		Hash:     "0xabc",
+		Status:   "success",
+		Value:    "1.5 ETH",
+		ValueWei: "1500000000000000000",
+		Labels:   map[Address]string{"0xfrom": "Alice"},
+		Warnings: []string{"something odd"},
+	}
+	fetchedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	path := filepath.Join(t.TempDir(), "tx.snapshot.json")
+	if err := SaveSnapshot(path, client.NewSnapshot(tx, fetchedAt)); err != nil {
+		t.Fatalf("SaveSnapshot failed: %v", err)
+	}
+	got, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot failed: %v", err)
+	}
+
+	if got.Version != SnapshotVersion || got.ChainID != 11155111 || got.Network != "Sepolia" {
+		t.Errorf("unexpected metadata: version %d, chain %d, network %q", got.Version, got.ChainID, got.Network)
+	}
+	if !got.FetchedAt.Equal(fetchedAt) {
+		t.Errorf("FetchedAt = %v; want %v", got.FetchedAt, fetchedAt)
+	}
+	if got.Transaction.Hash != tx.Hash || got.Transaction.ValueWei != tx.ValueWei || got.Transaction.Label("0xFROM") != "Alice" {
+		t.Errorf("transaction not preserved: %+v", got.Transaction)
+	}
+	if len(got.Transaction.Warnings) != 1 || got.Transaction.Warnings[0] != "something odd" {
+		t.Errorf("warnings not preserved: %v", got.Transaction.Warnings)
+	}
+}
+
+func TestReadSnapshot_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{"not json", "hello", "failed to decode snapshot"},
+		{"missing version", `{"transaction": {"hash": "0xabc"}}`, "missing version"},
+		{"future version", `{"version": 99, "transaction": {"hash": "0xabc"}}`, "unsupported snapshot version 99"},
+		{"no transaction", `{"version": 1}`, "no transaction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadSnapshot(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ReadSnapshot() error = %v; want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package etherscan defines per-action request timeouts for the client.
+package etherscan
+
+import (
+	"maps"
+	"net/url"
+	"time"
+)
+
+const (
+	// defaultRequestTimeout applies to any action without its own entry.
+	defaultRequestTimeout = 15 * time.Second
+	// quickRequestTimeout applies to cheap status polls that should fail fast.
+	quickRequestTimeout = 5 * time.Second
+	// bulkRequestTimeout applies to account and log queries that scan many records.
+	bulkRequestTimeout = 30 * time.Second
+)
+
+// defaultTimeouts maps Etherscan API actions to their request timeouts.
+var defaultTimeouts = map[string]time.Duration{
+	"eth_blockNumber": quickRequestTimeout,
+	"txlist":          bulkRequestTimeout,
+	"txlistinternal":  bulkRequestTimeout,
+	"tokentx":         bulkRequestTimeout,
+	"getLogs":         bulkRequestTimeout,
+}
+
+// DefaultTimeouts returns a copy of the built-in per-action request timeouts.
+func DefaultTimeouts() map[string]time.Duration {
+	return maps.Clone(defaultTimeouts)
+}
+
+// SetTimeout overrides the request timeout for a single API action.
+// Parameters:
+//   - action: The Etherscan action name (e.g., "txlist", "eth_blockNumber").
+//   - d: The timeout to apply. Zero or negative removes the override.
+func (c *Client) SetTimeout(action string, d time.Duration) {
+	if d <= 0 {
+		delete(c.timeouts, action)
+		return
+	}
+	c.timeouts[action] = d
+}
+
+// SetDefaultTimeout sets the request timeout for actions without their own entry.
+// Parameters:
+//   - d: The timeout to apply. Zero or negative restores the built-in default.
+func (c *Client) SetDefaultTimeout(d time.Duration) {
+	if d <= 0 {
+		d = defaultRequestTimeout
+	}
+	c.defaultTimeout = d
+}
+
+// Timeout returns the request timeout applied to the given API action.
+// Parameters:
+//   - action: The Etherscan action name.
+//
+// Returns:
+//   - The per-action timeout, or the client's default timeout.
+func (c *Client) Timeout(action string) time.Duration {
+	if d, ok := c.timeouts[action]; ok {
+		return d
+	}
+	return c.defaultTimeout
+}
+
+// timeoutForURL returns the timeout for the action named in a request URL's query.
+func (c *Client) timeoutForURL(rawURL string) time.Duration {
+	return c.Timeout(actionFromURL(rawURL))
+}
+
+// actionFromURL returns the API action named in a request URL's query, or "" if there is none.
+func actionFromURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("action")
+}
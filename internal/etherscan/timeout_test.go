@@ -0,0 +1,107 @@
+package etherscan
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_Timeout(t *testing.T) {
+	client := NewClient("test")
+
+	if got := client.Timeout("eth_getTransactionByHash"); got != defaultRequestTimeout {
+		t.Errorf("Expected default timeout %v, got %v", defaultRequestTimeout, got)
+	}
+	if got := client.Timeout("eth_blockNumber"); got != quickRequestTimeout {
+		t.Errorf("Expected quick timeout %v, got %v", quickRequestTimeout, got)
+	}
+	if got := client.Timeout("txlist"); got != bulkRequestTimeout {
+		t.Errorf("Expected bulk timeout %v, got %v", bulkRequestTimeout, got)
+	}
+
+	client.SetTimeout("txlist", time.Minute)
+	if got := client.Timeout("txlist"); got != time.Minute {
+		t.Errorf("Expected overridden timeout 1m, got %v", got)
+	}
+	client.SetTimeout("txlist", 0)
+	if got := client.Timeout("txlist"); got != defaultRequestTimeout {
+		t.Errorf("Expected removed override to fall back to default, got %v", got)
+	}
+
+	client.SetDefaultTimeout(time.Second)
+	if got := client.Timeout("eth_call"); got != time.Second {
+		t.Errorf("Expected new default timeout 1s, got %v", got)
+	}
+
+	// The built-in table must not be mutated through a client
+	if DefaultTimeouts()["txlist"] != bulkRequestTimeout {
+		t.Error("SetTimeout modified the built-in timeout table")
+	}
+}
+
+func TestDoRequest_PerActionTimeout(t *testing.T) {
+	deadlines := make(map[string]time.Duration)
+
+	client := NewClient("test")
+	client.http = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		deadline, ok := r.Context().Deadline()
+		if !ok {
+			t.Errorf("request for %s has no deadline", r.URL.Query().Get("action"))
+		}
+		deadlines[r.URL.Query().Get("action")] = time.Until(deadline)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)),
+		}, nil
+	})}
+	client.SetTimeout("eth_getCode", 2*time.Second)
+
+	tests := []struct {
+		action   string
+		expected time.Duration
+	}{
+		{"eth_blockNumber", quickRequestTimeout},
+		{"txlist", bulkRequestTimeout},
+		{"eth_getCode", 2 * time.Second},
+		{"eth_getTransactionByHash", defaultRequestTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			if _, err := client.doRequestWithRetry(t.Context(), client.baseURL+"?module=proxy&action="+tt.action, true); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := deadlines[tt.action]
+			if got > tt.expected || got < tt.expected-time.Second {
+				t.Errorf("Expected a deadline of ~%v for %s, got %v", tt.expected, tt.action, got)
+			}
+		})
+	}
+}
+
+func TestDoRequest_TimeoutExpires(t *testing.T) {
+	client := NewClient("test")
+	client.SetDefaultTimeout(time.Millisecond)
+	client.http = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	})}
+
+	// The parent context bounds the retries so the test stays fast
+	ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.doRequestWithRetry(ctx, client.baseURL+"?action=eth_call", true); err == nil {
+		t.Error("expected an error when the per-action timeout expires")
+	}
+}
@@ -0,0 +1,139 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// InternalTransaction is a call made while executing a transaction, as listed by
+// Etherscan's internal transactions API. Numeric fields are decimal strings.
+type InternalTransaction struct {
+	From            Address `json:"from"`
+	To              Address `json:"to"`
+	ContractAddress Address `json:"contractAddress"` // the created contract, for creations
+	Value           string  `json:"value"`           // in Wei
+	Type            string  `json:"type"`            // e.g. "call", "delegatecall", "create"
+	TraceID         string  `json:"traceId"`         // position in the call tree, e.g. "0_1", or "" if not reported
+	IsError         string  `json:"isError"`         // "1" if the call reverted
+	ErrCode         string  `json:"errCode"`
+}
+
+// Failed reports whether the call reverted.
+func (c InternalTransaction) Failed() bool {
+	return c.IsError == "1"
+}
+
+// CallNode is a call in a transaction's call tree.
+type CallNode struct {
+	Call     InternalTransaction
+	Children []*CallNode
+}
+
+// FetchInternalTransactions retrieves the internal transactions (calls between
+// contracts) made while executing a transaction.
+// Parameters:
+//   - ctx: The context for the request.
+//   - hash: The transaction hash.
+//
+// Returns:
+//   - The internal transactions, in the order Etherscan lists them; empty if there are none.
+//   - An error if the request fails.
+func (c *Client) FetchInternalTransactions(ctx context.Context, hash Hash) ([]InternalTransaction, error) {
+	if c.apiKey == "" {
+		return nil, ErrNoAPIKey
+	}
+
+	url := fmt.Sprintf("%s?chainid=%d&module=account&action=txlistinternal&txhash=%s&apikey=%s", c.baseURL, c.networkFor(ctx).ChainID, hash, c.apiKey)
+
+	resp, err := doRequest[json.RawMessage](ctx, c, url)
+	if err != nil {
+		return nil, err
+	}
+
+	// A transaction without internal transactions has an empty result list
+	var calls []InternalTransaction
+	if err := json.Unmarshal(resp.Result, &calls); err != nil {
+		var msg string
+		if json.Unmarshal(resp.Result, &msg) == nil {
+			return nil, newAPIError(msg)
+		}
+		return nil, fmt.Errorf("unexpected response format for internal transactions: %w", err)
+	}
+
+	return calls, nil
+}
+
+// BuildCallTree nests internal transactions by their trace ids: "0_1" is the
+// second call made by the call with trace id "0". Calls without a trace id, or
+// whose parent isn't listed, are placed at the top level.
+// Parameters:
+//   - calls: The internal transactions of one transaction.
+//
+// Returns:
+//   - The top-level calls, made directly by the transaction, with their
+//     children ordered by trace id.
+func BuildCallTree(calls []InternalTransaction) []*CallNode {
+	nodes := make(map[string]*CallNode, len(calls))
+	all := make([]*CallNode, len(calls))
+	for i, call := range calls {
+		all[i] = &CallNode{Call: call}
+		if call.TraceID != "" {
+			nodes[call.TraceID] = all[i]
+		}
+	}
+
+	var roots []*CallNode
+	for _, node := range all {
+		parent := nodes[parentTraceID(node.Call.TraceID)]
+		if parent == nil {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortCalls(roots)
+	return roots
+}
+
+// parentTraceID returns the trace id of a call's parent, or "" for a top-level call.
+func parentTraceID(id string) string {
+	i := strings.LastIndex(id, "_")
+	if i < 0 {
+		return ""
+	}
+	return id[:i]
+}
+
+// sortCalls orders sibling calls by trace id, recursively. Calls without a
+// trace id keep their order after those with one.
+func sortCalls(nodes []*CallNode) {
+	slices.SortStableFunc(nodes, func(a, b *CallNode) int {
+		return slices.Compare(tracePath(a.Call.TraceID), tracePath(b.Call.TraceID))
+	})
+	for _, n := range nodes {
+		sortCalls(n.Children)
+	}
+}
+
+// tracePath parses a trace id such as "0_10_2" into its indices, so "0_10"
+// sorts after "0_9". An empty or malformed id sorts last.
+func tracePath(id string) []int {
+	if id == "" {
+		return []int{math.MaxInt}
+	}
+	var path []int
+	for part := range strings.SplitSeq(id, "_") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return []int{math.MaxInt}
+		}
+		path = append(path, n)
+	}
+	return path
+}
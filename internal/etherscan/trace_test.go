@@ -0,0 +1,107 @@
+package etherscan
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchInternalTransactions(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseBody string
+		wantCalls    int
+		wantErr      string
+	}{
+		{
+			name:         "Success",
+			responseBody: `{"status":"1","message":"OK","result":[{"from":"0xaaa","to":"0xbbb","value":"1000000000000000000","type":"call","traceId":"0","isError":"0","errCode":""},{"from":"0xbbb","to":"","contractAddress":"0xccc","value":"0","type":"create","traceId":"0_0","isError":"1","errCode":"Out of gas"}]}`,
+			wantCalls:    2,
+		},
+		{
+			name:         "None",
+			responseBody: `{"status":"0","message":"No transactions found","result":[]}`,
+		},
+		{
+			name:         "APIError",
+			responseBody: `{"status":"0","message":"NOTOK","result":"Error! Invalid transaction hash"}`,
+			wantErr:      "Invalid transaction hash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("action"); got != "txlistinternal" || r.URL.Query().Get("txhash") != "0xabc" {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				w.Write([]byte(tt.responseBody)) // nolint:errcheck // mock server
+			}))
+			defer server.Close()
+
+			client := NewClient("test-api-key", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			calls, err := client.FetchInternalTransactions(t.Context(), Hash("0xabc"))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(calls) != tt.wantCalls {
+				t.Fatalf("got %d calls; want %d", len(calls), tt.wantCalls)
+			}
+			if tt.wantCalls > 0 && (calls[1].ContractAddress != "0xccc" || !calls[1].Failed() || calls[0].Failed()) {
+				t.Errorf("unexpected calls %+v", calls)
+			}
+		})
+	}
+}
+
+// treeShape renders the trace ids of a call tree, e.g. "0(0_0 0_1) 1".
+func treeShape(nodes []*CallNode) string {
+	var parts []string
+	for _, n := range nodes {
+		part := n.Call.TraceID
+		if part == "" {
+			part = string(n.Call.To)
+		}
+		if len(n.Children) > 0 {
+			part += "(" + treeShape(n.Children) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestBuildCallTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		traceIDs []string
+		want     string
+	}{
+		{"Empty", nil, ""},
+		{"Flat", []string{"0", "1", "2"}, "0 1 2"},
+		{"Nested", []string{"0", "0_0", "0_1", "0_1_0", "1"}, "0(0_0 0_1(0_1_0)) 1"},
+		{"Out of order", []string{"1", "0_1", "0", "0_0"}, "0(0_0 0_1) 1"},
+		{"Numeric order", []string{"0", "0_10", "0_9"}, "0(0_9 0_10)"},
+		{"Missing parent", []string{"0", "1_0"}, "0 1_0"},
+		{"No trace ids", []string{"", ""}, "0xa 0xb"},
+		{"Mixed", []string{"", "0"}, "0 0xa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []InternalTransaction
+			for i, id := range tt.traceIDs {
+				calls = append(calls, InternalTransaction{TraceID: id, To: Address("0x" + string(rune('a'+i)))})
+			}
+			if got := treeShape(BuildCallTree(calls)); got != tt.want {
+				t.Errorf("BuildCallTree() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
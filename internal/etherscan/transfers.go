@@ -0,0 +1,56 @@
+package etherscan
+
+import (
+	"math/big"
+	"strings"
+)
+
+// TokenTransfer is an ERC-20 token movement decoded from a Transfer event in the
+// transaction's receipt.
+type TokenTransfer struct {
+	Token  Address `json:"token"` // the token contract that emitted the event
+	From   Address `json:"from"`
+	To     Address `json:"to"`
+	Amount string  `json:"amount"` // decimal, in the token's smallest unit
+}
+
+// decodeTokenTransfers picks the ERC-20 transfers out of a receipt's logs.
+// ERC-721 transfers share the event's topic but index the token ID as a fourth
+// topic instead of logging an amount, so they're skipped along with other events
+// and malformed logs.
+// Parameters:
+//   - logs: The logs from the transaction receipt.
+//
+// Returns:
+//   - The transfers in log order, or nil if there are none.
+func decodeTokenTransfers(logs []receiptLog) []TokenTransfer {
+	var transfers []TokenTransfer
+	for _, l := range logs {
+		if len(l.Topics) != 3 || !strings.EqualFold(l.Topics[0], transferTopic) {
+			continue
+		}
+		from, okFrom := topicAddress(l.Topics[1])
+		to, okTo := topicAddress(l.Topics[2])
+		data := strings.TrimPrefix(l.Data, "0x")
+		amount, okAmount := new(big.Int).SetString(data, 16)
+		if !okFrom || !okTo || data == "" || !okAmount {
+			continue
+		}
+		transfers = append(transfers, TokenTransfer{
+			Token:  Address(strings.ToLower(l.Address)),
+			From:   from,
+			To:     to,
+			Amount: amount.String(),
+		})
+	}
+	return transfers
+}
+
+// topicAddress decodes an address indexed as a 32-byte topic, left-padded with zeros.
+func topicAddress(topic string) (Address, bool) {
+	word := strings.ToLower(strings.TrimPrefix(topic, "0x"))
+	if len(word) != 64 || strings.TrimLeft(word[:24], "0") != "" || strings.TrimLeft(word[24:], "0123456789abcdef") != "" {
+		return "", false
+	}
+	return Address("0x" + word[24:]), true
+}
@@ -0,0 +1,82 @@
+package etherscan
+
+import (
+	"awesomeProject/internal/etherscan/etherscantest"
+	"slices"
+	"testing"
+)
+
+const (
+	topicAlice = "0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	topicBob   = "0x000000000000000000000000bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	oneToken   = "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000"
+)
+
+func TestDecodeTokenTransfers(t *testing.T) {
+	transfer := receiptLog{Address: "0xTOKEN", Topics: []string{topicTransfer, topicAlice, topicBob}, Data: oneToken}
+	want := TokenTransfer{Token: "0xtoken", From: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", To: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Amount: "1000000000000000000"}
+
+	tests := []struct {
+		name string
+		logs []receiptLog
+		want []TokenTransfer
+	}{
+		{"No Logs", nil, nil},
+		{"One Transfer", []receiptLog{transfer}, []TokenTransfer{want}},
+		{"Several Transfers", []receiptLog{transfer, transfer}, []TokenTransfer{want, want}},
+		{"Skips Other Events", []receiptLog{
+			{Topics: []string{topicSwapV2}, Data: oneToken},
+			transfer,
+			{Topics: nil},
+		}, []TokenTransfer{want}},
+		{"Skips ERC-721", []receiptLog{{Topics: []string{topicTransfer, topicAlice, topicBob, "0x01"}, Data: "0x"}}, nil},
+		{"Skips Malformed", []receiptLog{
+			{Topics: []string{topicTransfer, "0x01", topicBob}, Data: oneToken},
+			{Topics: []string{topicTransfer, topicAlice, topicBob}, Data: "0x"},
+			{Topics: []string{topicTransfer, topicAlice, topicBob}, Data: "0xzz"},
+		}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeTokenTransfers(tt.logs); !slices.Equal(got, tt.want) {
+				t.Errorf("decodeTokenTransfers() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchTransaction_TokenTransfers(t *testing.T) {
+	tests := []struct {
+		name    string
+		receipt string
+		want    []TokenTransfer
+	}{
+		{"One Transfer", etherscantest.ReceiptTransfer, []TokenTransfer{
+			{Token: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", From: "0xa1e4380a3b1f749673e270229993ee55f35663b4", To: "0x5df9b87991262f6ba471f09758cde1c0fc1de734", Amount: "1000000"},
+		}},
+		{"Several Transfers", etherscantest.ReceiptLogs, []TokenTransfer{
+			{Token: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", From: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", To: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Amount: "1000000000000000000"},
+			{Token: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", From: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", To: "0xcccccccccccccccccccccccccccccccccccccccc", Amount: "32000000000"},
+			{Token: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", From: "0xcccccccccccccccccccccccccccccccccccccccc", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "1"},
+		}},
+		{"No Logs", etherscantest.ReceiptSuccess, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routes := etherscantest.DefaultRoutes()
+			routes["eth_getTransactionReceipt"] = tt.receipt
+			server := etherscantest.NewServer(t, routes)
+			client := NewClient("test", WithBaseURL(server.URL), WithTuning(FastTuning()))
+
+			tx, err := client.FetchTransaction(t.Context(), testHash)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(tx.TokenTransfers, tt.want) {
+				t.Errorf("TokenTransfers = %v; want %v", tx.TokenTransfers, tt.want)
+			}
+		})
+	}
+}
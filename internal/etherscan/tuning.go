@@ -0,0 +1,51 @@
+// Package etherscan defines presets for the client's API politeness settings.
+package etherscan
+
+import "time"
+
+// defaultArtificialDelay is the pause before each transaction fetch, keeping the
+// loading state visible and staying well under free-tier rate limits.
+const defaultArtificialDelay = 500 * time.Millisecond
+
+// defaultRateLimit and defaultRateBurst keep requests under the free tier's
+// limit of five calls per second.
+const (
+	defaultRateLimit = 5
+	defaultRateBurst = 5
+)
+
+// fastProbeConcurrency is how many other networks fast mode probes at once for a missing transaction.
+const fastProbeConcurrency = 4
+
+// Tuning groups the settings that trade API politeness for speed.
+type Tuning struct {
+	// ArtificialDelay is the pause before each transaction fetch. Zero disables it.
+	ArtificialDelay time.Duration
+	// RateLimit is the most API requests sent per second, retries included. Zero disables the limit.
+	RateLimit float64
+	// RateBurst is how many requests may be sent at once before RateLimit paces them.
+	RateBurst int
+	// ProbeConcurrency is how many other networks are probed at once for a missing
+	// transaction (see ProbeTransaction). Zero or one probes them one at a time.
+	ProbeConcurrency int
+}
+
+// DefaultTuning returns the conservative settings suitable for free-tier API keys.
+func DefaultTuning() Tuning {
+	return Tuning{
+		ArtificialDelay:  defaultArtificialDelay,
+		RateLimit:        defaultRateLimit,
+		RateBurst:        defaultRateBurst,
+		ProbeConcurrency: 1,
+	}
+}
+
+// FastTuning returns the "fast mode" preset for paid keys with high rate limits.
+// It removes all artificial delays and the rate limit and probes several networks at once,
+// so free-tier keys are likely to hit "Max calls per sec rate limit reached" errors and rely on retries.
+func FastTuning() Tuning {
+	return Tuning{
+		ArtificialDelay:  0,
+		ProbeConcurrency: fastProbeConcurrency,
+	}
+}
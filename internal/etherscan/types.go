@@ -1,7 +1,16 @@
 // Package etherscan contains type definitions for Etherscan API entities.
 package etherscan
 
-import "net/http"
+import (
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
 
 // Address represents an Ethereum address.
 type Address string
@@ -19,37 +28,159 @@ type Transaction struct {
 	From                  Address `json:"from"`
 	To                    Address `json:"to"`
 	Value                 string  `json:"value"`
+	ValueWei              string  `json:"valueWei,omitzero"` // raw value in Wei, for unit switching
 	Gas                   string  `json:"gas"`
 	GasPrice              string  `json:"gasPrice"`
+	GasPriceWei           string  `json:"gasPriceWei,omitzero"` // raw gas price in Wei (see ParseGasPrice)
 	Nonce                 string  `json:"nonce"`
+	SenderTxCount         string  `json:"senderTxCount,omitzero"` // sender's mined transaction count, if nonce context is enabled
 	TransactionIndex      string  `json:"transactionIndex"`
 	BlockTransactionCount string  `json:"blockTransactionCount,omitzero"`
+	BlockGasLimit         string  `json:"blockGasLimit,omitzero"`
 	Input                 string  `json:"input"`
+	Method                string  `json:"method,omitzero"` // called method's signature, or its raw selector if not well known
 	Type                  string  `json:"type"`
 	Confirmations         string  `json:"confirmations,omitzero"`
-	Status                string  `json:"status"`             // "Pending", "success", "failed", "dropped", "replaced"
-	Timestamp             string  `json:"timestamp,omitzero"` // ISO 8601 format
+	Finalized             bool    `json:"finalized,omitzero"`
+	Status                string  `json:"status"` // "Pending", "success", "failed", "mined", "dropped", "replaced"
+	RevertReason          string  `json:"revertReason,omitzero"`
+	RevertReasonError     string  `json:"revertReasonError,omitzero"` // why the revert replay failed, so "no reason" isn't assumed
+	Timestamp             string  `json:"timestamp,omitzero"`         // ISO 8601 format
 	GasUsed               string  `json:"gasUsed"`
 	TransactionFee        string  `json:"transactionFee"`
-	ToAccountType         string  `json:"toAccountType,omitzero"` // "EOA" or "Smart Contract"
+	TransactionFeeWei     string  `json:"transactionFeeWei,omitzero"` // raw fee in Wei, for unit switching
+	ToAccountType         string  `json:"toAccountType,omitzero"`     // "EOA" or "Smart Contract"
+	ContractAddress       Address `json:"contractAddress,omitzero"`   // the created contract, for creations with a receipt
 	MaxFeePerGas          string  `json:"maxFeePerGas,omitzero"`
 	MaxPriorityFeePerGas  string  `json:"maxPriorityFeePerGas,omitzero"`
 	BaseFeePerGas         string  `json:"baseFeePerGas,omitzero"`
 	BurntFees             string  `json:"burntFees,omitzero"`
 	Savings               string  `json:"savings,omitzero"`
+	// The blob fields are only set for EIP-4844 transactions (see IsBlobTransaction).
+	MaxFeePerBlobGas    string  `json:"maxFeePerBlobGas,omitzero"`    // in Gwei
+	BlobVersionedHashes []Hash  `json:"blobVersionedHashes,omitzero"` // one per blob carried
+	BlobGasUsed         string  `json:"blobGasUsed,omitzero"`
+	BlobGasPrice        string  `json:"blobGasPrice,omitzero"`  // in Gwei
+	BlobFee             string  `json:"blobFee,omitzero"`       // blob gas used times its price
+	EtherPriceUSD       float64 `json:"etherPriceUsd,omitzero"` // ETH price in USD when fetched, 0 if unavailable
+	// Events counts the receipt's logs by event name, in display order (see FormatEvents).
+	Events []EventCount `json:"events,omitzero"`
+	// TokenTransfers lists the ERC-20 Transfer events in the receipt, in log order.
+	TokenTransfers []TokenTransfer `json:"tokenTransfers,omitzero"`
+	// Labels maps lowercased addresses to labels set by an Enricher.
+	Labels map[Address]string `json:"labels,omitzero"`
+	// ENSNames maps lowercased addresses to their verified primary ENS names.
+	ENSNames map[Address]string `json:"ensNames,omitzero"`
+	// Warnings lists non-fatal issues to surface alongside the transaction.
+	Warnings []string `json:"warnings,omitzero"`
 }
 
-// Client is a client for the Etherscan API.
+// AddWarning appends a formatted non-fatal warning to the transaction.
+// All features should report warnings through this method so they render consistently.
+// Parameters:
+//   - format: A fmt format string describing the warning.
+//   - args: Arguments for the format string.
+func (tx *Transaction) AddWarning(format string, args ...any) {
+	tx.Warnings = append(tx.Warnings, fmt.Sprintf(format, args...))
+}
+
+// BlockSummary holds the headline details of a block, formatted for the TUI.
+type BlockSummary struct {
+	Number           string // decimal block number
+	Timestamp        string // ISO 8601 format
+	TransactionCount int
+	BaseFeePerGas    string // in Gwei, empty for pre-London blocks
+}
+
+// Client is a client for the Etherscan API. It is safe for concurrent use.
+// The Set* options configure the client and must be called before it is shared
+// between goroutines, except SetChainID and SetNetwork, which may be called at
+// any time; fetches already in flight keep the network they started with.
 type Client struct {
-	apiKey  string
-	http    *http.Client
-	baseURL string
-	chainID int
+	apiKey   string
+	http     *http.Client
+	baseURL  string
+	netMu    sync.RWMutex // guards network and chainErr, which the UI switches while fetches may be in flight
+	network  Network
+	chainErr error // WithChainID's unsupported chain, returned by every request until a network is set
+	tuning   Tuning
+	limiter  *rateLimiter // paces requests as tuning sets; nil when unlimited
+	finality Finality
+
+	nonceContext      bool     // fetch the sender's transaction count to annotate the nonce
+	skipConfirmations bool     // leave confirmations and finality unset to save API calls
+	skipReceipt       bool     // leave the status and gas used derived fields unset to save an API call
+	skipTimestamp     bool     // leave the timestamp and block details unset to save an API call
+	enricher          Enricher // optional post-fetch enrichment callback
+	chains            []int    // chain IDs in use, for probing and network hints; nil means every known network
+	noNetworkHint     bool     // don't suggest checking the network when a hash isn't found
+
+	ensNames bool               // reverse resolve sender and recipient ENS names on Mainnet
+	ensMu    sync.Mutex         // guards ensCache
+	ensCache map[Address]string // verified ENS names (or "") by lowercased address
+
+	usdPrices bool       // fetch the ETH price with each Mainnet transaction
+	priceMu   sync.Mutex // guards price and priceAt
+	price     float64    // last fetched ETH price in USD
+	priceAt   time.Time  // when price was fetched
+
+	timeouts       map[string]time.Duration // per-action request timeouts
+	defaultTimeout time.Duration            // timeout for actions without an entry
+	maxRetries     int                      // retries after a read's first failed attempt
+	retryBaseDelay time.Duration            // backoff before the first retry, doubled for each later one
+
+	logger *slog.Logger // structured request log, discarding unless SetLogger is called
+	debug  *log.Logger  // nil unless debug mode is enabled
+	nextID atomic.Int64 // last JSON-RPC request id issued in debug mode
+
+	rawLog io.Writer // nil unless raw response logging is enabled
+
+	offline bool // refuse every request, for strict offline mode
+
+	cache *txCache // nil unless transaction caching is enabled
+}
+
+// blockResultData represents the result of a block request.
+type blockResultData struct {
+	Number        string   `json:"number"`
+	Timestamp     string   `json:"timestamp"`
+	BaseFeePerGas string   `json:"baseFeePerGas"`
+	GasLimit      string   `json:"gasLimit"`
+	Transactions  []string `json:"transactions"`
 }
 
 // receiptResultData represents the result of a transaction receipt request.
 type receiptResultData struct {
-	Status            string `json:"status"`
-	GasUsed           string `json:"gasUsed"`
-	EffectiveGasPrice string `json:"effectiveGasPrice"`
+	Status            string       `json:"status"`
+	Root              string       `json:"root"` // post-transaction state root, set instead of status before Byzantium
+	GasUsed           string       `json:"gasUsed"`
+	EffectiveGasPrice string       `json:"effectiveGasPrice"`
+	ContractAddress   string       `json:"contractAddress"` // null unless the transaction created a contract
+	BlobGasUsed       string       `json:"blobGasUsed"`     // set for EIP-4844 transactions only
+	BlobGasPrice      string       `json:"blobGasPrice"`
+	Logs              []receiptLog `json:"logs"`
+}
+
+// receiptLog is an event log in a transaction receipt. Only the topics are
+// kept: they are enough to tell which event was emitted.
+type receiptLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+// accountTransaction represents an entry in the account txlist response.
+// Numeric fields are decimal strings, unlike the hex values of proxy responses.
+type accountTransaction struct {
+	Hash            string `json:"hash"`
+	BlockNumber     string `json:"blockNumber"`
+	TimeStamp       string `json:"timeStamp"` // Unix seconds
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Value           string `json:"value"`
+	Nonce           string `json:"nonce"`
+	GasUsed         string `json:"gasUsed"`
+	GasPrice        string `json:"gasPrice"`
+	IsError         string `json:"isError"`         // "1" if execution failed
+	ContractAddress string `json:"contractAddress"` // set for contract creations
 }
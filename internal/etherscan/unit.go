@@ -0,0 +1,60 @@
+// Package etherscan defines the display units for native currency amounts.
+package etherscan
+
+import "fmt"
+
+// Unit is a denomination used to display native currency amounts.
+type Unit int
+
+const (
+	// UnitEther displays amounts in whole native units (ETH).
+	UnitEther Unit = iota
+	// UnitGwei displays amounts in Gwei (10^9 Wei).
+	UnitGwei
+	// UnitWei displays amounts in Wei, the smallest unit.
+	UnitWei
+)
+
+// String returns the unit's display symbol.
+func (u Unit) String() string {
+	switch u {
+	case UnitGwei:
+		return "Gwei"
+	case UnitWei:
+		return "Wei"
+	default:
+		return "ETH"
+	}
+}
+
+// Next returns the unit that follows u in the ETH → Gwei → Wei cycle.
+func (u Unit) Next() Unit {
+	return (u + 1) % (UnitWei + 1)
+}
+
+// decimals returns how many decimal places of Wei the unit represents.
+func (u Unit) decimals() int {
+	switch u {
+	case UnitGwei:
+		return gweiDecimals
+	case UnitWei:
+		return 0
+	default:
+		return defaultNativeDecimals
+	}
+}
+
+// FormatAmount formats a raw Wei amount in the given unit.
+// Parameters:
+//   - wei: The amount in Wei (decimal or hex).
+//   - unit: The unit to display the amount in.
+//
+// Returns:
+//   - The formatted amount with its unit symbol (e.g., "21000 Gwei"), or "" if the amount is invalid.
+func FormatAmount(wei string, unit Unit) string {
+	v := stringToBigInt(wei)
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", formatUnits(v, unit.decimals()), unit)
+}
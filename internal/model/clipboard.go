@@ -0,0 +1,15 @@
+package model
+
+import "github.com/atotto/clipboard"
+
+// Clipboard receives the values copied from a transaction.
+type Clipboard interface {
+	Write(text string) error
+}
+
+// systemClipboard writes to the system clipboard, which on Linux needs xclip or xsel.
+type systemClipboard struct{}
+
+func (systemClipboard) Write(text string) error {
+	return clipboard.WriteAll(text)
+}
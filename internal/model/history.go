@@ -0,0 +1,120 @@
+package model
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/components/history"
+	"strings"
+	"time"
+)
+
+// defaultHistorySize is how many viewed transactions are remembered unless SetHistorySize says otherwise.
+const defaultHistorySize = 50
+
+// historyEntry is a transaction viewed during the session, kept so it can be
+// recalled into the search input or shown again without fetching it.
+type historyEntry struct {
+	hash     etherscan.Hash
+	chainID  int
+	status   string
+	searched time.Time
+	tx       *etherscan.Transaction
+}
+
+// searchHistory remembers the most recently viewed transactions, oldest first,
+// dropping the oldest once size is reached. Its cursor walks the entries when
+// recalling them into the search input.
+type searchHistory struct {
+	entries []historyEntry
+	size    int
+	cursor  int // index of the entry last recalled, -1 when not recalling
+}
+
+// newSearchHistory creates a history holding up to size entries. A size of zero or less remembers nothing.
+func newSearchHistory(size int) *searchHistory {
+	return &searchHistory{size: size, cursor: -1}
+}
+
+// add remembers a viewed transaction and stops recalling. Viewing the newest
+// entry again, e.g. on a refresh, updates it instead of adding a duplicate.
+func (h *searchHistory) add(e historyEntry) {
+	h.cursor = -1
+	if h.size <= 0 {
+		return
+	}
+	if n := len(h.entries); n > 0 && h.entries[n-1].chainID == e.chainID && strings.EqualFold(string(h.entries[n-1].hash), string(e.hash)) {
+		h.entries[n-1] = e
+		return
+	}
+	h.entries = append(h.entries, e)
+	if over := len(h.entries) - h.size; over > 0 {
+		h.entries = h.entries[over:]
+	}
+}
+
+// update replaces the remembered copies of a transaction fetched again, such as
+// a pending one that has since been mined, keeping when it was viewed.
+func (h *searchHistory) update(chainID int, tx *etherscan.Transaction) {
+	for i, e := range h.entries {
+		if e.chainID == chainID && strings.EqualFold(string(e.hash), string(tx.Hash)) {
+			h.entries[i].status = tx.Status
+			h.entries[i].tx = tx
+		}
+	}
+}
+
+// setSize changes how many entries are kept, dropping the oldest if there are too many.
+func (h *searchHistory) setSize(size int) {
+	h.size = size
+	h.entries = h.entries[max(0, len(h.entries)-max(size, 0)):]
+	h.cursor = -1
+}
+
+// prev recalls the entry before the last one recalled, starting from the newest
+// and wrapping around to it after the oldest.
+func (h *searchHistory) prev() (historyEntry, bool) {
+	n := len(h.entries)
+	if n == 0 {
+		return historyEntry{}, false
+	}
+	if h.cursor <= 0 {
+		h.cursor = n - 1
+	} else {
+		h.cursor--
+	}
+	return h.entries[h.cursor], true
+}
+
+// next recalls the entry after the last one recalled, starting from the oldest
+// and wrapping around to it after the newest.
+func (h *searchHistory) next() (historyEntry, bool) {
+	n := len(h.entries)
+	if n == 0 {
+		return historyEntry{}, false
+	}
+	h.cursor = (h.cursor + 1) % n
+	return h.entries[h.cursor], true
+}
+
+// resetCursor stops recalling, so the next prev starts from the newest entry again.
+func (h *searchHistory) resetCursor() {
+	h.cursor = -1
+}
+
+// listEntries returns the entries for the history panel, newest first.
+func (h *searchHistory) listEntries() []history.Entry {
+	list := make([]history.Entry, len(h.entries))
+	for i, e := range h.entries {
+		list[len(list)-1-i] = history.Entry{
+			Hash:     string(e.hash),
+			Chain:    etherscan.NetworkByID(e.chainID).Name,
+			Status:   e.status,
+			Searched: e.searched,
+		}
+	}
+	return list
+}
+
+// newestFirst returns the entry at index i of listEntries.
+func (h *searchHistory) newestFirst(i int) historyEntry {
+	return h.entries[len(h.entries)-1-i]
+}
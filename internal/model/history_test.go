@@ -0,0 +1,187 @@
+package model
+
+import (
+	"awesomeProject/internal/etherscan"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// hashes returns the hashes of the history's entries, oldest first.
+func (h *searchHistory) hashes() []etherscan.Hash {
+	var hashes []etherscan.Hash
+	for _, e := range h.entries {
+		hashes = append(hashes, e.hash)
+	}
+	return hashes
+}
+
+func TestSearchHistory_Add(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		added []historyEntry
+		want  []etherscan.Hash
+	}{
+		{"Keeps Order", 5, []historyEntry{{hash: "0xa", chainID: 1}, {hash: "0xb", chainID: 1}}, []etherscan.Hash{"0xa", "0xb"}},
+		{"Dedupes Consecutive", 5, []historyEntry{{hash: "0xa", chainID: 1}, {hash: "0xA", chainID: 1}}, []etherscan.Hash{"0xA"}},
+		{"Keeps Repeats Apart", 5, []historyEntry{{hash: "0xa", chainID: 1}, {hash: "0xb", chainID: 1}, {hash: "0xa", chainID: 1}}, []etherscan.Hash{"0xa", "0xb", "0xa"}},
+		{"Same Hash Other Chain", 5, []historyEntry{{hash: "0xa", chainID: 1}, {hash: "0xa", chainID: 11155111}}, []etherscan.Hash{"0xa", "0xa"}},
+		{"Evicts Oldest", 2, []historyEntry{{hash: "0xa", chainID: 1}, {hash: "0xb", chainID: 1}, {hash: "0xc", chainID: 1}}, []etherscan.Hash{"0xb", "0xc"}},
+		{"Disabled", 0, []historyEntry{{hash: "0xa", chainID: 1}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newSearchHistory(tt.size)
+			for _, e := range tt.added {
+				h.add(e)
+			}
+			if got := h.hashes(); !slices.Equal(got, tt.want) {
+				t.Errorf("entries = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchHistory_Dedupe_UpdatesMetadata(t *testing.T) {
+	h := newSearchHistory(5)
+	h.add(historyEntry{hash: "0xa", chainID: 1, status: "Pending"})
+	h.add(historyEntry{hash: "0xa", chainID: 1, status: "success"})
+	if len(h.entries) != 1 || h.entries[0].status != "success" {
+		t.Errorf("expected the newest entry updated in place, got %+v", h.entries)
+	}
+}
+
+func TestSearchHistory_SetSize(t *testing.T) {
+	h := newSearchHistory(5)
+	for _, hash := range []etherscan.Hash{"0xa", "0xb", "0xc"} {
+		h.add(historyEntry{hash: hash, chainID: 1})
+	}
+	h.setSize(2)
+	if got, want := h.hashes(), []etherscan.Hash{"0xb", "0xc"}; !slices.Equal(got, want) {
+		t.Errorf("entries = %v; want %v", got, want)
+	}
+	h.setSize(0)
+	if len(h.entries) != 0 {
+		t.Errorf("expected a zero size to drop every entry, got %v", h.hashes())
+	}
+}
+
+func TestSearchHistory_CursorWraparound(t *testing.T) {
+	h := newSearchHistory(5)
+	if _, ok := h.prev(); ok {
+		t.Fatal("expected nothing to recall from an empty history")
+	}
+	for _, hash := range []etherscan.Hash{"0xa", "0xb", "0xc"} {
+		h.add(historyEntry{hash: hash, chainID: 1})
+	}
+
+	recall := func(step func() (historyEntry, bool), n int) []etherscan.Hash {
+		var got []etherscan.Hash
+		for range n {
+			e, _ := step()
+			got = append(got, e.hash)
+		}
+		return got
+	}
+
+	// Up starts at the newest and wraps around after the oldest
+	if got, want := recall(h.prev, 4), []etherscan.Hash{"0xc", "0xb", "0xa", "0xc"}; !slices.Equal(got, want) {
+		t.Errorf("prev = %v; want %v", got, want)
+	}
+	// Down continues from there, wrapping around after the newest
+	if got, want := recall(h.next, 2), []etherscan.Hash{"0xa", "0xb"}; !slices.Equal(got, want) {
+		t.Errorf("next = %v; want %v", got, want)
+	}
+
+	// Down without recalling first starts at the oldest
+	h.resetCursor()
+	if got, want := recall(h.next, 1), []etherscan.Hash{"0xa"}; !slices.Equal(got, want) {
+		t.Errorf("next after reset = %v; want %v", got, want)
+	}
+	// Adding stops recalling, so up starts at the newest again
+	h.add(historyEntry{hash: "0xd", chainID: 1})
+	if got, want := recall(h.prev, 1), []etherscan.Hash{"0xd"}; !slices.Equal(got, want) {
+		t.Errorf("prev after add = %v; want %v", got, want)
+	}
+}
+
+func TestUpdate_HistoryRecall(t *testing.T) {
+	m := New(etherscan.NewClient("test-key"))
+
+	// Nothing to recall yet
+	m1, _ := m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if got := m1.(Model).input.Value(); got != "" {
+		t.Errorf("expected an empty input with no history, got %q", got)
+	}
+
+	m2, _ := m1.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xa", Status: "success"}})
+	m3, _ := m2.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xb", Status: "failed"}})
+	m4, _ := m3.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	for _, step := range []struct {
+		key  tea.KeyType
+		want string
+	}{
+		{tea.KeyUp, "0xb"},
+		{tea.KeyUp, "0xa"},
+		{tea.KeyUp, "0xb"},
+		{tea.KeyDown, "0xa"},
+	} {
+		m4, _ = m4.Update(tea.KeyMsg{Type: step.key})
+		if got := m4.(Model).input.Value(); got != step.want {
+			t.Errorf("after %v: input = %q; want %q", step.key, got, step.want)
+		}
+	}
+}
+
+func TestUpdate_HistoryPanel(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	first := &etherscan.Transaction{Hash: "0xaaa", Status: "success"}
+	m1, _ := m.Update(txMsg{tx: first})
+	m1.(Model).client.SetChainID(11155111)
+	m2, _ := m1.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xbbb", Status: "failed"}})
+
+	m3, _ := m2.Update(tea.KeyMsg{Runes: []rune("h"), Type: tea.KeyRunes})
+	view := m3.(Model).View()
+	for _, sub := range []string{"History", "0xaaa", "Mainnet", "0xbbb", "Sepolia", "(failed)"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("history panel missing expected substring: %q", sub)
+		}
+	}
+	if strings.Index(view, "0xbbb") > strings.Index(view, "0xaaa") {
+		t.Errorf("expected the newest transaction first")
+	}
+
+	// Letters go to the filter rather than triggering result keys
+	m4, _ := m3.Update(tea.KeyMsg{Runes: []rune("r"), Type: tea.KeyRunes})
+	if m4.(Model).state != resultState || !m4.(Model).showHistory {
+		t.Fatalf("expected the history panel to stay open while filtering")
+	}
+
+	// Select the older transaction and view it again, back on its network
+	m5, _ := m3.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m6, _ := m5.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	reopened := m6.(Model)
+	if reopened.showHistory || reopened.state != resultState {
+		t.Fatalf("expected the panel closed on the result screen, got state %v", reopened.state)
+	}
+	if reopened.tx != first {
+		t.Errorf("expected the remembered transaction without fetching it, got %+v", reopened.tx)
+	}
+	if client.ChainID() != 1 {
+		t.Errorf("expected a switch back to Mainnet, got chain %d", client.ChainID())
+	}
+
+	// Esc closes the panel without leaving the transaction
+	m7, _ := m6.Update(tea.KeyMsg{Runes: []rune("h"), Type: tea.KeyRunes})
+	m8, _ := m7.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m8.(Model).showHistory || m8.(Model).state != resultState {
+		t.Errorf("expected esc to close the history panel only")
+	}
+}
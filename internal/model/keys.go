@@ -0,0 +1,467 @@
+package model
+
+import (
+	"awesomeProject/internal/tui/components/activity"
+	"awesomeProject/internal/tui/components/history"
+	"awesomeProject/internal/tui/components/keyhelp"
+	"awesomeProject/internal/tui/components/transaction"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// action is something a key does, named as in the [keys] section of the config file.
+type action string
+
+const (
+	actionHelp          action = "help"
+	actionQuit          action = "quit"
+	actionConfirm       action = "confirm"
+	actionBack          action = "back"
+	actionSearchAgain   action = "search-again"
+	actionSwitchNetwork action = "switch-network"
+	actionSetChain      action = "set-chain"
+	actionLatest        action = "latest"
+	actionKeepNetwork   action = "keep-network"
+	actionWatch         action = "watch"
+	actionRefresh       action = "refresh"
+	actionPrevTx        action = "prev-tx"
+	actionNextTx        action = "next-tx"
+	actionPrevInBlock   action = "prev-in-block"
+	actionNextInBlock   action = "next-in-block"
+	actionFollow        action = "follow"
+	actionCompare       action = "compare"
+	actionSnapshot      action = "snapshot"
+	actionQR            action = "qr"
+	actionSelectUp      action = "select-up"
+	actionSelectDown    action = "select-down"
+	actionCopy          action = "copy"
+	actionCopyHash      action = "copy-hash"
+	actionUnit          action = "unit"
+	actionToggleInput   action = "toggle-input"
+	actionNextSection   action = "next-section"
+	actionPrevSection   action = "prev-section"
+	actionTrace         action = "trace"
+	actionHistory       action = "history"
+	actionExport        action = "export"
+	actionExportJSON    action = "export-json"
+	actionExportCSV     action = "export-csv"
+	actionPageUp        action = "page-up"
+	actionPageDown      action = "page-down"
+	actionScrollUp      action = "scroll-up"
+	actionScrollDown    action = "scroll-down"
+	actionPrevPage      action = "prev-page"
+	actionNextPage      action = "next-page"
+)
+
+// Screens that keys work on. Keys only clash if their actions share a screen.
+const (
+	screenSearch  = "search"
+	screenResult  = "result"
+	screenQR      = "QR code"
+	screenWatch   = "watch"
+	screenError   = "error"
+	screenCompare = "compare"
+	screenKeys    = "key list"
+	screenTrace   = "call tree"
+	screenHistory = "history"
+	screenExport  = "export"
+	screenAddress = "address"
+)
+
+// keyUse is what an action does on a screen. An empty screen means every screen.
+type keyUse struct {
+	screen      string
+	description string
+}
+
+// keyAction declares an action with its default keys.
+type keyAction struct {
+	name    action
+	keys    []string // default keys, as tea.KeyMsg.String() reports them
+	helpKey string   // how the default keys are shown in help, e.g. "r" for "r" and "R"
+	uses    []keyUse
+}
+
+// keyActions declares every action, in the order the key list shows them.
+// Add an entry here when adding a key to Update; the key list and footer help
+// are built from it, and the config file can rebind it by name.
+var keyActions = []keyAction{
+	{actionHelp, []string{"?"}, "?", []keyUse{{"", "search the key bindings"}}},
+	{actionQuit, []string{"ctrl+c"}, "ctrl+c", []keyUse{{"", "quit"}}},
+
+	{actionConfirm, []string{"enter"}, "enter", []keyUse{
+		{screenSearch, "look up a transaction hash, an address's transactions or 0xaddress#nonce, or confirm a chain id or comparison"},
+		{screenResult, "expand or collapse the focused section"},
+		{screenError, "view the transaction on the network it was found on, or search again"},
+		{screenCompare, "search again"},
+		{screenHistory, "view the selected transaction again"},
+		{screenAddress, "view the selected transaction"},
+	}},
+	{actionBack, []string{"esc"}, "esc", []keyUse{
+		{screenSearch, "quit, or cancel setting the chain id or comparing"},
+		{screenResult, "search again, or go back to the address's transactions"},
+		{screenQR, "close the QR code"},
+		{screenWatch, "back to search"},
+		{screenError, "search again"},
+		{screenCompare, "search again"},
+		{screenKeys, "close the key list"},
+		{screenTrace, "back to the transaction"},
+		{screenHistory, "close the history"},
+		{screenExport, "cancel the export"},
+		{screenAddress, "search again"},
+	}},
+	{actionSearchAgain, []string{"backspace"}, "backspace", []keyUse{
+		{screenResult, "search again"},
+		{screenError, "search again"},
+		{screenCompare, "search again"},
+		{screenAddress, "search again"},
+	}},
+
+	{actionSwitchNetwork, []string{"tab"}, "tab", []keyUse{{screenSearch, "switch to the next network"}}},
+	{actionSetChain, []string{"C"}, "C", []keyUse{{screenSearch, "set the chain id (on an empty input)"}}},
+	{actionLatest, []string{"l", "L"}, "l", []keyUse{{screenSearch, "look up the latest block's last transaction"}}},
+	{actionKeepNetwork, []string{"k", "K"}, "k", []keyUse{{screenSearch, "keep the current network instead of switching back"}}},
+	{actionWatch, []string{"w", "W"}, "w", []keyUse{
+		{screenSearch, "watch new blocks"},
+		{screenWatch, "pause or resume watching"},
+	}},
+
+	{actionRefresh, []string{"r", "R"}, "r", []keyUse{{screenResult, "refresh the transaction"}}},
+	{actionPrevTx, []string{"p", "P"}, "p", []keyUse{{screenResult, "previous transaction from the same sender"}}},
+	{actionNextTx, []string{"n", "N"}, "n", []keyUse{{screenResult, "next transaction from the same sender"}}},
+	{actionPrevInBlock, []string{"["}, "[", []keyUse{{screenResult, "previous transaction in the block"}}},
+	{actionNextInBlock, []string{"]"}, "]", []keyUse{{screenResult, "next transaction in the block"}}},
+	{actionFollow, []string{"f", "F"}, "f", []keyUse{{screenResult, "follow a replaced transaction to its replacement"}}},
+	{actionCompare, []string{"c", "C"}, "c", []keyUse{{screenResult, "compare with another transaction"}}},
+	{actionSnapshot, []string{"s", "S"}, "s", []keyUse{{screenResult, "save a snapshot to a file"}}},
+	{actionQR, []string{"q", "Q"}, "q", []keyUse{
+		{screenResult, "show the explorer link as a QR code"},
+		{screenQR, "close the QR code"},
+	}},
+	{actionSelectUp, []string{"up"}, "↑", []keyUse{
+		{screenSearch, "recall the previously viewed transaction hashes, newest first"},
+		{screenResult, "select the previous field in the transaction details"},
+		{screenTrace, "scroll the call tree up"},
+		{screenHistory, "select the newer transaction"},
+		{screenAddress, "select the newer transaction"},
+	}},
+	{actionSelectDown, []string{"down"}, "↓", []keyUse{
+		{screenSearch, "recall the previously viewed transaction hashes, oldest first"},
+		{screenResult, "select the next field in the transaction details"},
+		{screenTrace, "scroll the call tree down"},
+		{screenHistory, "select the older transaction"},
+		{screenAddress, "select the older transaction"},
+	}},
+	{actionCopy, []string{"y"}, "y", []keyUse{{screenResult, "copy the selected field to the clipboard"}}},
+	{actionCopyHash, []string{"Y"}, "Y", []keyUse{{screenResult, "copy the transaction hash to the clipboard"}}},
+	{actionUnit, []string{"u", "U"}, "u", []keyUse{{screenResult, "switch unit between ETH, Gwei and Wei"}}},
+	{actionToggleInput, []string{"i", "I"}, "i", []keyUse{{screenResult, "show or hide the input data"}}},
+	{actionNextSection, []string{"tab"}, "tab", []keyUse{{screenResult, "focus the next section"}}},
+	{actionPrevSection, []string{"shift+tab"}, "shift+tab", []keyUse{{screenResult, "focus the previous section"}}},
+	{actionTrace, []string{"t", "T"}, "t", []keyUse{{screenResult, "show the internal transactions as a call tree"}}},
+	{actionHistory, []string{"h", "H"}, "h", []keyUse{{screenResult, "show the transactions viewed this session"}}},
+	{actionExport, []string{"e", "E"}, "e", []keyUse{{screenResult, "export the transaction to a JSON or CSV file"}}},
+	{actionExportJSON, []string{"j", "J"}, "j", []keyUse{{screenExport, "export every field as JSON"}}},
+	{actionExportCSV, []string{"v", "V"}, "v", []keyUse{{screenExport, "export the displayed fields as CSV"}}},
+
+	{actionPageUp, []string{"pgup", "b"}, "pgup", []keyUse{{screenTrace, "scroll the call tree up a page"}}},
+	{actionPageDown, []string{"pgdown", "f", " "}, "pgdown", []keyUse{{screenTrace, "scroll the call tree down a page"}}},
+	{actionScrollUp, []string{"pgup"}, "pgup", []keyUse{{screenResult, "scroll the transaction up a page when it doesn't fit"}}},
+	{actionScrollDown, []string{"pgdown"}, "pgdown", []keyUse{{screenResult, "scroll the transaction down a page when it doesn't fit"}}},
+	{actionPrevPage, []string{"p", "P"}, "p", []keyUse{{screenAddress, "show the newer page of the address's transactions"}}},
+	{actionNextPage, []string{"n", "N"}, "n", []keyUse{{screenAddress, "show the older page of the address's transactions"}}},
+}
+
+// keyMap maps each action to its key binding.
+type keyMap map[action]key.Binding
+
+// defaultKeyMap returns the bindings declared in keyActions.
+func defaultKeyMap() keyMap {
+	keys := make(keyMap, len(keyActions))
+	for _, a := range keyActions {
+		keys[a.name] = key.NewBinding(key.WithKeys(a.keys...), key.WithHelp(a.helpKey, ""))
+	}
+	return keys
+}
+
+// newKeyMap returns the default bindings with overrides applied. overrides maps
+// action names to comma-separated keys, e.g. "copy" to "c, ctrl+y"; "space"
+// stands for the space bar. A rebound key is taken away from any other action on
+// the same screen, and an action left with no keys is disabled.
+// It returns an error for an unknown action, an empty value, or a key given to
+// two actions that share a screen.
+func newKeyMap(overrides map[string]string) (keyMap, error) {
+	keys := defaultKeyMap()
+	bound := make(map[action][]string, len(overrides))
+	for name, value := range overrides {
+		a, ok := lookupAction(action(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown key action %q", name)
+		}
+		var ks []string
+		for k := range strings.SplitSeq(value, ",") {
+			if k = strings.TrimSpace(k); k == "space" {
+				k = " "
+			}
+			if k != "" {
+				ks = append(ks, k)
+			}
+		}
+		if len(ks) == 0 {
+			return nil, fmt.Errorf("no keys given for key action %q", name)
+		}
+		bound[a.name] = ks
+	}
+
+	// Sorted, so the same clash is reported on every run
+	names := make([]action, 0, len(bound))
+	for name := range bound {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for i, name := range names {
+		for _, other := range names[i+1:] {
+			if shared := sharedKey(bound[name], bound[other]); shared != "" && shareScreen(name, other) {
+				return nil, fmt.Errorf("key %q is bound to both %s and %s", shared, name, other)
+			}
+		}
+		helpKey := strings.ReplaceAll(strings.Join(bound[name], "/"), " ", "space")
+		keys[name] = key.NewBinding(key.WithKeys(bound[name]...), key.WithHelp(helpKey, ""))
+	}
+
+	// Take rebound keys away from the defaults they clash with
+	for _, a := range keyActions {
+		if _, ok := bound[a.name]; ok {
+			continue
+		}
+		kept := a.keys
+		for _, name := range names {
+			if shareScreen(a.name, name) {
+				kept = slices.DeleteFunc(slices.Clone(kept), func(k string) bool { return slices.Contains(bound[name], k) })
+			}
+		}
+		if len(kept) == len(a.keys) {
+			continue
+		}
+		binding := key.NewBinding(key.WithKeys(kept...), key.WithHelp(strings.Join(kept, "/"), ""))
+		if len(kept) == 0 {
+			binding.SetEnabled(false)
+		} else if slices.Contains(kept, a.helpKey) {
+			binding.SetHelp(a.helpKey, "")
+		}
+		keys[a.name] = binding
+	}
+	return keys, nil
+}
+
+// lookupAction returns the declaration of the named action.
+func lookupAction(name action) (keyAction, bool) {
+	i := slices.IndexFunc(keyActions, func(a keyAction) bool { return a.name == name })
+	if i < 0 {
+		return keyAction{}, false
+	}
+	return keyActions[i], true
+}
+
+// shareScreen reports whether two actions are used on a common screen.
+func shareScreen(a, b action) bool {
+	ua, _ := lookupAction(a)
+	ub, _ := lookupAction(b)
+	for _, x := range ua.uses {
+		for _, y := range ub.uses {
+			if x.screen == "" || y.screen == "" || x.screen == y.screen {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sharedKey returns a key in both a and b, or "" if there is none.
+func sharedKey(a, b []string) string {
+	for _, k := range a {
+		if slices.Contains(b, k) {
+			return k
+		}
+	}
+	return ""
+}
+
+// matches reports whether msg is bound to any of actions.
+func (k keyMap) matches(msg tea.KeyMsg, actions ...action) bool {
+	for _, a := range actions {
+		if key.Matches(msg, k[a]) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyNames shows the keys of actions in help, e.g. "backspace/esc",
+// leaving out disabled actions.
+func (k keyMap) keyNames(actions ...action) string {
+	var names []string
+	for _, a := range actions {
+		if b := k[a]; b.Enabled() {
+			names = append(names, b.Help().Key)
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+// help renders a footer help item, e.g. "(r) refresh", or "" if none of actions has a key.
+func (k keyMap) help(description string, actions ...action) string {
+	names := k.keyNames(actions...)
+	if names == "" {
+		return ""
+	}
+	return "(" + names + ") " + description
+}
+
+// helpLine joins footer help items, skipping empty ones.
+func helpLine(items ...string) string {
+	return strings.Join(slices.DeleteFunc(items, func(s string) bool { return s == "" }), " • ")
+}
+
+func (k keyMap) inputHelp() string {
+	return helpLine(k.help("switch network", actionSwitchNetwork), k.help("set chain id", actionSetChain),
+		k.help("history", actionSelectUp, actionSelectDown), k.help("latest hash", actionLatest), k.help("watch blocks", actionWatch), k.help("search", actionConfirm),
+		k.help("keys", actionHelp), k.help("quit", actionQuit))
+}
+
+// snapshotHelp is the result help for a loaded snapshot, which leaves out the keys that fetch.
+func (k keyMap) snapshotHelp() string {
+	return helpLine(k.help("QR code", actionQR), k.help("select field", actionSelectUp, actionSelectDown),
+		k.help("copy field", actionCopy), k.help("copy hash", actionCopyHash), k.help("switch unit", actionUnit), k.help("toggle input", actionToggleInput),
+		k.help("next section", actionNextSection), k.help("expand/collapse", actionConfirm), k.help("history", actionHistory),
+		k.help("export", actionExport), k.help("search again", actionSearchAgain, actionBack), k.help("keys", actionHelp),
+		k.help("quit", actionQuit))
+}
+
+func (k keyMap) resultHelp() string {
+	return helpLine(k.help("refresh", actionRefresh), k.help("prev tx", actionPrevTx), k.help("next tx", actionNextTx),
+		k.help("compare", actionCompare), k.help("save snapshot", actionSnapshot), k.help("call tree", actionTrace), k.snapshotHelp())
+}
+
+func (k keyMap) chainInputHelp() string {
+	return helpLine(k.help("set chain", actionConfirm), k.help("cancel", actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) compareInputHelp() string {
+	return helpLine(k.help("compare", actionConfirm), k.help("cancel", actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) compareHelp() string {
+	return helpLine(k.help("search again", actionSearchAgain, actionConfirm, actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) errorHelp() string {
+	return "press " + k.keyNames(actionSearchAgain, actionConfirm, actionBack) + " to try again • " +
+		k.keyNames(actionQuit) + " to quit"
+}
+
+func (k keyMap) redirectHelp() string {
+	return helpLine(k.help("view on the other network", actionConfirm), k.help("search again", actionSearchAgain, actionBack),
+		k.help("keys", actionHelp), k.help("quit", actionQuit))
+}
+
+func (k keyMap) qrHelp() string {
+	return helpLine(k.help("close QR code", actionQR, actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) keysHelp() string {
+	return helpLine("type to filter", k.help("close", actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) traceHelp() string {
+	return helpLine(k.help("scroll", actionSelectUp, actionSelectDown), k.help("page", actionPageUp, actionPageDown),
+		k.help("back", actionBack), k.help("keys", actionHelp), k.help("quit", actionQuit))
+}
+
+func (k keyMap) exportHelp() string {
+	return helpLine("export as", k.help("JSON", actionExportJSON), k.help("CSV", actionExportCSV),
+		k.help("cancel", actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) historyHelp() string {
+	return helpLine("type to filter", k.help("select", actionSelectUp, actionSelectDown), k.help("view", actionConfirm),
+		k.help("close", actionBack), k.help("quit", actionQuit))
+}
+
+func (k keyMap) addressHelp() string {
+	return helpLine(k.help("select", actionSelectUp, actionSelectDown), k.help("view", actionConfirm),
+		k.help("newer", actionPrevPage), k.help("older", actionNextPage), k.help("search again", actionSearchAgain, actionBack),
+		k.help("keys", actionHelp), k.help("quit", actionQuit))
+}
+
+func (k keyMap) watchHelp() string {
+	return helpLine(k.help("pause/resume", actionWatch), k.help("back", actionBack), k.help("quit", actionQuit))
+}
+
+// selectionKeys returns the keys that move the transaction details' row selection.
+func (k keyMap) selectionKeys() transaction.KeyMap {
+	return transaction.KeyMap{Up: k[actionSelectUp], Down: k[actionSelectDown]}
+}
+
+// historyKeys returns the keys that move the history panel's selection.
+func (k keyMap) historyKeys() history.KeyMap {
+	return history.KeyMap{Up: k[actionSelectUp], Down: k[actionSelectDown]}
+}
+
+// activityKeys returns the keys that move the address activity list's selection.
+func (k keyMap) activityKeys() activity.KeyMap {
+	return activity.KeyMap{Up: k[actionSelectUp], Down: k[actionSelectDown]}
+}
+
+// scrollKeys returns the keys that scroll the call tree. Half pages and
+// horizontal scrolling have no action of their own, so they're disabled.
+func (k keyMap) scrollKeys() viewport.KeyMap {
+	disabled := key.NewBinding(key.WithDisabled())
+	return viewport.KeyMap{
+		Up:           k[actionSelectUp],
+		Down:         k[actionSelectDown],
+		PageUp:       k[actionPageUp],
+		PageDown:     k[actionPageDown],
+		HalfPageUp:   disabled,
+		HalfPageDown: disabled,
+		Left:         disabled,
+		Right:        disabled,
+	}
+}
+
+// resultScrollKeys returns the keys that scroll a transaction too tall for the
+// screen. The arrow keys move the row selection instead, and the view follows it.
+func (k keyMap) resultScrollKeys() viewport.KeyMap {
+	disabled := key.NewBinding(key.WithDisabled())
+	return viewport.KeyMap{
+		Up:           disabled,
+		Down:         disabled,
+		PageUp:       k[actionScrollUp],
+		PageDown:     k[actionScrollDown],
+		HalfPageUp:   disabled,
+		HalfPageDown: disabled,
+		Left:         disabled,
+		Right:        disabled,
+	}
+}
+
+// bindings lists what each enabled key does on each screen, for the key list.
+func (k keyMap) bindings() []keyhelp.Binding {
+	var bindings []keyhelp.Binding
+	for _, a := range keyActions {
+		b := k[a.name]
+		if !b.Enabled() {
+			continue
+		}
+		for _, use := range a.uses {
+			bindings = append(bindings, keyhelp.Binding{Key: b.Help().Key, Description: use.description, Context: use.screen})
+		}
+	}
+	return bindings
+}
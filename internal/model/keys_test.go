@@ -0,0 +1,196 @@
+package model
+
+import (
+	"awesomeProject/internal/etherscan"
+	"regexp"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// helpKey matches a key named in footer help, e.g. "(tab)" or "(backspace/esc)".
+var helpKey = regexp.MustCompile(`\(([^)]+)\)`)
+
+// runeKey returns the key message for typing s.
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestKeyActions_NoDefaultClashes(t *testing.T) {
+	seen := make(map[action]bool)
+	for i, a := range keyActions {
+		if seen[a.name] {
+			t.Errorf("action %s is declared twice", a.name)
+		}
+		seen[a.name] = true
+		for _, b := range keyActions[i+1:] {
+			if k := sharedKey(a.keys, b.keys); k != "" && shareScreen(a.name, b.name) {
+				t.Errorf("default key %q is bound to both %s and %s", k, a.name, b.name)
+			}
+		}
+	}
+}
+
+func TestKeyBindings(t *testing.T) {
+	// A replaced transaction in the middle of its block shows every result key
+	m := New(etherscan.NewClient("test-key"))
+	m.tx = &etherscan.Transaction{Status: "replaced", TransactionIndex: "1", BlockTransactionCount: "3"}
+
+	listed := make(map[string]bool)
+	for _, b := range m.keys.bindings() {
+		listed[b.Key] = true
+	}
+	k := m.keys
+	helps := []string{k.inputHelp(), m.resultHelp(), k.snapshotHelp(), k.chainInputHelp(), k.compareInputHelp(),
+		k.compareHelp(), k.redirectHelp(), k.qrHelp(), k.watchHelp(), k.keysHelp(), k.traceHelp()}
+	for _, help := range helps {
+		for _, match := range helpKey.FindAllStringSubmatch(help, -1) {
+			for name := range strings.SplitSeq(match[1], "/") {
+				if !listed[name] {
+					t.Errorf("key %q from footer help %q is missing from the key list", name, help)
+				}
+			}
+		}
+	}
+}
+
+func TestNewKeyMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		wantErr   string
+		check     func(t *testing.T, k keyMap)
+	}{
+		{
+			name: "Defaults",
+			check: func(t *testing.T, k keyMap) {
+				if got, want := k.resultHelp(), "(r) refresh • (p) prev tx • (n) next tx • (c) compare • (s) save snapshot • (t) call tree • (q) QR code • (↑/↓) select field • (y) copy field • (Y) copy hash • (u) switch unit • (i) toggle input • (tab) next section • (enter) expand/collapse • (h) history • (e) export • (backspace/esc) search again • (?) keys • (ctrl+c) quit"; got != want {
+					t.Errorf("resultHelp() = %q; want %q", got, want)
+				}
+				if got, want := k.errorHelp(), "press backspace/enter/esc to try again • ctrl+c to quit"; got != want {
+					t.Errorf("errorHelp() = %q; want %q", got, want)
+				}
+			},
+		},
+		{
+			name:      "Rebound key taken from another action",
+			overrides: map[string]string{"copy": "c"},
+			check: func(t *testing.T, k keyMap) {
+				if !k.matches(runeKey("c"), actionCopy) || k.matches(runeKey("y"), actionCopy) {
+					t.Error("expected c, and only c, to copy")
+				}
+				if k.matches(runeKey("c"), actionCompare) || !k.matches(runeKey("C"), actionCompare) {
+					t.Error("expected compare to keep only C")
+				}
+				if help := k.resultHelp(); !strings.Contains(help, "(C) compare") || !strings.Contains(help, "(c) copy field") {
+					t.Errorf("unexpected help %q", help)
+				}
+			},
+		},
+		{
+			name:      "Action left without keys",
+			overrides: map[string]string{"refresh": "i, I"},
+			check: func(t *testing.T, k keyMap) {
+				if k[actionToggleInput].Enabled() {
+					t.Error("expected toggle-input to be disabled")
+				}
+				if help := k.resultHelp(); strings.Contains(help, "toggle input") || !strings.Contains(help, "(i/I) refresh") {
+					t.Errorf("unexpected help %q", help)
+				}
+				for _, b := range k.bindings() {
+					if b.Description == "show or hide the input data" {
+						t.Error("expected the disabled action to be left out of the key list")
+					}
+				}
+			},
+		},
+		{
+			name:      "Same key on different screens",
+			overrides: map[string]string{"copy": "x", "watch": "x"},
+			check: func(t *testing.T, k keyMap) {
+				if !k.matches(runeKey("x"), actionCopy) || !k.matches(runeKey("x"), actionWatch) {
+					t.Error("expected x to copy and watch")
+				}
+			},
+		},
+		{
+			name:      "Space and named keys",
+			overrides: map[string]string{"refresh": "space", "quit": "ctrl+q"},
+			check: func(t *testing.T, k keyMap) {
+				if !k.matches(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, actionRefresh) {
+					t.Error("expected space to refresh")
+				}
+				if k.matches(tea.KeyMsg{Type: tea.KeyCtrlC}, actionQuit) || !k.matches(tea.KeyMsg{Type: tea.KeyCtrlQ}, actionQuit) {
+					t.Error("expected ctrl+q, and only ctrl+q, to quit")
+				}
+				if help := k.resultHelp(); !strings.Contains(help, "(space) refresh") || !strings.Contains(help, "(ctrl+q) quit") {
+					t.Errorf("unexpected help %q", help)
+				}
+			},
+		},
+		{
+			name:      "Selection keys",
+			overrides: map[string]string{"select-up": "k", "select-down": "j"},
+			check: func(t *testing.T, k keyMap) {
+				if !strings.Contains(k.resultHelp(), "(k/j) select field") {
+					t.Errorf("unexpected help %q", k.resultHelp())
+				}
+				if keys := k.selectionKeys(); keys.Up.Keys()[0] != "k" || keys.Down.Keys()[0] != "j" {
+					t.Errorf("unexpected selection keys %v, %v", keys.Up.Keys(), keys.Down.Keys())
+				}
+			},
+		},
+		{name: "Unknown action", overrides: map[string]string{"teleport": "t"}, wantErr: `unknown key action "teleport"`},
+		{name: "No keys", overrides: map[string]string{"copy": " , "}, wantErr: `no keys given for key action "copy"`},
+		{name: "Clash", overrides: map[string]string{"copy": "x", "compare": "x"}, wantErr: `key "x" is bound to both compare and copy`},
+		{name: "Clash with a global action", overrides: map[string]string{"help": "x", "watch": "x"}, wantErr: `key "x" is bound to both help and watch`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := newKeyMap(tt.overrides)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("newKeyMap() error = %v; want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, k)
+		})
+	}
+}
+
+func TestSetKeys(t *testing.T) {
+	clip := &recordingClipboard{}
+	m := New(etherscan.NewClient("test-key"))
+	m.SetClipboard(clip)
+	if err := m.SetKeys(map[string]string{"copy": "c", "select-down": "j"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := m.SetKeys(map[string]string{"teleport": "t"}); err == nil {
+		t.Fatal("expected an error for an unknown action")
+	}
+
+	tx := &etherscan.Transaction{Hash: "0xaaa", Status: "success"}
+	shown, _ := m.Update(txMsg{tx: tx})
+	if !strings.Contains(shown.(Model).footer.Help(), "(c) copy field") {
+		t.Errorf("expected the rebound keys in the footer, got %q", shown.(Model).footer.Help())
+	}
+
+	// Status, Hash
+	selected, _ := shown.Update(runeKey("j"))
+	selected, _ = selected.Update(runeKey("j"))
+	done, _ := selected.Update(runeKey("c"))
+	if clip.copied != "0xaaa" || done.(Model).state != resultState {
+		t.Errorf("expected c to copy the hash instead of comparing, got %q in state %v", clip.copied, done.(Model).state)
+	}
+
+	// C still compares
+	comparing, _ := done.Update(runeKey("C"))
+	if comparing.(Model).compareWith != "0xaaa" {
+		t.Error("expected C to start a comparison")
+	}
+}
@@ -3,16 +3,29 @@ package model
 
 import (
 	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/components/activity"
+	"awesomeProject/internal/tui/components/banner"
+	"awesomeProject/internal/tui/components/blockwatch"
+	"awesomeProject/internal/tui/components/calltree"
+	"awesomeProject/internal/tui/components/compare"
 	"awesomeProject/internal/tui/components/errorview"
 	"awesomeProject/internal/tui/components/footer"
 	"awesomeProject/internal/tui/components/header"
+	"awesomeProject/internal/tui/components/history"
 	"awesomeProject/internal/tui/components/input"
+	"awesomeProject/internal/tui/components/keyhelp"
 	"awesomeProject/internal/tui/components/loader"
+	"awesomeProject/internal/tui/components/qr"
 	"awesomeProject/internal/tui/components/transaction"
 	"awesomeProject/internal/tui/context"
 	"awesomeProject/internal/tui/theme"
 	goctx "context"
+	"errors"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -23,6 +36,49 @@ const (
 	loadingState
 	resultState
 	errorState
+	watchState
+	compareState
+	traceState
+	addressState
+)
+
+// inputPrompt is the search input's default prompt.
+const inputPrompt = "Enter transaction hash:"
+
+// chainPrompt is the input prompt while entering a chain ID.
+const chainPrompt = "Enter chain ID (e.g., 137):"
+
+// checksumWarning is shown when a searched address fails its EIP-55 checksum.
+const checksumWarning = "checksum mismatch — possible typo (press enter again to search anyway)"
+
+const (
+	// offlineThreshold is the number of consecutive network failures before the offline banner is shown.
+	offlineThreshold = 2
+	// pingInterval is how often connectivity is re-checked while offline.
+	pingInterval = 5 * time.Second
+	// watchPollInterval bounds how often the latest block is polled in watch mode.
+	watchPollInterval = 4 * time.Second
+	// noticeDuration is how long a brief notice, such as a field being copied, stays in the footer.
+	noticeDuration = 2 * time.Second
+	// defaultPendingPoll is how often a pending transaction is fetched again unless SetPendingPoll says otherwise.
+	defaultPendingPoll = 8 * time.Second
+	// headPollInterval is how often the latest block's age is re-checked on the search screen.
+	headPollInterval = 30 * time.Second
+	// maxHeadAge is the latest block age beyond which the network is shown as lagging.
+	maxHeadAge  = time.Minute
+	offlineText = "offline — check your connection"
+	// maxLoadProgress is as far as the loader goes before the result arrives.
+	maxLoadProgress = 0.9
+	// stageShare is the share of the remaining bar each finished fetch step fills.
+	// Fetches run a varying number of steps, so the bar slows instead of stalling early.
+	stageShare = 0.5
+	// progressFallback is how long a load may go without a finished step before the
+	// loader creeps forward on its own, so it never looks frozen.
+	progressFallback = 500 * time.Millisecond
+	// fallbackIncrement is how far the loader creeps on each tick past progressFallback.
+	fallbackIncrement = 0.02
+	// activityPageSize is how many of an address's transactions are listed per page.
+	activityPageSize = 25
 )
 
 // Model is the main application model.
@@ -32,62 +88,332 @@ type Model struct {
 	header      header.Model
 	input       input.Model
 	transaction transaction.Model
+	compare     compare.Model
 	footer      footer.Model
 	errorView   errorview.Model
 	loader      loader.Model
+	banner      banner.Model
+	blockWatch  blockwatch.Model
 	client      *etherscan.Client
 	tx          *etherscan.Transaction
 	err         error
+	netFailures int
+	watchID     int
+	loadID      int
+	session     *sessionStats
+	compareWith etherscan.Hash      // set while entering the second hash to compare against
+	fetchedAt   time.Time           // when the current transaction was fetched
+	snapshot    *etherscan.Snapshot // set while viewing a loaded snapshot, which is read-only
+	keepNetwork bool                // the user dismissed the network hint for the current network
+	chainEntry  bool                // set while entering a chain ID instead of a search
+	redirect    *foundElsewhereMsg  // set while offering to view a missing transaction on another network
+	maxWatch    time.Duration       // how long watch mode may run before giving up, 0 for no limit
+	watchRun    int                 // incremented each time watch mode starts, to match its deadline
+	gaveUp      string              // why the program quit on its own, if it did
+	qrCode      qr.Model            // the current transaction's explorer link as a QR code
+	showQR      bool                // set while the QR code overlays the transaction
+	keyHelp     keyhelp.Model       // the searchable key list
+	showKeys    bool                // set while the key list overlays the current screen
+	keysReturn  string              // the footer help to restore when the key list closes
+	keys        keyMap              // what each key does
+	callTree    calltree.Model      // the current transaction's internal transactions
+	headTime    time.Time           // when the latest block was mined, zero if unknown
+	progressAt  time.Time           // when the current load started or last finished a step
+	history     *searchHistory      // transactions viewed this session
+	historyList history.Model       // the history panel
+	showHistory bool                // set while the history panel overlays the transaction
+	exporting   bool                // set while choosing the format to export the transaction in
+	pendingPoll time.Duration       // how often a pending transaction is fetched again, 0 to never
+	pollID      int                 // incremented to stop polling a pending transaction
+	cancelPoll  goctx.CancelFunc    // cancels the refetch of a pending transaction in flight, if any
+	clipboard   Clipboard           // where copied values go
+	resultView  viewport.Model      // scrolls a transaction too tall for the screen
+	activity    activity.Model      // the searched address's recent transactions
+	fromList    bool                // the transaction was opened from the address's transactions, so esc goes back to them
 }
 
 type txMsg struct{ tx *etherscan.Transaction }
 type latestBlockMsg struct {
 	blockNumber string
 	lastTxHash  string
+	timestamp   string // RFC3339, empty if the block's details couldn't be fetched
+}
+type compareMsg struct{ a, b compare.Side }
+type addressTxsMsg struct {
+	address etherscan.Address
+	page    int
+	txs     []etherscan.AccountTransaction
+}
+type traceMsg struct {
+	calls []etherscan.InternalTransaction
+}
+type snapshotSavedMsg struct {
+	path string
+	err  error
+}
+type exportedMsg struct {
+	path string
+	err  error
 }
+
+// exportFormat is a file format the current transaction can be exported in.
+type exportFormat struct {
+	ext    string // file extension, without the dot
+	encode func(*etherscan.Transaction) ([]byte, error)
+}
+
+var (
+	jsonExport = exportFormat{ext: "json", encode: etherscan.ExportJSON}
+	csvExport  = exportFormat{ext: "csv", encode: etherscan.ExportCSV}
+)
+
 type errMsg error
 
+// clearNoticeMsg restores the result help if the footer still shows help, a brief notice.
+type clearNoticeMsg struct{ help string }
+
+// foundElsewhereMsg reports a transaction missing on the current network that another known network has.
+type foundElsewhereMsg struct {
+	hash    etherscan.Hash
+	network etherscan.Network
+	err     error
+}
+type pingMsg struct{ err error }
+type headTickMsg struct{}
+
+// headMsg reports a background check of the latest block on a chain.
+type headMsg struct {
+	chainID int
+	latest  latestBlockMsg
+	err     error
+}
+type pendingTickMsg struct{ id int }
+
+// pendingTxMsg reports a refetch of the pending transaction being shown.
+type pendingTxMsg struct {
+	id  int
+	tx  *etherscan.Transaction
+	err error
+}
+type watchTickMsg struct{ id int }
+type watchDeadlineMsg struct{ run int }
+type watchBlockMsg struct {
+	id    int
+	block *etherscan.BlockSummary
+	err   error
+}
+type stepMsg struct {
+	step  etherscan.Step
+	steps <-chan etherscan.Step
+}
+
 // New creates a new Model with the given Etherscan client.
 func New(client *etherscan.Client) Model {
 	pCtx := &context.ProgramContext{
-		Theme: theme.DefaultTheme(),
+		Theme:  theme.DefaultTheme(),
+		Flavor: context.FlavorEtherscan,
 	}
 
-	return Model{
+	keys := defaultKeyMap()
+	m := Model{
 		state:       inputState,
 		ctx:         pCtx,
 		header:      header.New(pCtx, client.ChainID()),
 		input:       input.New(pCtx),
 		transaction: transaction.New(pCtx, nil),
-		footer:      footer.New(pCtx, "(tab) switch network • (l) latest hash • (enter) search • (ctrl+c) quit"),
+		compare:     compare.New(pCtx, compare.Side{}, compare.Side{}),
+		footer:      footer.New(pCtx, keys.inputHelp()),
 		errorView:   errorview.New(pCtx, nil),
 		loader:      loader.New(pCtx),
+		banner:      banner.New(pCtx),
+		blockWatch:  blockwatch.New(pCtx),
 		client:      client,
+		session:     &sessionStats{},
+		history:     newSearchHistory(defaultHistorySize),
+		pendingPoll: defaultPendingPoll,
+		clipboard:   systemClipboard{},
+		keys:        keys,
+	}
+	m.header.SetChains(client.ChainsInUse())
+	m.resultView.KeyMap = keys.resultScrollKeys()
+	return m
+}
+
+// SetKeys rebinds keys. overrides maps action names to comma-separated keys,
+// as in the [keys] section of the config file, e.g. "copy" to "c".
+// A rebound key is taken away from other actions on the same screen.
+// It returns an error for an unknown action or a key bound to two actions on the
+// same screen, leaving the bindings unchanged.
+func (m *Model) SetKeys(overrides map[string]string) error {
+	keys, err := newKeyMap(overrides)
+	if err != nil {
+		return err
+	}
+	m.keys = keys
+	m.transaction.SetKeyMap(keys.selectionKeys())
+	m.resultView.KeyMap = keys.resultScrollKeys()
+	if m.snapshot != nil {
+		m.footer.SetHelp(m.resultHelp())
+	} else {
+		m.footer.SetHelp(keys.inputHelp())
+	}
+	return nil
+}
+
+// newTransaction creates the details component for tx with the configured keys.
+func (m Model) newTransaction(tx *etherscan.Transaction) transaction.Model {
+	t := transaction.New(m.ctx, tx)
+	t.SetKeyMap(m.keys.selectionKeys())
+	return t
+}
+
+// SetLabelFlavor selects the terminology used for transaction field labels.
+func (m *Model) SetLabelFlavor(f context.Flavor) {
+	m.ctx.Flavor = f
+}
+
+// SetLocalTime shows timestamps in the local timezone instead of UTC.
+func (m *Model) SetLocalTime(local bool) {
+	m.ctx.Location = nil
+	if local {
+		m.ctx.Location = time.Local
+	}
+}
+
+// SetBoxed draws the transaction details in a bordered box with the title in
+// its top edge. Terminals too narrow for the box get the plain list instead.
+func (m *Model) SetBoxed(boxed bool) {
+	m.ctx.Boxed = boxed
+}
+
+// SetSimpleProgress replaces the animated gradient progress bar with a static
+// one for slow terminals. The loader also switches on its own if animation
+// frames fall behind.
+func (m *Model) SetSimpleProgress(simple bool) {
+	m.loader.SetSimple(simple)
+}
+
+// SetMaxWatch limits how long watch mode may run. When the limit is reached the
+// program quits and GaveUp reports the last observed state. Zero means no limit.
+func (m *Model) SetMaxWatch(d time.Duration) {
+	m.maxWatch = d
+}
+
+// SetHistorySize sets how many viewed transactions are remembered for recalling
+// and reopening, dropping the oldest first. Zero disables the history.
+func (m *Model) SetHistorySize(n int) {
+	m.history.setSize(n)
+}
+
+// SetClipboard sets where copied values go instead of the system clipboard.
+func (m *Model) SetClipboard(c Clipboard) {
+	m.clipboard = c
+}
+
+// SetPendingPoll sets how often a pending transaction being shown is fetched
+// again until it's mined or dropped. Zero turns the polling off.
+func (m *Model) SetPendingPoll(d time.Duration) {
+	m.pendingPoll = d
+}
+
+// GaveUp returns why the program quit on its own, such as watch mode reaching
+// its time limit, or "" if it was quit by the user.
+func (m Model) GaveUp() string {
+	return m.gaveUp
+}
+
+// LoadSnapshot shows a previously saved snapshot instead of the search input.
+// The snapshot is read-only: actions that would fetch from the network are disabled until the next search.
+func (m *Model) LoadSnapshot(s *etherscan.Snapshot) {
+	m.snapshot = s
+	m.tx = s.Transaction
+	m.fetchedAt = s.FetchedAt
+	// Snapshots from chains outside the registry still show with generic settings
+	if err := m.client.SetChainID(s.ChainID); err != nil {
+		m.client.SetNetwork(etherscan.NetworkByID(s.ChainID))
 	}
+	m.header.SetChainID(s.ChainID)
+	m.transaction = m.newTransaction(m.tx)
+	m.state = resultState
+	m.footer.SetHelp(m.keys.snapshotHelp())
 }
 
 // Init initializes the Model.
 func (m Model) Init() tea.Cmd {
+	if m.snapshot != nil {
+		// Snapshots are meant to be viewable offline, so don't touch the network
+		return m.header.Tick()
+	}
 	return tea.Batch(
 		m.input.Focus(),
 		fetchLatestBlockCmd(goctx.Background(), m.client),
 		m.header.Tick(),
+		headTickCmd(),
 	)
 }
 
 func fetchTransactionCmd(ctx goctx.Context, hash etherscan.Hash, client *etherscan.Client) tea.Cmd {
-	return func() tea.Msg {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
 		tx, err := client.FetchTransaction(ctx, hash)
+		if errors.Is(err, etherscan.ErrTransactionNotFound) {
+			if n, ok := client.ProbeTransaction(ctx, hash); ok {
+				return foundElsewhereMsg{hash: hash, network: n, err: err}
+			}
+		}
 		if err != nil {
 			return errMsg(err)
 		}
 		return txMsg{tx: tx}
+	})
+}
+
+// fetchCompareCmd fetches two transactions concurrently for comparison.
+// Each side carries its own error, so one failing doesn't hide the other.
+func fetchCompareCmd(ctx goctx.Context, a, b etherscan.Hash, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		msg := compareMsg{a: compare.Side{Hash: a}, b: compare.Side{Hash: b}}
+		var wg sync.WaitGroup
+		wg.Go(func() { msg.a.Tx, msg.a.Err = client.FetchTransaction(ctx, a) })
+		wg.Go(func() { msg.b.Tx, msg.b.Err = client.FetchTransaction(ctx, b) })
+		wg.Wait()
+		return msg
+	})
+}
+
+// fetchInternalTransactionsCmd fetches the calls a transaction made, for its call tree.
+func fetchInternalTransactionsCmd(ctx goctx.Context, hash etherscan.Hash, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		calls, err := client.FetchInternalTransactions(ctx, hash)
+		if err != nil {
+			return errMsg(err)
+		}
+		return traceMsg{calls: calls}
+	})
+}
+
+// saveSnapshotCmd writes the transaction to <hash>.snapshot.json in the working directory.
+func saveSnapshotCmd(s *etherscan.Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		path := string(s.Transaction.Hash) + ".snapshot.json"
+		return snapshotSavedMsg{path: path, err: etherscan.SaveSnapshot(path, s)}
 	}
 }
 
-func fetchNextTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transaction, client *etherscan.Client) tea.Cmd {
+// exportCmd writes the transaction to <hash>.<ext> in the working directory.
+func exportCmd(tx *etherscan.Transaction, format exportFormat) tea.Cmd {
 	return func() tea.Msg {
-		hash, err := client.FetchNextTransactionHash(ctx, currentTx)
+		path := string(tx.Hash) + "." + format.ext
+		data, err := format.encode(tx)
+		if err == nil {
+			err = os.WriteFile(path, data, 0o644)
+		}
+		return exportedMsg{path: path, err: err}
+	}
+}
+
+func fetchTransactionByNonceCmd(ctx goctx.Context, address etherscan.Address, nonce string, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		hash, err := client.FetchTransactionHashByNonce(ctx, address, nonce)
 		if err != nil {
 			return errMsg(err)
 		}
@@ -96,11 +422,49 @@ func fetchNextTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transaction
 			return errMsg(err)
 		}
 		return txMsg{tx: tx}
+	})
+}
+
+// fetchAddressTxsCmd fetches a page of an address's transactions, newest first.
+func fetchAddressTxsCmd(ctx goctx.Context, address etherscan.Address, page int, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		txs, err := client.FetchAddressTransactions(ctx, address, page, activityPageSize)
+		if err != nil {
+			return errMsg(err)
+		}
+		return addressTxsMsg{address: address, page: page, txs: txs}
+	})
+}
+
+// searchCmd dispatches a search input to the lookup matching its kind.
+func searchCmd(ctx goctx.Context, input string, client *etherscan.Client) tea.Cmd {
+	q := etherscan.Classify(input)
+	switch q.Kind {
+	case etherscan.QueryAddressNonce:
+		return fetchTransactionByNonceCmd(ctx, q.Address, q.Nonce, client)
+	case etherscan.QueryAddress:
+		return fetchAddressTxsCmd(ctx, q.Address, 1, client)
+	default:
+		return fetchTransactionCmd(ctx, q.Hash, client)
 	}
 }
 
+func fetchNextTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transaction, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		hash, err := client.FetchNextTransactionHash(ctx, currentTx)
+		if err != nil {
+			return errMsg(err)
+		}
+		tx, err := client.FetchTransaction(ctx, etherscan.Hash(hash))
+		if err != nil {
+			return errMsg(err)
+		}
+		return txMsg{tx: tx}
+	})
+}
+
 func fetchPreviousTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transaction, client *etherscan.Client) tea.Cmd {
-	return func() tea.Msg {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
 		hash, err := client.FetchPreviousTransactionHash(ctx, currentTx)
 		if err != nil {
 			return errMsg(err)
@@ -110,23 +474,169 @@ func fetchPreviousTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transac
 			return errMsg(err)
 		}
 		return txMsg{tx: tx}
+	})
+}
+
+// fetchBlockTransactionCmd fetches the transaction at index in the given block.
+func fetchBlockTransactionCmd(ctx goctx.Context, blockNumber string, index int, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		hash, err := client.FetchTransactionHashByBlockAndIndex(ctx, blockNumber, index)
+		if err != nil {
+			return errMsg(err)
+		}
+		tx, err := client.FetchTransaction(ctx, etherscan.Hash(hash))
+		if err != nil {
+			return errMsg(err)
+		}
+		return txMsg{tx: tx}
+	})
+}
+
+func fetchReplacementTransactionCmd(ctx goctx.Context, currentTx *etherscan.Transaction, client *etherscan.Client) tea.Cmd {
+	return fetchWithSteps(ctx, func(ctx goctx.Context) tea.Msg {
+		hash, err := client.FetchReplacementTransactionHash(ctx, currentTx)
+		if err != nil {
+			return errMsg(err)
+		}
+		tx, err := client.FetchTransaction(ctx, etherscan.Hash(hash))
+		if err != nil {
+			return errMsg(err)
+		}
+		return txMsg{tx: tx}
+	})
+}
+
+// fetchWithSteps runs fetch as a command and forwards the client's progress steps,
+// both starting and finishing, as stepMsg.
+func fetchWithSteps(ctx goctx.Context, fetch func(goctx.Context) tea.Msg) tea.Cmd {
+	steps := make(chan etherscan.Step, 16)
+	ctx = etherscan.WithProgress(ctx, func(step etherscan.Step) {
+		// Drop steps rather than block the fetch if the UI falls behind
+		select {
+		case steps <- step:
+		default:
+		}
+	})
+
+	run := func() tea.Msg {
+		defer close(steps)
+		return fetch(ctx)
+	}
+
+	return tea.Batch(run, waitForStepCmd(steps))
+}
+
+// waitForStepCmd waits for the next progress step, returning nil once the fetch has finished.
+func waitForStepCmd(steps <-chan etherscan.Step) tea.Cmd {
+	return func() tea.Msg {
+		step, ok := <-steps
+		if !ok {
+			return nil
+		}
+		return stepMsg{step: step, steps: steps}
 	}
 }
 
 func fetchLatestBlockCmd(ctx goctx.Context, client *etherscan.Client) tea.Cmd {
 	return func() tea.Msg {
-		blockNum, err := client.FetchLatestBlockNumber(ctx)
+		latest, err := fetchLatestBlock(ctx, client)
 		if err != nil {
 			return errMsg(err)
 		}
-		_, _, txHashes, err := client.FetchBlockDetails(ctx, blockNum)
+		return latest
+	}
+}
+
+// fetchLatestBlock fetches the latest block number along with its last transaction and timestamp.
+// Only failing to get the block number is an error; the details are best-effort.
+func fetchLatestBlock(ctx goctx.Context, client *etherscan.Client) (latestBlockMsg, error) {
+	blockNum, err := client.FetchLatestBlockNumber(ctx)
+	if err != nil {
+		return latestBlockMsg{}, err
+	}
+	timestamp, _, txHashes, err := client.FetchBlockDetails(ctx, blockNum)
+	if err != nil {
+		return latestBlockMsg{blockNumber: blockNum}, nil
+	}
+	var txHash string
+	if len(txHashes) > 0 {
+		txHash = txHashes[len(txHashes)-1]
+	}
+	return latestBlockMsg{blockNumber: blockNum, lastTxHash: txHash, timestamp: timestamp}, nil
+}
+
+// headTickCmd schedules the next background check of the latest block.
+func headTickCmd() tea.Cmd {
+	return tea.Tick(headPollInterval, func(_ time.Time) tea.Msg {
+		return headTickMsg{}
+	})
+}
+
+// fetchHeadCmd checks the latest block in the background. Unlike fetchLatestBlockCmd,
+// failures are reported in the message rather than as an errMsg, so they don't replace the current view.
+func fetchHeadCmd(ctx goctx.Context, client *etherscan.Client) tea.Cmd {
+	chainID := client.ChainID()
+	return func() tea.Msg {
+		latest, err := fetchLatestBlock(ctx, client)
+		return headMsg{chainID: chainID, latest: latest, err: err}
+	}
+}
+
+func pingCmd(ctx goctx.Context, client *etherscan.Client) tea.Cmd {
+	return tea.Tick(pingInterval, func(_ time.Time) tea.Msg {
+		return pingMsg{err: client.Ping(ctx)}
+	})
+}
+
+// clearNoticeCmd clears the brief notice in footer help once noticeDuration has passed.
+func clearNoticeCmd(help string) tea.Cmd {
+	return tea.Tick(noticeDuration, func(_ time.Time) tea.Msg {
+		return clearNoticeMsg{help: help}
+	})
+}
+
+// pendingTickCmd schedules the next refetch for pending poll id.
+func pendingTickCmd(id int, d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(_ time.Time) tea.Msg {
+		return pendingTickMsg{id: id}
+	})
+}
+
+// fetchPendingCmd fetches a pending transaction again in the background, checking
+// whether it was replaced. Unlike fetchTransactionCmd, it reports no progress and
+// failures are returned in the message rather than as an errMsg, so they don't
+// replace the transaction shown.
+func fetchPendingCmd(ctx goctx.Context, id int, hash etherscan.Hash, client *etherscan.Client) tea.Cmd {
+	return func() tea.Msg {
+		tx, err := client.FetchTransaction(etherscan.WithReplacementCheck(ctx), hash)
+		return pendingTxMsg{id: id, tx: tx, err: err}
+	}
+}
+
+func watchTickCmd(id int) tea.Cmd {
+	return tea.Tick(watchPollInterval, func(_ time.Time) tea.Msg {
+		return watchTickMsg{id: id}
+	})
+}
+
+// watchDeadlineCmd ends watch run number run once d has passed.
+func watchDeadlineCmd(run int, d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(_ time.Time) tea.Msg {
+		return watchDeadlineMsg{run: run}
+	})
+}
+
+// fetchNewBlockCmd fetches the summary of the latest block if it differs from lastBlock (decimal).
+func fetchNewBlockCmd(ctx goctx.Context, client *etherscan.Client, id int, lastBlock string) tea.Cmd {
+	return func() tea.Msg {
+		blockNum, err := client.FetchLatestBlockNumber(ctx)
 		if err != nil {
-			return latestBlockMsg{blockNumber: blockNum}
+			return watchBlockMsg{id: id, err: err}
 		}
-		var txHash string
-		if len(txHashes) > 0 {
-			txHash = txHashes[len(txHashes)-1]
+		if etherscan.FormatLatestBlock(blockNum) == lastBlock {
+			return watchBlockMsg{id: id}
 		}
-		return latestBlockMsg{blockNumber: blockNum, lastTxHash: txHash}
+		block, err := client.FetchBlockSummary(ctx, blockNum)
+		return watchBlockMsg{id: id, block: block, err: err}
 	}
 }
@@ -2,13 +2,21 @@ package model
 
 import (
 	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/components/compare"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// testHash is a well-formed transaction hash to search for.
+const testHash = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
 func TestNew(t *testing.T) {
 	client := etherscan.NewClient("test-key")
 	m := New(client)
@@ -36,17 +44,29 @@ func TestUpdate_KeyEvents(t *testing.T) {
 		t.Fatal("expected non-nil cmd for Ctrl+C")
 	}
 
-	// Test Tab toggles chain ID
-	m2, _ := m.Update(tea.KeyMsg{Type: tea.KeyTab})
-	updatedModel := m2.(Model)
-	if updatedModel.client.ChainID() != 11155111 {
-		t.Errorf("expected chainID 11155111 after tab, got %d", updatedModel.client.ChainID())
+	// Test Tab cycles through the chains in registry order, wrapping around
+	var seen []int
+	current := tea.Model(m)
+	for range etherscan.SupportedChains() {
+		current, _ = current.Update(tea.KeyMsg{Type: tea.KeyTab})
+		seen = append(seen, current.(Model).client.ChainID())
+	}
+	if want := []int{11155111, 17000, 8453, 137, 42161, 10, 1}; !slices.Equal(seen, want) {
+		t.Errorf("expected tab to cycle through %v, got %v", want, seen)
 	}
 
-	m3, _ := updatedModel.Update(tea.KeyMsg{Type: tea.KeyTab})
-	updatedModel2 := m3.(Model)
-	if updatedModel2.client.ChainID() != 1 {
-		t.Errorf("expected chainID 1 after second tab, got %d", updatedModel2.client.ChainID())
+	// Only the chains in use are cycled through
+	client.SetChains([]int{1, 137})
+	cycled := New(client)
+	for _, want := range []int{137, 1} {
+		next, _ := cycled.Update(tea.KeyMsg{Type: tea.KeyTab})
+		cycled = next.(Model)
+		if got := cycled.client.ChainID(); got != want {
+			t.Errorf("expected chain %d after tab, got %d", want, got)
+		}
+	}
+	if view := cycled.View(); !strings.Contains(view, "Network: Mainnet | Polygon\n") {
+		t.Errorf("expected the switcher to list only the chains in use, got %q", view)
 	}
 }
 
@@ -82,13 +102,20 @@ func TestUpdate_EnterKey(t *testing.T) {
 	client := etherscan.NewClient("test-key")
 	m := New(client)
 
-	// Set some input
-	m.input.SetValue("0x123")
-
-	// Test Enter starts loading
+	// Test a malformed hash goes straight to the error screen without loading
 	m.state = inputState
 	m.input.SetValue("0x123")
 	_ = m.input.Focus()
+	bad, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if bad.(Model).state != errorState || cmd != nil {
+		t.Errorf("expected errorState and no cmd after Enter on a malformed hash, got %v", bad.(Model).state)
+	}
+	if !errors.Is(bad.(Model).err, etherscan.ErrInvalidTxHash) {
+		t.Errorf("expected ErrInvalidTxHash, got %v", bad.(Model).err)
+	}
+
+	// Test Enter starts loading
+	m.input.SetValue(testHash)
 	m2, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 	updatedModel := m2.(Model)
 	if updatedModel.state != loadingState {
@@ -117,12 +144,12 @@ func TestUpdate_EnterKey(t *testing.T) {
 		t.Errorf("expected state inputState after Esc from resultState, got %v", updatedModel3.state)
 	}
 
-	// Test Enter from result state returns to input state
+	// Test Backspace from result state returns to input state
 	updatedModel.state = resultState
-	m5, _ := updatedModel.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m5, _ := updatedModel.Update(tea.KeyMsg{Type: tea.KeyBackspace})
 	updatedModel4 := m5.(Model)
 	if updatedModel4.state != inputState {
-		t.Errorf("expected state inputState after Enter from resultState, got %v", updatedModel4.state)
+		t.Errorf("expected state inputState after Backspace from resultState, got %v", updatedModel4.state)
 	}
 }
 
@@ -130,7 +157,7 @@ func TestFooterHelpReset(t *testing.T) {
 	client := etherscan.NewClient("test-key")
 	m := New(client)
 
-	initialHelp := "(tab) switch network • (l) latest hash • (enter) search • (ctrl+c) quit"
+	initialHelp := "(tab) switch network • (C) set chain id • (↑/↓) history • (l) latest hash • (w) watch blocks • (enter) search • (?) keys • (ctrl+c) quit"
 	if m.footer.Help() != initialHelp {
 		t.Errorf("expected initial help %q, got %q", initialHelp, m.footer.Help())
 	}
@@ -139,7 +166,7 @@ func TestFooterHelpReset(t *testing.T) {
 	tx := &etherscan.Transaction{Hash: "0xabc"}
 	m2, _ := m.Update(txMsg{tx: tx})
 	updatedModel := m2.(Model)
-	resultHelp := "(r) refresh • (p) prev tx • (n) next tx • (backspace/enter/esc) search again • (ctrl+c) quit"
+	resultHelp := "(r) refresh • (p) prev tx • (n) next tx • (c) compare • (s) save snapshot • (t) call tree • (q) QR code • (↑/↓) select field • (y) copy field • (Y) copy hash • (u) switch unit • (i) toggle input • (tab) next section • (enter) expand/collapse • (h) history • (e) export • (backspace/esc) search again • (?) keys • (ctrl+c) quit"
 	if updatedModel.footer.Help() != resultHelp {
 		t.Errorf("expected result help %q, got %q", resultHelp, updatedModel.footer.Help())
 	}
@@ -261,8 +288,605 @@ func TestLoadingViewNoFooter(t *testing.T) {
 		t.Errorf("expected view to contain loader text, got %q", view)
 	}
 
-	initialHelp := "(tab) switch network • (l) latest hash • (enter) search • (ctrl+c) quit"
+	initialHelp := "(tab) switch network • (C) set chain id • (↑/↓) history • (l) latest hash • (w) watch blocks • (enter) search • (?) keys • (ctrl+c) quit"
 	if strings.Contains(view, initialHelp) {
 		t.Errorf("expected loading view NOT to contain footer help text")
 	}
 }
+
+func TestUpdate_FollowReplacement(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	// 'f' is ignored unless the transaction was replaced
+	m2, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0x123", Status: "success"}})
+	m3, _ := m2.Update(tea.KeyMsg{Runes: []rune("f"), Type: tea.KeyRunes})
+	if m3.(Model).state != resultState {
+		t.Errorf("expected state resultState, got %v", m3.(Model).state)
+	}
+
+	m4, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0x123", From: "0xaaa", Nonce: "5", Status: "replaced"}})
+	if !strings.Contains(m4.(Model).footer.Help(), "(f) follow replacement") {
+		t.Errorf("expected footer to mention follow replacement, got %q", m4.(Model).footer.Help())
+	}
+	m5, cmd := m4.Update(tea.KeyMsg{Runes: []rune("f"), Type: tea.KeyRunes})
+	updatedModel := m5.(Model)
+	if updatedModel.state != loadingState {
+		t.Errorf("expected state loadingState, got %v", updatedModel.state)
+	}
+	if !strings.Contains(updatedModel.loader.View(), "replacement transaction") {
+		t.Errorf("expected loader to mention replacement transaction")
+	}
+	if cmd == nil {
+		t.Errorf("expected non-nil cmd")
+	}
+}
+
+func TestUpdate_SwitchUnit(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	// 'u' is ignored outside the result view
+	m1, _ := m.Update(tea.KeyMsg{Runes: []rune("u"), Type: tea.KeyRunes})
+	if m1.(Model).ctx.Unit != etherscan.UnitEther {
+		t.Errorf("expected unit ETH in input state, got %v", m1.(Model).ctx.Unit)
+	}
+
+	tx := &etherscan.Transaction{Hash: "0x123", Status: "success", Value: "♦ 0.000001 ETH", ValueWei: "1000000000000"}
+	m2, _ := m.Update(txMsg{tx: tx})
+	m3, _ := m2.Update(tea.KeyMsg{Runes: []rune("u"), Type: tea.KeyRunes})
+	if m3.(Model).ctx.Unit != etherscan.UnitGwei {
+		t.Errorf("expected unit Gwei, got %v", m3.(Model).ctx.Unit)
+	}
+	if !strings.Contains(m3.(Model).View(), "♦ 1000 Gwei") {
+		t.Errorf("expected value rendered in Gwei")
+	}
+
+	// The chosen unit persists across lookups
+	m4, _ := m3.Update(txMsg{tx: tx})
+	if !strings.Contains(m4.(Model).View(), "♦ 1000 Gwei") {
+		t.Errorf("expected unit to persist across lookups")
+	}
+
+	m5, _ := m4.Update(tea.KeyMsg{Runes: []rune("u"), Type: tea.KeyRunes})
+	m6, _ := m5.Update(tea.KeyMsg{Runes: []rune("u"), Type: tea.KeyRunes})
+	if m6.(Model).ctx.Unit != etherscan.UnitEther {
+		t.Errorf("expected unit to cycle back to ETH, got %v", m6.(Model).ctx.Unit)
+	}
+}
+
+func TestUpdate_ToggleInput(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	tx := &etherscan.Transaction{Hash: "0x123", Status: "success", Input: "0xa9059cbb"}
+	m1, _ := m.Update(txMsg{tx: tx})
+	if !strings.Contains(m1.(Model).View(), "Input Data") {
+		t.Fatalf("expected input data to be shown by default")
+	}
+
+	m2, _ := m1.Update(tea.KeyMsg{Runes: []rune("i"), Type: tea.KeyRunes})
+	if strings.Contains(m2.(Model).View(), "Input Data") {
+		t.Errorf("expected input data to be hidden after pressing 'i'")
+	}
+
+	// The choice persists across lookups
+	m3, _ := m2.Update(txMsg{tx: tx})
+	if strings.Contains(m3.(Model).View(), "Input Data") {
+		t.Errorf("expected input data to stay hidden for the next lookup")
+	}
+
+	m4, _ := m3.Update(tea.KeyMsg{Runes: []rune("i"), Type: tea.KeyRunes})
+	if !strings.Contains(m4.(Model).View(), "Input Data") {
+		t.Errorf("expected input data to be shown after pressing 'i' again")
+	}
+}
+
+func TestUpdate_SectionFocus(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	tx := &etherscan.Transaction{Hash: "0x123", Status: "success", Input: "0xa9059cbb"}
+	m1, _ := m.Update(txMsg{tx: tx})
+
+	// Enter collapses the focused details section instead of leaving the result view
+	m2, _ := m1.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m2.(Model).state != resultState {
+		t.Fatalf("expected Enter to keep the result view, got state %v", m2.(Model).state)
+	}
+	if strings.Contains(m2.(Model).View(), "Hash:") {
+		t.Errorf("expected details to be collapsed after Enter")
+	}
+
+	// Tab moves focus to the input section, so Enter collapses it instead
+	m3, _ := m2.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m4, _ := m3.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if strings.Contains(m4.(Model).View(), "a9 05 9c bb") {
+		t.Errorf("expected input to be collapsed after Tab, Enter")
+	}
+
+	// Shift+Tab moves focus back to the details
+	m5, _ := m4.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m6, _ := m5.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m6.(Model).View(), "Hash:") {
+		t.Errorf("expected details to expand after Shift+Tab, Enter")
+	}
+
+	// Tab in the result view doesn't switch networks
+	if client.ChainID() != 1 {
+		t.Errorf("expected chain to stay 1, got %d", client.ChainID())
+	}
+}
+
+func TestUpdate_ChecksumWarning(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	// The last letter's case is flipped, breaking the EIP-55 checksum
+	m.input.SetValue("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD#3")
+	m1, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m1.(Model).state != inputState || cmd != nil {
+		t.Fatalf("expected a bad checksum to stay in the input state, got %v", m1.(Model).state)
+	}
+	if m1.(Model).input.Warning() != checksumWarning {
+		t.Errorf("expected checksum warning, got %q", m1.(Model).input.Warning())
+	}
+	if !strings.Contains(m1.(Model).View(), "possible typo") {
+		t.Errorf("expected the warning to be rendered")
+	}
+
+	// Pressing enter again searches anyway
+	m2, _ := m1.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m2.(Model).state != loadingState {
+		t.Errorf("expected second enter to search, got %v", m2.(Model).state)
+	}
+
+	// Lowercase addresses carry no checksum and are searched in checksummed form
+	m.input.SetValue("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed#3")
+	m3, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m3.(Model).state != loadingState {
+		t.Fatalf("expected lowercase address to search immediately, got %v", m3.(Model).state)
+	}
+	if got := m3.(Model).loader.Text(); got != "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed#3" {
+		t.Errorf("expected checksummed search text, got %q", got)
+	}
+}
+
+func TestUpdate_Compare(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	m1, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xaaa", Status: "success"}})
+	m2, _ := m1.Update(tea.KeyMsg{Runes: []rune("c"), Type: tea.KeyRunes})
+	if m2.(Model).state != inputState || m2.(Model).compareWith != "0xaaa" {
+		t.Fatalf("expected compare input for 0xaaa, got state %v compareWith %q", m2.(Model).state, m2.(Model).compareWith)
+	}
+	if !strings.Contains(m2.(Model).View(), "Compare 0xaaa with") {
+		t.Errorf("expected compare prompt")
+	}
+
+	// Esc cancels back to the transaction
+	m3, _ := m2.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m3.(Model).state != resultState || m3.(Model).compareWith != "" {
+		t.Errorf("expected esc to cancel the comparison, got state %v", m3.(Model).state)
+	}
+
+	// Entering a hash fetches both
+	m4, _ := m3.Update(tea.KeyMsg{Runes: []rune("c"), Type: tea.KeyRunes})
+	mm := m4.(Model)
+	mm.input.SetValue(testHash)
+	m5, cmd := mm.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m5.(Model).state != loadingState || cmd == nil {
+		t.Fatalf("expected loading state, got %v", m5.(Model).state)
+	}
+	if got := m5.(Model).loader.Text(); got != "0xaaa vs "+testHash {
+		t.Errorf("expected loader text for the comparison, got %q", got)
+	}
+
+	m6, _ := m5.Update(compareMsg{
+		a: compare.Side{Hash: "0xaaa", Tx: &etherscan.Transaction{GasUsed: "21000"}},
+		b: compare.Side{Hash: "0xbbb", Err: errors.New("transaction not found")},
+	})
+	if m6.(Model).state != compareState {
+		t.Fatalf("expected compare state, got %v", m6.(Model).state)
+	}
+	view := m6.(Model).View()
+	if !strings.Contains(view, "Compare Transactions") || !strings.Contains(view, "Error:") {
+		t.Errorf("expected comparison with the failed side's error, got %q", view)
+	}
+	if m6.(Model).footer.Help() != defaultKeyMap().compareHelp() {
+		t.Errorf("expected compare help, got %q", m6.(Model).footer.Help())
+	}
+
+	m7, _ := m6.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if m7.(Model).state != inputState || !strings.Contains(m7.(Model).View(), inputPrompt) {
+		t.Errorf("expected backspace to return to the search prompt")
+	}
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.LoadSnapshot(&etherscan.Snapshot{
+		Version:     etherscan.SnapshotVersion,
+		ChainID:     11155111,
+		Network:     "Sepolia",
+		FetchedAt:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Transaction: &etherscan.Transaction{Hash: "0xabc", Status: "success"},
+	})
+
+	if m.state != resultState || client.ChainID() != 11155111 {
+		t.Fatalf("expected result state on Sepolia, got state %v chain %d", m.state, client.ChainID())
+	}
+	if !strings.Contains(m.View(), "snapshot · Sepolia · fetched 2026-01-02 03:04:05 UTC · read-only") {
+		t.Errorf("expected snapshot banner, got %q", m.View())
+	}
+	if m.footer.Help() != defaultKeyMap().snapshotHelp() {
+		t.Errorf("expected snapshot help, got %q", m.footer.Help())
+	}
+
+	// Network actions are disabled while viewing a snapshot
+	for _, key := range []string{"r", "n", "p", "c"} {
+		updated, _ := m.Update(tea.KeyMsg{Runes: []rune(key), Type: tea.KeyRunes})
+		if updated.(Model).state != resultState {
+			t.Errorf("%q: expected to stay on the snapshot, got state %v", key, updated.(Model).state)
+		}
+	}
+
+	updated, _ := m.Update(snapshotSavedMsg{path: "0xabc.snapshot.json"})
+	if got := updated.(Model).footer.Help(); got != "saved 0xabc.snapshot.json • "+defaultKeyMap().snapshotHelp() {
+		t.Errorf("unexpected help after saving: %q", got)
+	}
+
+	searched, _ := updated.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if searched.(Model).state != inputState || searched.(Model).snapshot != nil {
+		t.Errorf("expected backspace to leave the snapshot for the search input")
+	}
+	if strings.Contains(searched.(Model).View(), "read-only") {
+		t.Errorf("expected snapshot banner to be gone")
+	}
+}
+
+func TestUpdate_QRCode(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m0, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+
+	m1, _ := m0.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xaaa", Status: "success"}})
+	m2, _ := m1.Update(tea.KeyMsg{Runes: []rune("q"), Type: tea.KeyRunes})
+	shown := m2.(Model)
+	if !shown.showQR || shown.footer.Help() != defaultKeyMap().qrHelp() {
+		t.Fatalf("expected QR code overlay, got showQR %v help %q", shown.showQR, shown.footer.Help())
+	}
+	if !strings.Contains(shown.View(), "https://etherscan.io/tx/0xaaa") {
+		t.Errorf("expected explorer link in view, got %q", shown.View())
+	}
+
+	// Other keys are ignored while the overlay is open
+	m3, _ := shown.Update(tea.KeyMsg{Runes: []rune("c"), Type: tea.KeyRunes})
+	if m3.(Model).state != resultState || !m3.(Model).showQR {
+		t.Errorf("expected overlay to swallow other keys, got state %v", m3.(Model).state)
+	}
+
+	// Esc closes the overlay rather than searching again
+	m4, _ := m3.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	closed := m4.(Model)
+	if closed.showQR || closed.state != resultState || closed.footer.Help() != defaultKeyMap().resultHelp() {
+		t.Errorf("expected esc to close the overlay, got showQR %v state %v", closed.showQR, closed.state)
+	}
+
+	// The link follows the active network
+	client.SetChainID(11155111)
+	m5, _ := closed.Update(tea.KeyMsg{Runes: []rune("q"), Type: tea.KeyRunes})
+	if got := m5.(Model).qrCode.URL(); got != "https://sepolia.etherscan.io/tx/0xaaa" {
+		t.Errorf("expected Sepolia link, got %q", got)
+	}
+}
+
+func TestUpdate_CallTree(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m0, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+
+	m1, _ := m0.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xaaa", To: "0xbbb", Status: "success"}})
+	m2, cmd := m1.Update(tea.KeyMsg{Runes: []rune("t"), Type: tea.KeyRunes})
+	if m2.(Model).state != loadingState || cmd == nil {
+		t.Fatalf("expected t to load the internal transactions, got state %v", m2.(Model).state)
+	}
+
+	m3, _ := m2.Update(traceMsg{calls: []etherscan.InternalTransaction{{To: "0xccc", Type: "call", TraceID: "0", Value: "1000000000000000000"}}})
+	shown := m3.(Model)
+	if shown.state != traceState || shown.footer.Help() != defaultKeyMap().traceHelp() {
+		t.Fatalf("expected the call tree, got state %v help %q", shown.state, shown.footer.Help())
+	}
+	if !strings.Contains(shown.View(), "└─ CALL → 0xccc 1 ETH") {
+		t.Errorf("expected the call in the view, got %q", shown.View())
+	}
+
+	// Result keys don't apply to the call tree
+	m4, _ := shown.Update(tea.KeyMsg{Runes: []rune("c"), Type: tea.KeyRunes})
+	if m4.(Model).state != traceState || m4.(Model).compareWith != "" {
+		t.Errorf("expected c to be ignored, got state %v", m4.(Model).state)
+	}
+
+	// Esc returns to the transaction rather than searching again
+	m5, _ := m4.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	back := m5.(Model)
+	if back.state != resultState || back.footer.Help() != defaultKeyMap().resultHelp() {
+		t.Errorf("expected esc to return to the transaction, got state %v help %q", back.state, back.footer.Help())
+	}
+
+	// A trace arriving after its load was abandoned is dropped
+	m6, _ := back.Update(traceMsg{})
+	if m6.(Model).state != resultState {
+		t.Errorf("expected a stale trace to be ignored, got state %v", m6.(Model).state)
+	}
+}
+
+func TestUpdate_AddressActivity(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	const addr = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	// A lowercase address is searched in checksummed form, listing its transactions
+	m.input.SetValue(strings.ToLower(addr))
+	m1, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m1.(Model).state != loadingState || cmd == nil || m1.(Model).loader.Text() != addr {
+		t.Fatalf("expected the address to load, got state %v text %q", m1.(Model).state, m1.(Model).loader.Text())
+	}
+
+	txs := []etherscan.AccountTransaction{
+		{Hash: "0xaaa", BlockNumber: "2", From: addr, To: "0xbbb", Value: "1 ETH", Status: "success"},
+		{Hash: "0xccc", BlockNumber: "1", From: "0xddd", To: addr, Value: "2 ETH", Status: "failed"},
+	}
+	m2, _ := m1.Update(addressTxsMsg{address: addr, page: 1, txs: txs})
+	list := m2.(Model)
+	if list.state != addressState || list.footer.Help() != defaultKeyMap().addressHelp() {
+		t.Fatalf("expected the address's transactions, got state %v help %q", list.state, list.footer.Help())
+	}
+	for _, sub := range []string{"Transactions of " + addr, "page 1", "0xaaa", "0xccc", "(failed)"} {
+		if !strings.Contains(list.View(), sub) {
+			t.Errorf("list missing expected substring: %q", sub)
+		}
+	}
+
+	// p does nothing on the first page, n loads the next one
+	if _, cmd := list.Update(tea.KeyMsg{Runes: []rune("p"), Type: tea.KeyRunes}); cmd != nil {
+		t.Errorf("expected no newer page before the first")
+	}
+	m3, _ := list.Update(tea.KeyMsg{Runes: []rune("n"), Type: tea.KeyRunes})
+	if m3.(Model).state != loadingState || !strings.Contains(m3.(Model).loader.Text(), "page 2") {
+		t.Fatalf("expected n to load page 2, got state %v", m3.(Model).state)
+	}
+	// An empty page past the last keeps the list shown
+	m4, _ := m3.Update(addressTxsMsg{address: addr, page: 2})
+	if m4.(Model).state != addressState || m4.(Model).activity.Page() != 1 || !strings.Contains(m4.(Model).footer.Help(), "no older transactions") {
+		t.Errorf("expected the first page kept with a notice, got page %d help %q", m4.(Model).activity.Page(), m4.(Model).footer.Help())
+	}
+
+	// Enter views the selected transaction, and esc goes back to the list
+	m5, _ := m4.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m6, _ := m5.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m6.(Model).state != loadingState || m6.(Model).loader.Text() != "0xccc" {
+		t.Fatalf("expected enter to load the selected transaction, got state %v text %q", m6.(Model).state, m6.(Model).loader.Text())
+	}
+	m7, _ := m6.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xccc", Status: "failed"}})
+	m8, _ := m7.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	back := m8.(Model)
+	if back.state != addressState {
+		t.Fatalf("expected esc to return to the list, got state %v", back.state)
+	}
+	if got, _ := back.activity.Selected(); got.Hash != "0xccc" {
+		t.Errorf("expected the selection kept, got %s", got.Hash)
+	}
+
+	// Esc from the list searches again, and a transaction found afterwards no longer goes back to it
+	m9, _ := back.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m9.(Model).state != inputState {
+		t.Fatalf("expected esc to search again, got state %v", m9.(Model).state)
+	}
+	m10, _ := m9.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xeee", Status: "success"}})
+	if m11, _ := m10.Update(tea.KeyMsg{Type: tea.KeyEsc}); m11.(Model).state != inputState {
+		t.Errorf("expected esc to search again, got state %v", m11.(Model).state)
+	}
+}
+
+func TestUpdate_KeyList(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m0, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+
+	m1, _ := m0.Update(tea.KeyMsg{Runes: []rune("?"), Type: tea.KeyRunes})
+	shown := m1.(Model)
+	if !shown.showKeys || shown.footer.Help() != defaultKeyMap().keysHelp() {
+		t.Fatalf("expected key list overlay, got showKeys %v help %q", shown.showKeys, shown.footer.Help())
+	}
+	if shown.input.Value() != "" {
+		t.Errorf("expected ? not to reach the search input, got %q", shown.input.Value())
+	}
+
+	// Typing filters the list instead of searching or switching modes
+	var updated tea.Model = shown
+	for _, r := range "copy" {
+		updated, _ = updated.Update(tea.KeyMsg{Runes: []rune{r}, Type: tea.KeyRunes})
+	}
+	filtered := updated.(Model)
+	if filtered.state != inputState || filtered.input.Value() != "" {
+		t.Errorf("expected the filter to swallow keys, got state %v input %q", filtered.state, filtered.input.Value())
+	}
+	view := filtered.View()
+	if !strings.Contains(view, "copy the selected field to the clipboard") || strings.Contains(view, "watch new blocks") {
+		t.Errorf("expected only matching bindings, got %q", view)
+	}
+
+	// Esc closes the list rather than quitting, and restores the footer
+	m2, cmd := filtered.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	closed := m2.(Model)
+	if cmd != nil || closed.showKeys || closed.footer.Help() != defaultKeyMap().inputHelp() {
+		t.Errorf("expected esc to close the list, got showKeys %v help %q", closed.showKeys, closed.footer.Help())
+	}
+
+	// Reopening starts with an empty filter
+	m3, _ := closed.Update(tea.KeyMsg{Runes: []rune("?"), Type: tea.KeyRunes})
+	if !strings.Contains(m3.(Model).View(), "watch new blocks") {
+		t.Errorf("expected the full list on reopening")
+	}
+}
+
+func TestUpdate_BlockNavigation(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	key := func(r string) tea.KeyMsg { return tea.KeyMsg{Runes: []rune(r), Type: tea.KeyRunes} }
+
+	tests := []struct {
+		name      string
+		index     string
+		count     string
+		wantHelp  string
+		prevLoads bool
+		nextLoads bool
+	}{
+		{"First", "0", "180", "tx 1 of 180 • (]) next in block • ", false, true},
+		{"Middle", "2", "180", "tx 3 of 180 • ([) prev in block • (]) next in block • ", true, true},
+		{"Last", "179", "180", "tx 180 of 180 • ([) prev in block • ", true, false},
+		{"OnlyTransaction", "0", "1", "tx 1 of 1 • ", false, false},
+		{"UnknownCount", "2", "", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &etherscan.Transaction{Hash: "0xaaa", Status: "success", BlockNumber: "19000000", TransactionIndex: tt.index, BlockTransactionCount: tt.count}
+			shown, _ := m.Update(txMsg{tx: tx})
+			if got := shown.(Model).footer.Help(); got != tt.wantHelp+defaultKeyMap().resultHelp() {
+				t.Errorf("expected help %q, got %q", tt.wantHelp+defaultKeyMap().resultHelp(), got)
+			}
+
+			prev, _ := shown.Update(key("["))
+			if loading := prev.(Model).state == loadingState; loading != tt.prevLoads {
+				t.Errorf("expected [ to load: %v, got %v", tt.prevLoads, loading)
+			}
+			next, _ := shown.Update(key("]"))
+			if loading := next.(Model).state == loadingState; loading != tt.nextLoads {
+				t.Errorf("expected ] to load: %v, got %v", tt.nextLoads, loading)
+			}
+			if tt.nextLoads {
+				if want := "of " + tt.count + " in block 19000000"; !strings.Contains(next.(Model).loader.Text(), want) {
+					t.Errorf("expected loader text to contain %q, got %q", want, next.(Model).loader.Text())
+				}
+			}
+		})
+	}
+}
+
+// recordingClipboard is a Clipboard that remembers the last value copied, or fails with err.
+type recordingClipboard struct {
+	copied string
+	err    error
+}
+
+func (c *recordingClipboard) Write(text string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.copied = text
+	return nil
+}
+
+func TestUpdate_CopyField(t *testing.T) {
+	clip := &recordingClipboard{}
+	m := New(etherscan.NewClient("test-key"))
+	m.SetClipboard(clip)
+	tx := &etherscan.Transaction{Hash: "0xaaa", Status: "success", From: "0x00000000000000000000000000000000000000aa"}
+	shown, _ := m.Update(txMsg{tx: tx})
+	copyKey := tea.KeyMsg{Runes: []rune("y"), Type: tea.KeyRunes}
+
+	// Nothing is selected yet
+	unselected, _ := shown.Update(copyKey)
+	if clip.copied != "" || !strings.HasPrefix(unselected.(Model).footer.Help(), "select a field") {
+		t.Errorf("expected a selection hint, got %q", unselected.(Model).footer.Help())
+	}
+
+	// Status, Hash, Type, Timestamp, Block Number, From
+	current := shown
+	for range 6 {
+		current, _ = current.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	done, _ := current.Update(copyKey)
+	if clip.copied != string(tx.From) {
+		t.Errorf("expected the bare sender address to be copied, got %q", clip.copied)
+	}
+	help := done.(Model).footer.Help()
+	if !strings.HasPrefix(help, "From copied! • ") {
+		t.Errorf("expected confirmation naming the field, got %q", help)
+	}
+
+	// The confirmation is brief, unless something else replaced it meanwhile
+	cleared, _ := done.Update(clearNoticeMsg{help: help})
+	if got := cleared.(Model).footer.Help(); got != cleared.(Model).resultHelp() {
+		t.Errorf("expected the result help once the notice expires, got %q", got)
+	}
+	if kept, _ := cleared.Update(clearNoticeMsg{help: "stale"}); kept.(Model).footer.Help() != cleared.(Model).footer.Help() {
+		t.Error("expected an expired notice no longer shown to leave the footer alone")
+	}
+
+	// The hash is copied without selecting it
+	hashed, cmd := shown.Update(tea.KeyMsg{Runes: []rune("Y"), Type: tea.KeyRunes})
+	if clip.copied != string(tx.Hash) || !strings.HasPrefix(hashed.(Model).footer.Help(), "Hash copied! • ") || cmd == nil {
+		t.Errorf("expected the hash copied with a brief notice, got %q", clip.copied)
+	}
+
+	clip.err = errors.New("no clipboard utility")
+	failed, _ := current.Update(copyKey)
+	if help := failed.(Model).footer.Help(); !strings.HasPrefix(help, "copy failed: no clipboard utility") {
+		t.Errorf("expected the failure to be reported, got %q", help)
+	}
+}
+
+func TestUpdate_Export(t *testing.T) {
+	t.Chdir(t.TempDir())
+	m := New(etherscan.NewClient("test-key"))
+	m1, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: testHash, Status: "success", Value: "♦ 1 ETH"}})
+
+	for _, tt := range []struct {
+		key  string
+		path string
+	}{
+		{"j", testHash + ".json"},
+		{"v", testHash + ".csv"},
+	} {
+		prompt, _ := m1.Update(tea.KeyMsg{Runes: []rune("e"), Type: tea.KeyRunes})
+		if got := prompt.(Model).footer.Help(); got != defaultKeyMap().exportHelp() {
+			t.Fatalf("expected the export prompt, got %q", got)
+		}
+		// Other keys don't leave the prompt
+		ignored, _ := prompt.Update(tea.KeyMsg{Runes: []rune("r"), Type: tea.KeyRunes})
+		if ignored.(Model).state != resultState || !ignored.(Model).exporting {
+			t.Fatalf("%s: expected the export prompt to stay open", tt.key)
+		}
+
+		chosen, cmd := ignored.Update(tea.KeyMsg{Runes: []rune(tt.key), Type: tea.KeyRunes})
+		if cmd == nil {
+			t.Fatalf("%s: expected an export command", tt.key)
+		}
+		done, _ := chosen.Update(cmd())
+		if got := done.(Model).footer.Help(); got != "exported "+tt.path+" • "+done.(Model).resultHelp() {
+			t.Errorf("%s: unexpected help after exporting: %q", tt.key, got)
+		}
+		if data, err := os.ReadFile(tt.path); err != nil || !strings.Contains(string(data), testHash) {
+			t.Errorf("%s: expected the transaction in %s, got %q, %v", tt.key, tt.path, data, err)
+		}
+	}
+
+	// Esc cancels the prompt
+	prompt, _ := m1.Update(tea.KeyMsg{Runes: []rune("e"), Type: tea.KeyRunes})
+	cancelled, _ := prompt.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cancelled.(Model).exporting || cancelled.(Model).state != resultState {
+		t.Errorf("expected esc to cancel the export and stay on the transaction")
+	}
+
+	// A failed write is shown on the error screen
+	failed, _ := m1.Update(exportedMsg{path: "missing/" + testHash + ".json", err: errors.New("no such file or directory")})
+	if failed.(Model).state != errorState || !strings.Contains(failed.(Model).View(), "export failed") {
+		t.Errorf("expected the export error, got state %v", failed.(Model).state)
+	}
+}
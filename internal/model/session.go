@@ -0,0 +1,63 @@
+package model
+
+import (
+	"awesomeProject/internal/etherscan"
+	"fmt"
+	"strings"
+)
+
+// sessionStats records the lookups made during a session for the summary printed on quit.
+type sessionStats struct {
+	lookups  int
+	chains   []string       // chain names in order of first use
+	byChain  map[string]int // lookups per chain name
+	failures []string       // "query: error" for each failed lookup
+	// lastChainID is the chain of the most recent successful lookup, or 0 before the first.
+	lastChainID int
+}
+
+// recordLookup counts a successful lookup on the given network.
+func (s *sessionStats) recordLookup(n etherscan.Network) {
+	chain := n.Name
+	s.lookups++
+	s.lastChainID = n.ChainID
+	if s.byChain == nil {
+		s.byChain = make(map[string]int)
+	}
+	if s.byChain[chain] == 0 {
+		s.chains = append(s.chains, chain)
+	}
+	s.byChain[chain]++
+}
+
+// recordFailure records a lookup that ended in an error.
+func (s *sessionStats) recordFailure(query string, err error) {
+	s.failures = append(s.failures, fmt.Sprintf("%s: %v", query, err))
+}
+
+// Summary returns a short report of the session's lookups, or "" if none were made.
+func (m Model) Summary() string {
+	s := m.session
+	if s == nil || s.lookups == 0 && len(s.failures) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Session summary: %d transaction(s) looked up", s.lookups)
+	if len(s.chains) > 0 {
+		counts := make([]string, 0, len(s.chains))
+		for _, chain := range s.chains {
+			counts = append(counts, fmt.Sprintf("%s: %d", chain, s.byChain[chain]))
+		}
+		fmt.Fprintf(&b, " (%s)", strings.Join(counts, ", "))
+	}
+	b.WriteString("\n")
+
+	if len(s.failures) > 0 {
+		fmt.Fprintf(&b, "%d lookup(s) failed:\n", len(s.failures))
+		for _, f := range s.failures {
+			b.WriteString("  - " + f + "\n")
+		}
+	}
+	return b.String()
+}
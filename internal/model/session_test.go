@@ -0,0 +1,48 @@
+package model
+
+import (
+	"awesomeProject/internal/etherscan"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSummary(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	if got := m.Summary(); got != "" {
+		t.Errorf("expected no summary for an empty session, got %q", got)
+	}
+
+	m2, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0x1"}})
+	m3, _ := m2.Update(txMsg{tx: &etherscan.Transaction{Hash: "0x2"}})
+	m4, _ := m3.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m5, _ := m4.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m6, _ := m5.Update(txMsg{tx: &etherscan.Transaction{Hash: "0x3"}})
+
+	// A failed lookup is recorded with the query that was being loaded
+	updated := m6.(Model)
+	updated.state = loadingState
+	updated.loader.SetText("0xbad")
+	m7, _ := updated.Update(errMsg(errors.New("transaction not found")))
+
+	// Errors outside a lookup (e.g., the header's latest block poll) are not lookups
+	m8, _ := m7.Update(errMsg(errors.New("header poll failed")))
+
+	summary := m8.(Model).Summary()
+	for _, sub := range []string{
+		"3 transaction(s) looked up (Mainnet: 2, Sepolia: 1)",
+		"1 lookup(s) failed",
+		"0xbad: transaction not found",
+	} {
+		if !strings.Contains(summary, sub) {
+			t.Errorf("summary missing %q, got:\n%s", sub, summary)
+		}
+	}
+	if strings.Contains(summary, "header poll failed") {
+		t.Errorf("summary should not include non-lookup errors, got:\n%s", summary)
+	}
+}
@@ -2,8 +2,17 @@ package model
 
 import (
 	"awesomeProject/internal/etherscan"
-	"awesomeProject/internal/tui/components/transaction"
+	"awesomeProject/internal/tui/components/activity"
+	"awesomeProject/internal/tui/components/calltree"
+	"awesomeProject/internal/tui/components/compare"
+	"awesomeProject/internal/tui/components/history"
+	"awesomeProject/internal/tui/components/keyhelp"
+	"awesomeProject/internal/tui/components/qr"
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,99 +34,557 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.footer.UpdateProgramContext(m.ctx)
 		m.errorView.UpdateProgramContext(m.ctx)
 		m.loader.UpdateProgramContext(m.ctx)
+		m.banner.UpdateProgramContext(m.ctx)
+		m.blockWatch.UpdateProgramContext(m.ctx)
+		m.compare.UpdateProgramContext(m.ctx)
+		m.qrCode.UpdateProgramContext(m.ctx)
+		m.keyHelp.UpdateProgramContext(m.ctx)
+		m.callTree.UpdateProgramContext(m.ctx)
+		m.historyList.UpdateProgramContext(m.ctx)
+		m.activity.UpdateProgramContext(m.ctx)
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyCtrlC:
+		k := m.keys
+		if m.showQR && !k.matches(msg, actionQuit) {
+			// The QR code is modal: only closing it does anything
+			if k.matches(msg, actionQR, actionBack) {
+				m.showQR = false
+				m.footer.SetHelp(m.resultHelp())
+			}
+			return m, nil
+		}
+		if m.exporting && !k.matches(msg, actionQuit) {
+			// Choosing the export format is modal: other keys are ignored
+			switch {
+			case k.matches(msg, actionExportJSON, actionExportCSV):
+				format := jsonExport
+				if k.matches(msg, actionExportCSV) {
+					format = csvExport
+				}
+				m.exporting = false
+				m.footer.SetHelp(m.resultHelp())
+				return m, exportCmd(m.tx, format)
+			case k.matches(msg, actionBack):
+				m.exporting = false
+				m.footer.SetHelp(m.resultHelp())
+			}
+			return m, nil
+		}
+		if m.showKeys && !k.matches(msg, actionQuit) {
+			// The key list is modal too: keys go to its filter
+			if k.matches(msg, actionBack) {
+				m.showKeys = false
+				m.footer.SetHelp(m.keysReturn)
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.keyHelp, cmd = m.keyHelp.Update(msg)
+			return m, cmd
+		}
+		if m.showHistory && !k.matches(msg, actionQuit) {
+			// So is the history: keys other than these go to its filter
+			switch {
+			case k.matches(msg, actionBack):
+				m.showHistory = false
+				m.footer.SetHelp(m.resultHelp())
+				return m, nil
+			case k.matches(msg, actionConfirm):
+				i, ok := m.historyList.Selected()
+				if !ok {
+					return m, nil
+				}
+				m.showHistory = false
+				return m, m.reopen(m.history.newestFirst(i))
+			}
+			var cmd tea.Cmd
+			m.historyList, cmd = m.historyList.Update(msg)
+			return m, cmd
+		}
+		if k.matches(msg, actionHelp) && m.state != loadingState {
+			m.keyHelp = keyhelp.New(m.ctx, k.bindings())
+			m.showKeys = true
+			m.keysReturn = m.footer.Help()
+			m.footer.SetHelp(k.keysHelp())
+			return m, nil
+		}
+		if k.matches(msg, actionQuit) {
 			return m, tea.Quit
-		case tea.KeyEsc:
+		}
+		if k.matches(msg, actionBack) {
+			if m.state == inputState && m.chainEntry {
+				return m, m.searchAgain()
+			}
+			if m.state == inputState && m.compareWith != "" {
+				// Cancel the comparison and return to the transaction it started from
+				m.compareWith = ""
+				m.input.SetPrompt(inputPrompt)
+				m.state = resultState
+				m.footer.SetHelp(k.resultHelp())
+				return m, nil
+			}
 			if m.state == inputState {
 				return m, tea.Quit
 			}
-			m.state = inputState
-			m.input.SetValue("")
-			m.footer.SetHelp("(tab) switch network • (l) latest hash • (enter) search • (ctrl+c) quit")
-			return m, m.input.Focus()
-		case tea.KeyTab:
-			if m.state == inputState {
-				chainID := m.client.ChainID()
-				if chainID == 1 {
-					chainID = 11155111
-				} else {
-					chainID = 1
+			if m.state == traceState {
+				m.state = resultState
+				m.footer.SetHelp(m.resultHelp())
+				return m, nil
+			}
+			if m.state == resultState && m.fromList {
+				m.stopPolling()
+				m.fromList = false
+				m.state = addressState
+				m.footer.SetHelp(k.addressHelp())
+				return m, nil
+			}
+			return m, m.searchAgain()
+		}
+		if k.matches(msg, actionSwitchNetwork) && m.state == inputState {
+			// The next chain comes from the registry, so switching to it can't fail
+			cmd, _ := m.switchChain(m.client.NextChain().ChainID)
+			return m, cmd
+		}
+		if k.matches(msg, actionNextSection) && m.state == resultState {
+			m.transaction.FocusNext()
+			return m, nil
+		}
+		if k.matches(msg, actionPrevSection) && m.state == resultState {
+			m.transaction.FocusPrev()
+			return m, nil
+		}
+		if k.matches(msg, actionSelectUp, actionSelectDown) && m.state == inputState && !m.chainEntry {
+			recall := m.history.prev
+			if k.matches(msg, actionSelectDown) {
+				recall = m.history.next
+			}
+			if e, ok := recall(); ok {
+				m.input.SetValue(string(e.hash))
+			}
+			return m, nil
+		}
+		if k.matches(msg, actionConfirm) && m.state == inputState {
+			hash := strings.TrimSpace(m.input.Value())
+			if hash == "" {
+				return m, nil
+			}
+			if m.compareWith != "" {
+				return m, m.startCompare(hash)
+			}
+			if m.chainEntry {
+				return m, m.setChain(hash)
+			}
+			switch q := etherscan.Classify(hash); q.Kind {
+			case etherscan.QueryAddressNonce, etherscan.QueryAddress:
+				// Warn once about a likely typo; pressing enter again searches anyway
+				if _, checksumOK := etherscan.IsValidAddress(string(q.Address)); !checksumOK && m.input.Warning() == "" {
+					m.input.SetWarning(checksumWarning)
+					return m, nil
+				}
+				if checksummed, err := etherscan.ToChecksum(q.Address); err == nil {
+					hash = string(checksummed)
+				}
+				if q.Kind == etherscan.QueryAddressNonce {
+					hash += "#" + q.Nonce
 				}
-				m.client.SetChainID(chainID)
-				m.header.SetChainID(chainID)
-				m.header.SetLatestBlock("", "") // Reset while fetching
-				return m, tea.Batch(fetchLatestBlockCmd(context.Background(), m.client), m.header.Tick())
-			}
-		case tea.KeyEnter, tea.KeyBackspace:
-			if m.state == inputState && msg.Type == tea.KeyEnter {
-				hash := strings.TrimSpace(m.input.Value())
-				if hash == "" {
+			case etherscan.QueryHash:
+				// A malformed hash can't be found, so don't spend a request on it
+				if err := etherscan.ValidateTxHash(string(q.Hash)); err != nil {
+					m.showError(err)
 					return m, nil
 				}
-				m.state = loadingState
-				m.loader.SetText(hash)
-				return m, tea.Batch(fetchTransactionCmd(context.Background(), etherscan.Hash(hash), m.client), m.loader.SetPercent(0), tickCmd())
-			}
-			if m.state == resultState || m.state == errorState {
-				m.state = inputState
-				m.input.SetValue("")
-				m.footer.SetHelp("(tab) switch network • (l) latest hash • (enter) search • (ctrl+c) quit")
-				return m, m.input.Focus()
-			}
-		case tea.KeyRunes:
-			if (strings.Contains(string(msg.Runes), "L") || strings.Contains(string(msg.Runes), "l")) && m.state == inputState {
-				latestHash := m.header.LatestTxHash()
-				if latestHash != "" {
-					m.input.SetValue(latestHash)
-					m.state = loadingState
-					m.loader.SetText(latestHash)
-					return m, tea.Batch(fetchTransactionCmd(context.Background(), etherscan.Hash(latestHash), m.client), m.loader.SetPercent(0), tickCmd())
+			}
+			return m, m.startLoading(hash, searchCmd(context.Background(), hash, m.client))
+		}
+		if k.matches(msg, actionConfirm) && m.state == resultState {
+			m.transaction.ToggleFocused()
+			return m, nil
+		}
+		if k.matches(msg, actionConfirm) && m.state == errorState && m.redirect != nil {
+			r := m.redirect
+			m.redirect = nil
+			switchCmd, err := m.switchChain(r.network.ChainID)
+			if err != nil {
+				m.showError(err)
+				return m, nil
+			}
+			return m, tea.Batch(switchCmd, m.startLoading(string(r.hash), fetchTransactionCmd(context.Background(), r.hash, m.client)))
+		}
+		if k.matches(msg, actionSearchAgain) && (m.state == resultState || m.state == errorState || m.state == compareState || m.state == addressState) ||
+			k.matches(msg, actionConfirm) && (m.state == errorState || m.state == compareState) {
+			return m, m.searchAgain()
+		}
+		if k.matches(msg, actionSetChain) && m.state == inputState && !m.chainEntry && m.compareWith == "" && m.input.Value() == "" {
+			// Only on an empty input, since C can be part of a checksummed address
+			m.chainEntry = true
+			m.input.SetPrompt(chainPrompt)
+			m.footer.SetHelp(k.chainInputHelp())
+			return m, nil
+		}
+		if k.matches(msg, actionKeepNetwork) && m.state == inputState && m.networkHint() != "" {
+			m.keepNetwork = true
+			return m, nil
+		}
+		if k.matches(msg, actionLatest) && m.state == inputState {
+			latestHash := m.header.LatestTxHash()
+			if latestHash != "" {
+				m.input.SetValue(latestHash)
+				return m, m.startLoading(latestHash, fetchTransactionCmd(context.Background(), etherscan.Hash(latestHash), m.client))
+			}
+		}
+		if k.matches(msg, actionWatch) {
+			switch m.state {
+			case inputState:
+				m.state = watchState
+				m.watchRun++
+				m.blockWatch.Reset()
+				m.footer.SetHelp(k.watchHelp())
+				cmds := []tea.Cmd{m.resumeWatch(), m.blockWatch.Tick()}
+				if m.maxWatch > 0 {
+					cmds = append(cmds, watchDeadlineCmd(m.watchRun, m.maxWatch))
 				}
+				return m, tea.Batch(cmds...)
+			case watchState:
+				if m.blockWatch.Paused() {
+					return m, tea.Batch(m.resumeWatch(), m.blockWatch.Tick())
+				}
+				// Invalidate the pending poll so the loop stops
+				m.watchID++
+				m.blockWatch.SetPaused(true)
+				return m, nil
+			}
+		}
+		if m.state == traceState {
+			m.callTree, cmd = m.callTree.Update(msg)
+			return m, cmd
+		}
+		if m.state == addressState {
+			address, page := m.activity.Address(), m.activity.Page()
+			switch {
+			case k.matches(msg, actionConfirm):
+				tx, ok := m.activity.Selected()
+				if !ok {
+					return m, nil
+				}
+				m.fromList = true
+				return m, m.startLoading(string(tx.Hash), fetchTransactionCmd(context.Background(), tx.Hash, m.client))
+			case k.matches(msg, actionNextPage):
+				return m, m.startLoading(fmt.Sprintf("%s page %d", address, page+1), fetchAddressTxsCmd(context.Background(), address, page+1, m.client))
+			case k.matches(msg, actionPrevPage) && page > 1:
+				return m, m.startLoading(fmt.Sprintf("%s page %d", address, page-1), fetchAddressTxsCmd(context.Background(), address, page-1, m.client))
+			}
+			m.activity, cmd = m.activity.Update(msg)
+			return m, cmd
+		}
+		if m.state != resultState {
+			break
+		}
+		if k.matches(msg, actionScrollUp, actionScrollDown) {
+			if m.layoutResult() {
+				m.resultView, _ = m.resultView.Update(msg)
+			}
+			return m, nil
+		}
+		if k.matches(msg, actionRefresh) && m.snapshot == nil {
+			hash := m.tx.Hash
+			return m, m.startLoading(string(hash), fetchTransactionCmd(context.Background(), hash, m.client))
+		}
+		if k.matches(msg, actionNextTx) && m.snapshot == nil {
+			return m, m.startLoading("next transaction", fetchNextTransactionCmd(context.Background(), m.tx, m.client))
+		}
+		if k.matches(msg, actionPrevTx) && m.snapshot == nil {
+			return m, m.startLoading("previous transaction", fetchPreviousTransactionCmd(context.Background(), m.tx, m.client))
+		}
+		if k.matches(msg, actionPrevInBlock, actionNextInBlock) && m.snapshot == nil {
+			index, count, ok := m.blockPosition()
+			target := index + 1
+			if k.matches(msg, actionPrevInBlock) {
+				target = index - 1
 			}
-			if (strings.Contains(string(msg.Runes), "R") || strings.Contains(string(msg.Runes), "r")) && m.state == resultState {
-				hash := m.tx.Hash
-				m.state = loadingState
-				m.loader.SetText(string(hash))
-				return m, tea.Batch(fetchTransactionCmd(context.Background(), hash, m.client), m.loader.SetPercent(0), tickCmd())
+			if !ok || target < 0 || target >= count {
+				return m, nil
 			}
-			if (strings.Contains(string(msg.Runes), "N") || strings.Contains(string(msg.Runes), "n")) && m.state == resultState {
-				m.state = loadingState
-				m.loader.SetText("next transaction")
-				return m, tea.Batch(fetchNextTransactionCmd(context.Background(), m.tx, m.client), m.loader.SetPercent(0), tickCmd())
+			label := fmt.Sprintf("tx %d of %d in block %s", target+1, count, m.tx.BlockNumber)
+			return m, m.startLoading(label, fetchBlockTransactionCmd(context.Background(), m.tx.BlockNumber, target, m.client))
+		}
+		if k.matches(msg, actionUnit) {
+			// The unit lives on the shared context so it persists across lookups
+			m.ctx.Unit = m.ctx.Unit.Next()
+			return m, nil
+		}
+		if k.matches(msg, actionCompare) && m.snapshot == nil {
+			m.compareWith = m.tx.Hash
+			m.state = inputState
+			m.input.SetValue("")
+			m.input.SetPrompt("Compare " + string(m.tx.Hash) + " with transaction hash:")
+			m.footer.SetHelp(k.compareInputHelp())
+			return m, m.input.Focus()
+		}
+		if k.matches(msg, actionTrace) && m.snapshot == nil {
+			return m, m.startLoading("internal transactions", fetchInternalTransactionsCmd(context.Background(), m.tx.Hash, m.client))
+		}
+		if k.matches(msg, actionSnapshot) {
+			snapshot := m.snapshot
+			if snapshot == nil {
+				snapshot = m.client.NewSnapshot(m.tx, m.fetchedAt)
 			}
-			if (strings.Contains(string(msg.Runes), "P") || strings.Contains(string(msg.Runes), "p")) && m.state == resultState {
-				m.state = loadingState
-				m.loader.SetText("previous transaction")
-				return m, tea.Batch(fetchPreviousTransactionCmd(context.Background(), m.tx, m.client), m.loader.SetPercent(0), tickCmd())
+			return m, saveSnapshotCmd(snapshot)
+		}
+		if k.matches(msg, actionQR) {
+			m.qrCode = qr.New(m.ctx, m.client.Network().TransactionURL(m.tx.Hash))
+			m.showQR = true
+			m.footer.SetHelp(k.qrHelp())
+			return m, nil
+		}
+		if k.matches(msg, actionCopy) {
+			label, value, ok := m.transaction.SelectedField()
+			if !ok {
+				m.footer.SetHelp("select a field with " + k.keyNames(actionSelectUp, actionSelectDown) + " to copy it • " + m.resultHelp())
+				return m, nil
 			}
+			return m, m.copyValue(label, value)
+		}
+		if k.matches(msg, actionCopyHash) {
+			return m, m.copyValue("Hash", string(m.tx.Hash))
+		}
+		if k.matches(msg, actionExport) {
+			m.exporting = true
+			m.footer.SetHelp(k.exportHelp())
+			return m, nil
+		}
+		if k.matches(msg, actionHistory) {
+			m.historyList = history.New(m.ctx, m.history.listEntries())
+			m.historyList.SetKeyMap(k.historyKeys())
+			m.showHistory = true
+			m.footer.SetHelp(k.historyHelp())
+			return m, nil
+		}
+		if k.matches(msg, actionToggleInput) {
+			m.ctx.HideInput = !m.ctx.HideInput
+			return m, nil
+		}
+		if k.matches(msg, actionFollow) && m.snapshot == nil && m.tx.Status == "replaced" {
+			return m, m.startLoading("replacement transaction", fetchReplacementTransactionCmd(context.Background(), m.tx, m.client))
 		}
 	case txMsg:
+		m.setOnline()
+		m.session.recordLookup(m.client.Network())
+		m.keepNetwork = false
 		m.tx = msg.tx
+		m.fetchedAt = time.Now()
+		m.history.add(historyEntry{hash: m.tx.Hash, chainID: m.client.ChainID(), status: m.tx.Status, searched: m.fetchedAt, tx: m.tx})
 		m.state = resultState
-		m.transaction = transaction.New(m.ctx, m.tx)
-		m.footer.SetHelp("(r) refresh • (p) prev tx • (n) next tx • (backspace/enter/esc) search again • (ctrl+c) quit")
+		m.transaction = m.newTransaction(m.tx)
+		m.resultView.GotoTop()
+		m.showQR = false
+		m.footer.SetHelp(m.resultHelp())
+		return m, tea.Batch(m.loader.SetPercent(1.0), m.pollPending())
+	case pendingTickMsg:
+		if msg.id != m.pollID || m.state != resultState {
+			return m, nil
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		m.cancelPoll = cancel
+		return m, fetchPendingCmd(ctx, msg.id, m.tx.Hash, m.client)
+	case pendingTxMsg:
+		if msg.id != m.pollID || m.state != resultState {
+			return m, nil // Polling stopped while fetching
+		}
+		if m.cancelPoll != nil {
+			m.cancelPoll() // Release the finished refetch's context
+			m.cancelPoll = nil
+		}
+		if msg.err != nil {
+			// Keep showing the transaction and try again on the next tick
+			m.setPollHelp("refresh failed: " + msg.err.Error() + " • " + m.resultHelp())
+			return m, pendingTickCmd(msg.id, m.pendingPoll)
+		}
+		m.setOnline()
+		m.tx = msg.tx
+		m.fetchedAt = time.Now()
+		m.history.update(m.client.ChainID(), m.tx)
+		m.transaction.SetTransaction(m.tx)
+		// Settling can add block navigation or a replacement to follow
+		m.setPollHelp(m.resultHelp())
+		if m.tx.Status != "Pending" {
+			return m, nil
+		}
+		return m, pendingTickCmd(msg.id, m.pendingPoll)
+	case compareMsg:
+		m.setOnline()
+		for _, side := range []compare.Side{msg.a, msg.b} {
+			if side.Err != nil {
+				m.session.recordFailure(string(side.Hash), side.Err)
+			} else {
+				m.session.recordLookup(m.client.Network())
+			}
+		}
+		m.state = compareState
+		m.compare = compare.New(m.ctx, msg.a, msg.b)
+		m.footer.SetHelp(m.keys.compareHelp())
 		return m, m.loader.SetPercent(1.0)
+	case addressTxsMsg:
+		if m.state != loadingState {
+			return m, nil // The load was abandoned
+		}
+		m.setOnline()
+		m.state = addressState
+		if len(msg.txs) == 0 && msg.page > 1 {
+			// Past the last page: keep the page already shown
+			m.footer.SetHelp("no older transactions • " + m.keys.addressHelp())
+			return m, m.loader.SetPercent(1.0)
+		}
+		m.activity = activity.New(m.ctx, msg.address, msg.page, msg.txs)
+		m.activity.SetKeyMap(m.keys.activityKeys())
+		m.footer.SetHelp(m.keys.addressHelp())
+		return m, m.loader.SetPercent(1.0)
+	case traceMsg:
+		if m.state != loadingState {
+			return m, nil // The load was abandoned
+		}
+		m.setOnline()
+		m.state = traceState
+		m.callTree = calltree.New(m.ctx, m.tx, msg.calls)
+		m.callTree.SetKeyMap(m.keys.scrollKeys())
+		m.footer.SetHelp(m.keys.traceHelp())
+		return m, m.loader.SetPercent(1.0)
+	case snapshotSavedMsg:
+		if m.state != resultState {
+			return m, nil
+		}
+		help := m.resultHelp()
+		if msg.err != nil {
+			m.footer.SetHelp("snapshot failed: " + msg.err.Error() + " • " + help)
+		} else {
+			m.footer.SetHelp("saved " + msg.path + " • " + help)
+		}
+		return m, nil
+	case clearNoticeMsg:
+		// Anything shown in the footer since the notice stays
+		if m.footer.Help() == msg.help {
+			m.footer.SetHelp(m.resultHelp())
+		}
+		return m, nil
+	case exportedMsg:
+		if m.state != resultState {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.showError(fmt.Errorf("export failed: %w", msg.err))
+			return m, nil
+		}
+		m.footer.SetHelp("exported " + msg.path + " • " + m.resultHelp())
+		return m, nil
 	case latestBlockMsg:
-		m.header.SetLatestBlock(msg.blockNumber, msg.lastTxHash)
+		m.setOnline()
+		m.setLatestBlock(msg)
 		return m, nil
+	case headTickMsg:
+		// The check only runs while its result is visible, and never while offline since the ping already polls
+		if m.state != inputState || m.snapshot != nil || m.banner.Visible() {
+			return m, headTickCmd()
+		}
+		return m, tea.Batch(fetchHeadCmd(context.Background(), m.client), headTickCmd())
+	case headMsg:
+		if msg.chainID != m.client.ChainID() {
+			return m, nil // The chain was switched while checking
+		}
+		if msg.err != nil {
+			m.headTime = time.Time{}
+			return m, nil
+		}
+		m.setLatestBlock(msg.latest)
+		return m, nil
+	case foundElsewhereMsg:
+		m.setOnline()
+		m.session.recordFailure(string(msg.hash), msg.err)
+		m.err = msg.err
+		m.errorView.SetError(msg.err)
+		m.errorView.SetHint(fmt.Sprintf("Not found on %s, but found on %s — press Enter to view.", m.client.Network().Name, msg.network.Name))
+		m.redirect = &msg
+		m.state = errorState
+		m.footer.SetHelp(m.keys.redirectHelp())
+		return m, m.loader.SetPercent(1.0)
 	case errMsg:
+		m.redirect = nil
+		if m.state == loadingState {
+			m.session.recordFailure(m.loader.Text(), msg)
+		}
 		m.err = msg
 		m.errorView.SetError(msg)
+		m.errorView.SetHint(m.errorHint(msg))
 		m.state = errorState
-		m.footer.SetHelp("press backspace/enter/esc to try again • ctrl+c to quit")
+		m.footer.SetHelp(m.keys.errorHelp())
+		if _, ok := errors.AsType[*etherscan.NetworkError](msg); !ok {
+			m.setOnline()
+			return m, nil
+		}
+		m.netFailures++
+		if m.netFailures < offlineThreshold || m.banner.Visible() {
+			return m, nil
+		}
+		m.banner.SetText(offlineText)
+		return m, pingCmd(context.Background(), m.client)
+	case pingMsg:
+		if !m.banner.Visible() {
+			return m, nil
+		}
+		if msg.err != nil {
+			return m, pingCmd(context.Background(), m.client)
+		}
+		m.setOnline()
 		return m, nil
-	case tickMsg:
+	case watchTickMsg:
+		if msg.id != m.watchID || m.state != watchState {
+			return m, nil
+		}
+		return m, fetchNewBlockCmd(context.Background(), m.client, msg.id, m.blockWatch.LatestBlock())
+	case watchDeadlineMsg:
+		// Pausing doesn't extend the deadline, but leaving watch mode cancels it
+		if msg.run != m.watchRun || m.state != watchState {
+			return m, nil
+		}
+		m.watchID++
+		m.gaveUp = fmt.Sprintf("gave up after %s watching blocks; %s", m.maxWatch, m.blockWatch.LastState())
+		return m, tea.Quit
+	case watchBlockMsg:
+		if msg.id != m.watchID || m.state != watchState {
+			return m, nil
+		}
+		switch {
+		case msg.err != nil:
+			m.blockWatch.SetError(msg.err)
+		case msg.block != nil:
+			m.blockWatch.AddBlock(*msg.block)
+		}
+		return m, watchTickCmd(msg.id)
+	case stepMsg:
+		next := waitForStepCmd(msg.steps)
 		if m.state != loadingState {
+			return m, next
+		}
+		if !msg.step.Done {
+			m.loader.SetStep(msg.step.Label)
+			return m, next
+		}
+		m.progressAt = time.Now()
+		if p := stageProgress(msg.step.Completed); p > m.loader.Percent() {
+			return m, tea.Batch(next, m.loader.SetPercent(p))
+		}
+		return m, next
+	case tickMsg:
+		// Ticks from an earlier load stop here instead of doubling the progress rate
+		if msg.id != m.loadID || m.state != loadingState {
 			return m, nil
 		}
-		if m.loader.Percent() >= 0.9 {
+		if m.loader.Percent() >= maxLoadProgress {
 			return m, nil
 		}
-		return m, tea.Batch(tickCmd(), m.loader.IncrPercent(0.1))
+		// Finished steps drive the bar; only creep forward while they're slow to come
+		if time.Since(m.progressAt) < progressFallback {
+			return m, tickCmd(msg.id)
+		}
+		return m, tea.Batch(tickCmd(msg.id), m.loader.SetPercent(min(m.loader.Percent()+fallbackIncrement, maxLoadProgress)))
 	}
 
 	m.loader, cmd = m.loader.Update(msg)
@@ -131,6 +598,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	m.transaction, cmd = m.transaction.Update(msg)
 	cmds = append(cmds, cmd)
+	if _, ok := msg.(tea.KeyMsg); ok && m.state == resultState {
+		m.followSelection()
+	}
 
 	m.footer, cmd = m.footer.Update(msg)
 	cmds = append(cmds, cmd)
@@ -138,13 +608,277 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.errorView, cmd = m.errorView.Update(msg)
 	cmds = append(cmds, cmd)
 
+	m.blockWatch, cmd = m.blockWatch.Update(msg)
+	cmds = append(cmds, cmd)
+
 	return m, tea.Batch(cmds...)
 }
 
-type tickMsg time.Time
+// stageProgress returns where the loader stands once completed fetch steps have finished.
+// Each step fills stageShare of what remains below maxLoadProgress, so the bar keeps
+// moving however many steps a fetch takes, and only the result fills it.
+func stageProgress(completed int) float64 {
+	return maxLoadProgress * (1 - math.Pow(1-stageShare, float64(completed)))
+}
+
+// tickMsg keeps the loader moving for the load with the given id when fetch steps are slow.
+type tickMsg struct{ id int }
 
-func tickCmd() tea.Cmd {
-	return tea.Tick(time.Millisecond*100, func(t time.Time) tea.Msg {
-		return tickMsg(t)
+func tickCmd(id int) tea.Cmd {
+	return tea.Tick(time.Millisecond*100, func(_ time.Time) tea.Msg {
+		return tickMsg{id: id}
 	})
 }
+
+// startLoading shows the loader for text and runs fetch, advancing progress as its steps
+// finish, with a fallback tick for when they are slow, until the result arrives.
+// Each load gets a new id, so a tick loop left over from a previous load stops on its next tick.
+func (m *Model) startLoading(text string, fetch tea.Cmd) tea.Cmd {
+	m.stopPolling()
+	m.loadID++
+	m.state = loadingState
+	m.progressAt = time.Now()
+	m.loader.SetText(text)
+	return tea.Batch(fetch, m.loader.SetPercent(0), tickCmd(m.loadID))
+}
+
+// showError shows err on the error screen without loading anything first.
+func (m *Model) showError(err error) {
+	m.stopPolling()
+	m.redirect = nil
+	m.err = err
+	m.errorView.SetError(err)
+	m.errorView.SetHint(m.errorHint(err))
+	m.state = errorState
+	m.footer.SetHelp(m.keys.errorHelp())
+}
+
+// errorHint suggests how to recover from err, or returns "" if there's nothing
+// better to do than search again.
+func (m Model) errorHint(err error) string {
+	switch {
+	case errors.Is(err, etherscan.ErrNoAPIKey), errors.Is(err, etherscan.ErrInvalidAPIKey):
+		return "Check ETHERSCAN_API_KEY in your environment or .env file, then restart."
+	case errors.Is(err, etherscan.ErrRateLimited):
+		return "Too many requests for your API key — wait a few seconds, then search again."
+	case errors.Is(err, etherscan.ErrQuotaExceeded):
+		return "Try again once the daily quota resets, or use another API key."
+	case errors.Is(err, etherscan.ErrWrongNetwork):
+		if names := m.keys.keyNames(actionSwitchNetwork); names != "" {
+			return "Search again and press " + names + " to switch to another network."
+		}
+		return "Search again on another network."
+	case errors.Is(err, etherscan.ErrTransactionNotFound):
+		return "Check the hash for typos; a transaction only shows once it's been broadcast."
+	case errors.Is(err, etherscan.ErrInvalidTxHash):
+		return "A transaction hash is 0x followed by 64 hexadecimal characters."
+	}
+	return ""
+}
+
+// searchAgain returns to an empty search input, leaving any comparison or snapshot.
+func (m *Model) searchAgain() tea.Cmd {
+	m.stopPolling()
+	m.state = inputState
+	m.compareWith = ""
+	m.chainEntry = false
+	m.fromList = false
+	m.redirect = nil
+	m.history.resetCursor()
+	m.input.SetValue("")
+	m.input.SetPrompt(inputPrompt)
+	m.footer.SetHelp(m.keys.inputHelp())
+	if m.snapshot != nil {
+		// The latest block was never fetched while viewing the snapshot
+		m.snapshot = nil
+		return tea.Batch(m.input.Focus(), fetchLatestBlockCmd(context.Background(), m.client), headTickCmd())
+	}
+	return m.input.Focus()
+}
+
+// reopen shows a transaction from the history as it was when viewed, without
+// fetching it again, switching back to the network it was found on if needed.
+func (m *Model) reopen(e historyEntry) tea.Cmd {
+	var cmd tea.Cmd
+	if e.chainID != m.client.ChainID() {
+		switchCmd, err := m.switchChain(e.chainID)
+		if err != nil {
+			m.showError(err)
+			return nil
+		}
+		cmd = switchCmd
+	}
+	m.tx = e.tx
+	m.fetchedAt = e.searched
+	m.state = resultState
+	m.transaction = m.newTransaction(m.tx)
+	m.resultView.GotoTop()
+	m.showQR = false
+	m.footer.SetHelp(m.resultHelp())
+	return tea.Batch(cmd, m.pollPending())
+}
+
+// copyValue copies value to the clipboard, confirming with a brief notice naming
+// its label. A failure stays in the footer until something replaces it.
+func (m *Model) copyValue(label, value string) tea.Cmd {
+	if err := m.clipboard.Write(value); err != nil {
+		m.footer.SetHelp("copy failed: " + err.Error() + " • " + m.resultHelp())
+		return nil
+	}
+	help := label + " copied! • " + m.resultHelp()
+	m.footer.SetHelp(help)
+	return clearNoticeCmd(help)
+}
+
+// pollPending starts fetching the transaction shown again every pendingPoll
+// while it's pending, stopping any earlier polling. Snapshots are never polled.
+func (m *Model) pollPending() tea.Cmd {
+	m.stopPolling()
+	if m.tx.Status != "Pending" || m.snapshot != nil || m.pendingPoll <= 0 {
+		return nil
+	}
+	return pendingTickCmd(m.pollID, m.pendingPoll)
+}
+
+// stopPolling stops polling a pending transaction, cancelling a refetch in flight.
+// The next tick of the old poll sees the new id and stops there.
+func (m *Model) stopPolling() {
+	m.pollID++
+	if m.cancelPoll != nil {
+		m.cancelPoll()
+		m.cancelPoll = nil
+	}
+}
+
+// setPollHelp shows help on the footer after polling, or saves it for when an
+// overlay closes. The QR code, export prompt and history restore the result help themselves.
+func (m *Model) setPollHelp(help string) {
+	switch {
+	case m.showKeys:
+		m.keysReturn = help
+	case !m.showQR && !m.exporting && !m.showHistory:
+		m.footer.SetHelp(help)
+	}
+}
+
+// resultHelp returns the footer help for the transaction being shown.
+func (m Model) resultHelp() string {
+	if m.snapshot != nil {
+		return m.keys.snapshotHelp()
+	}
+	var follow string
+	if m.tx != nil && m.tx.Status == "replaced" {
+		follow = m.keys.help("follow replacement", actionFollow)
+	}
+	return helpLine(m.blockNavHelp(), follow, m.keys.resultHelp())
+}
+
+// blockPosition returns the current transaction's zero-based index in its block
+// and the block's transaction count. ok is false if either is unknown, as for
+// pending transactions or when the block wasn't fetched.
+func (m Model) blockPosition() (index, count int, ok bool) {
+	if m.tx == nil {
+		return 0, 0, false
+	}
+	index, err := strconv.Atoi(m.tx.TransactionIndex)
+	if err != nil {
+		return 0, 0, false
+	}
+	count, err = strconv.Atoi(m.tx.BlockTransactionCount)
+	if err != nil || index < 0 || index >= count {
+		return 0, 0, false
+	}
+	return index, count, true
+}
+
+// blockNavHelp shows the transaction's position in its block with the keys that
+// step through it, leaving out a key at either end of the block.
+func (m Model) blockNavHelp() string {
+	index, count, ok := m.blockPosition()
+	if !ok {
+		return ""
+	}
+	parts := []string{fmt.Sprintf("tx %d of %d", index+1, count)}
+	if index > 0 {
+		parts = append(parts, m.keys.help("prev in block", actionPrevInBlock))
+	}
+	if index < count-1 {
+		parts = append(parts, m.keys.help("next in block", actionNextInBlock))
+	}
+	return helpLine(parts...)
+}
+
+// startCompare fetches the transaction being compared against and the given hash.
+func (m *Model) startCompare(input string) tea.Cmd {
+	q := etherscan.Classify(input)
+	if q.Kind != etherscan.QueryHash || etherscan.ValidateTxHash(string(q.Hash)) != nil {
+		m.input.SetWarning("compare takes a transaction hash")
+		return nil
+	}
+	a := m.compareWith
+	m.compareWith = ""
+	m.input.SetPrompt(inputPrompt)
+	return m.startLoading(string(a)+" vs "+string(q.Hash), fetchCompareCmd(context.Background(), a, q.Hash, m.client))
+}
+
+// switchChain points the client and header at a new chain and refreshes the latest block.
+func (m *Model) switchChain(chainID int) (tea.Cmd, error) {
+	if err := m.client.SetChainID(chainID); err != nil {
+		return nil, err
+	}
+	m.header.SetChainID(chainID)
+	m.keepNetwork = false
+	m.header.SetLatestBlock("", "") // Reset while fetching
+	m.headTime = time.Time{}
+	return tea.Batch(fetchLatestBlockCmd(context.Background(), m.client), m.header.Tick()), nil
+}
+
+// setChain switches to the chain ID entered by the user, warning instead if it
+// isn't a number or isn't in the chain registry.
+func (m *Model) setChain(input string) tea.Cmd {
+	chainID, err := strconv.Atoi(input)
+	if err != nil || chainID <= 0 {
+		m.input.SetWarning("chain ID must be a positive number")
+		return nil
+	}
+	cmd, err := m.switchChain(chainID)
+	if err != nil {
+		m.input.SetWarning(err.Error())
+		return nil
+	}
+	return tea.Batch(m.searchAgain(), cmd)
+}
+
+// networkHint suggests switching back to the network recent lookups succeeded on,
+// or returns "" if the current network matches or the user chose to keep it.
+func (m Model) networkHint() string {
+	last := m.session.lastChainID
+	if last == 0 || last == m.client.ChainID() || m.keepNetwork {
+		return ""
+	}
+	current := m.client.Network().Name
+	return fmt.Sprintf("you're on %s, but recent lookups succeeded on %s • (tab) switch network • (k) keep %s",
+		current, etherscan.NetworkByID(last).Name, current)
+}
+
+// setLatestBlock shows a newly fetched latest block and records when it was mined.
+func (m *Model) setLatestBlock(msg latestBlockMsg) {
+	m.header.SetLatestBlock(msg.blockNumber, msg.lastTxHash)
+	m.headTime = time.Time{}
+	if t, err := time.Parse(time.RFC3339, msg.timestamp); err == nil {
+		m.headTime = t
+	}
+}
+
+// setOnline resets network failure tracking and hides the offline banner.
+func (m *Model) setOnline() {
+	m.netFailures = 0
+	m.banner.Clear()
+}
+
+// resumeWatch starts a new block polling loop, invalidating any previous one.
+func (m *Model) resumeWatch() tea.Cmd {
+	m.watchID++
+	m.blockWatch.SetPaused(false)
+	return fetchNewBlockCmd(context.Background(), m.client, m.watchID, m.blockWatch.LatestBlock())
+}
@@ -2,7 +2,12 @@ package model
 
 import (
 	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/etherscan/etherscantest"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -28,6 +33,25 @@ func TestUpdate_WindowSizeMsg(t *testing.T) {
 	}
 }
 
+func TestUpdate_ZeroWidthWindowSizeMsg(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.state = loadingState
+	m.loader.SetText("0x123")
+
+	// Duplicate and zero-width sizes (seen on some CI PTYs) must not break rendering
+	m2, _ := m.Update(tea.WindowSizeMsg{Width: 0, Height: 0})
+	m3, _ := m2.Update(tea.WindowSizeMsg{Width: 0, Height: 0})
+	updatedModel := m3.(Model)
+
+	if updatedModel.ctx.ScreenWidth != 0 {
+		t.Errorf("expected ScreenWidth 0, got %d", updatedModel.ctx.ScreenWidth)
+	}
+	if view := updatedModel.View(); !strings.Contains(view, "0x123") {
+		t.Errorf("expected loading view to still render, got %q", view)
+	}
+}
+
 func TestUpdate_TickMsg(t *testing.T) {
 	client := etherscan.NewClient("test-key")
 	m := New(client)
@@ -61,6 +85,39 @@ func TestUpdate_TickMsg(t *testing.T) {
 	}
 }
 
+func TestUpdate_TickStopsAfterLoading(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	m.input.SetValue(testHash)
+	loading, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	firstLoad := loading.(Model).loadID
+	if loading.(Model).state != loadingState {
+		t.Fatalf("expected loading state, got %v", loading.(Model).state)
+	}
+
+	result, _ := loading.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xabc"}})
+	if result.(Model).state != resultState {
+		t.Fatalf("expected result state, got %v", result.(Model).state)
+	}
+	if _, cmd := result.Update(tickMsg{id: firstLoad}); cmd != nil {
+		t.Errorf("expected no further tick once loading ended")
+	}
+
+	// A tick left over from the first load must not drive the next one
+	refreshing, _ := result.Update(tea.KeyMsg{Runes: []rune("r"), Type: tea.KeyRunes})
+	if refreshing.(Model).loadID == firstLoad {
+		t.Fatalf("expected a new load id for the refresh")
+	}
+	stale, cmd := refreshing.Update(tickMsg{id: firstLoad})
+	if cmd != nil || stale.(Model).loader.Percent() != refreshing.(Model).loader.Percent() {
+		t.Errorf("expected stale tick to be dropped")
+	}
+	if _, cmd := refreshing.Update(tickMsg{id: refreshing.(Model).loadID}); cmd == nil {
+		t.Errorf("expected current load to keep ticking")
+	}
+}
+
 func TestUpdate_ComponentDelegation(t *testing.T) {
 	// This is tricky to test deeply without mocks, but we can check if messages
 	// that should be handled by components result in state changes in those components.
@@ -74,3 +131,507 @@ func TestUpdate_ComponentDelegation(t *testing.T) {
 		t.Errorf("expected input value 'a', got %q", m2.(Model).input.Value())
 	}
 }
+
+func TestUpdate_OfflineBanner(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	netErr := errMsg(&etherscan.NetworkError{Err: errors.New("connection refused")})
+
+	m2, cmd := m.Update(netErr)
+	if m2.(Model).banner.Visible() {
+		t.Error("expected banner to stay hidden after a single network failure")
+	}
+	if cmd != nil {
+		t.Error("expected no ping cmd after a single network failure")
+	}
+
+	m3, cmd := m2.Update(netErr)
+	if !strings.Contains(m3.(Model).View(), offlineText) {
+		t.Errorf("expected view to contain offline banner, got %q", m3.(Model).View())
+	}
+	if cmd == nil {
+		t.Error("expected ping cmd once offline")
+	}
+
+	// A failed ping keeps the banner and schedules another check
+	m4, cmd := m3.Update(pingMsg{err: netErr})
+	if !m4.(Model).banner.Visible() || cmd == nil {
+		t.Error("expected banner to persist and another ping to be scheduled")
+	}
+
+	// A successful ping clears the banner
+	m5, _ := m4.Update(pingMsg{})
+	if m5.(Model).banner.Visible() || m5.(Model).netFailures != 0 {
+		t.Error("expected banner to clear after a successful ping")
+	}
+
+	// A successful request also clears the banner
+	m6, _ := m3.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xabc"}})
+	if m6.(Model).banner.Visible() {
+		t.Error("expected banner to clear after a successful fetch")
+	}
+
+	// Non-network errors reset the failure count
+	m7, _ := m2.Update(errMsg(errors.New("not found")))
+	if m7.(Model).netFailures != 0 {
+		t.Errorf("expected failure count reset, got %d", m7.(Model).netFailures)
+	}
+}
+
+func TestUpdate_HeadStatus(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	mined := time.Now().Add(-5 * time.Minute).UTC().Truncate(time.Second)
+	latest := latestBlockMsg{blockNumber: "0x10", lastTxHash: "0xabc", timestamp: mined.Format(time.RFC3339)}
+
+	checked, _ := m.Update(headMsg{chainID: 1, latest: latest})
+	if got := checked.(Model).headTime; !got.Equal(mined) {
+		t.Fatalf("expected head time %s, got %s", mined, got)
+	}
+	if !strings.Contains(checked.View(), "lagging (block age 5m)") {
+		t.Errorf("expected lagging status, got %q", checked.View())
+	}
+
+	// A failed check hides the status without leaving the search screen
+	failed, _ := checked.Update(headMsg{chainID: 1, err: &etherscan.NetworkError{Err: errors.New("timeout")}})
+	if fm := failed.(Model); !fm.headTime.IsZero() || fm.state != inputState || fm.banner.Visible() {
+		t.Errorf("expected the status to be cleared quietly, got state %v", fm.state)
+	}
+
+	// Results for a chain that was switched away from are dropped
+	stale, _ := m.Update(headMsg{chainID: 11155111, latest: latest})
+	if !stale.(Model).headTime.IsZero() {
+		t.Error("expected a check for another chain to be ignored")
+	}
+
+	// The poll keeps ticking, but only fetches on the search screen
+	if _, cmd := m.Update(headTickMsg{}); cmd == nil {
+		t.Error("expected the next head check to be scheduled")
+	}
+}
+
+func TestUpdate_StepMsg(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.state = loadingState
+	m.loader.SetText("0x123")
+
+	steps := make(chan etherscan.Step, 1)
+	m2, cmd := m.Update(stepMsg{step: etherscan.Step{Label: "Fetching receipt…"}, steps: steps})
+	if !strings.Contains(m2.(Model).View(), "Fetching receipt…") {
+		t.Errorf("expected loading view to show current step, got %q", m2.(Model).View())
+	}
+	if cmd == nil {
+		t.Fatal("expected cmd waiting for the next step")
+	}
+
+	steps <- etherscan.Step{Label: "Fetching block details…"}
+	next, ok := cmd().(stepMsg)
+	if !ok || next.step.Label != "Fetching block details…" {
+		t.Errorf("expected next stepMsg, got %v", next)
+	}
+
+	close(steps)
+	if msg := cmd(); msg != nil {
+		t.Errorf("expected nil msg once steps are closed, got %v", msg)
+	}
+}
+
+func TestUpdate_StepProgress(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.input.SetValue(testHash)
+	loading, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if loading.(Model).loader.Percent() != 0 {
+		t.Fatalf("expected the loader to start empty, got %f", loading.(Model).loader.Percent())
+	}
+
+	// Starting a step only changes the label
+	steps := make(chan etherscan.Step)
+	started, _ := loading.Update(stepMsg{step: etherscan.Step{Label: "Fetching transaction…"}, steps: steps})
+	if got := started.(Model).loader.Percent(); got != 0 {
+		t.Errorf("expected no progress for a started step, got %f", got)
+	}
+
+	// Each finished step moves the bar forward, short of full
+	var last float64
+	current := started
+	for i := 1; i <= 6; i++ {
+		current, _ = current.Update(stepMsg{step: etherscan.Step{Label: "Fetching receipt…", Done: true, Completed: i}, steps: steps})
+		got := current.(Model).loader.Percent()
+		if got <= last || got >= maxLoadProgress {
+			t.Fatalf("after %d finished steps, expected progress between %f and %v, got %f", i, last, maxLoadProgress, got)
+		}
+		last = got
+	}
+
+	// A step just finished, so the tick waits instead of creeping forward
+	ticked, cmd := current.Update(tickMsg{id: current.(Model).loadID})
+	if cmd == nil || ticked.(Model).loader.Percent() != last {
+		t.Errorf("expected the tick to wait for the next step, got %f", ticked.(Model).loader.Percent())
+	}
+
+	// The result fills the bar
+	done, _ := ticked.Update(txMsg{tx: &etherscan.Transaction{Hash: testHash}})
+	if got := done.(Model).loader.Percent(); got != 1 {
+		t.Errorf("expected a full bar once the result arrives, got %f", got)
+	}
+}
+
+func TestUpdate_WatchBlocks(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	// 'w' from input starts watching
+	m2, cmd := m.Update(tea.KeyMsg{Runes: []rune("w"), Type: tea.KeyRunes})
+	watching := m2.(Model)
+	if watching.state != watchState {
+		t.Fatalf("expected state watchState, got %v", watching.state)
+	}
+	if watching.footer.Help() != defaultKeyMap().watchHelp() {
+		t.Errorf("expected watch help, got %q", watching.footer.Help())
+	}
+	if cmd == nil {
+		t.Error("expected cmd starting the poll loop")
+	}
+
+	// New blocks are shown and the next poll is scheduled
+	block := &etherscan.BlockSummary{Number: "100", Timestamp: "2024-02-20T20:12:48Z", TransactionCount: 5}
+	m3, cmd := watching.Update(watchBlockMsg{id: watching.watchID, block: block})
+	if !strings.Contains(m3.(Model).View(), "#100") {
+		t.Errorf("expected view to contain block 100, got %q", m3.(Model).View())
+	}
+	if cmd == nil {
+		t.Error("expected next poll to be scheduled")
+	}
+
+	// Results from a stale loop are ignored
+	m4, cmd := m3.Update(watchBlockMsg{id: watching.watchID - 1, block: &etherscan.BlockSummary{Number: "99"}})
+	if strings.Contains(m4.(Model).View(), "#99") || cmd != nil {
+		t.Error("expected stale watch result to be ignored")
+	}
+
+	// 'w' pauses and invalidates the loop
+	m5, _ := m4.Update(tea.KeyMsg{Runes: []rune("w"), Type: tea.KeyRunes})
+	paused := m5.(Model)
+	if !paused.blockWatch.Paused() {
+		t.Error("expected watch to be paused")
+	}
+	if _, cmd := paused.Update(watchTickMsg{id: watching.watchID}); cmd != nil {
+		t.Error("expected paused watch to stop polling")
+	}
+
+	// 'w' again resumes
+	m6, cmd := paused.Update(tea.KeyMsg{Runes: []rune("w"), Type: tea.KeyRunes})
+	if m6.(Model).blockWatch.Paused() || cmd == nil {
+		t.Error("expected watch to resume polling")
+	}
+
+	// Esc returns to input and stops polling
+	m7, _ := m6.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m7.(Model).state != inputState {
+		t.Errorf("expected state inputState, got %v", m7.(Model).state)
+	}
+	if _, cmd := m7.Update(watchTickMsg{id: m7.(Model).watchID}); cmd != nil {
+		t.Error("expected polling to stop after leaving watch mode")
+	}
+}
+
+func TestUpdate_WatchDeadline(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.SetMaxWatch(10 * time.Millisecond)
+
+	m2, _ := m.Update(tea.KeyMsg{Runes: []rune("w"), Type: tea.KeyRunes})
+	watching := m2.(Model)
+
+	// A deadline from an earlier watch run is ignored
+	if _, cmd := watching.Update(watchDeadlineMsg{run: watching.watchRun - 1}); cmd != nil {
+		t.Error("expected stale deadline to be ignored")
+	}
+
+	msg := watchDeadlineCmd(watching.watchRun, 10*time.Millisecond)()
+	m3, cmd := watching.Update(msg)
+	if cmd == nil {
+		t.Fatal("expected quit cmd at the deadline")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("expected tea.QuitMsg at the deadline")
+	}
+	if got := m3.(Model).GaveUp(); got != "gave up after 10ms watching blocks; no blocks seen yet" {
+		t.Errorf("unexpected give-up reason %q", got)
+	}
+
+	// Leaving watch mode cancels the deadline
+	m4, _ := watching.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if _, cmd := m4.Update(msg); cmd != nil || m4.(Model).GaveUp() != "" {
+		t.Error("expected deadline to be cancelled after leaving watch mode")
+	}
+}
+
+func TestUpdate_NetworkHint(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	if strings.Contains(m.View(), "recent lookups succeeded") {
+		t.Fatalf("expected no hint before any lookup")
+	}
+
+	result, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xabc"}})
+	input, _ := result.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if strings.Contains(input.View(), "recent lookups succeeded") {
+		t.Errorf("expected no hint on the network the lookup succeeded on")
+	}
+
+	switched, _ := input.Update(tea.KeyMsg{Type: tea.KeyTab})
+	want := "you're on Sepolia, but recent lookups succeeded on Mainnet"
+	if !strings.Contains(switched.View(), want) {
+		t.Errorf("expected hint %q, got %q", want, switched.View())
+	}
+
+	kept, _ := switched.Update(tea.KeyMsg{Runes: []rune("k"), Type: tea.KeyRunes})
+	if strings.Contains(kept.View(), want) {
+		t.Errorf("expected (k) to dismiss the hint")
+	}
+	if kept.(Model).input.Value() != "" {
+		t.Errorf("expected (k) not to be typed into the input, got %q", kept.(Model).input.Value())
+	}
+
+	// Switching networks again brings the hint back
+	again, _ := kept.Update(tea.KeyMsg{Type: tea.KeyTab})
+	want = "you're on Holesky, but recent lookups succeeded on Mainnet"
+	if !strings.Contains(again.View(), want) {
+		t.Errorf("expected hint after switching networks again")
+	}
+
+	// A successful lookup on the new network makes it the expected one
+	found, _ := again.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xdef"}})
+	searched, _ := found.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if strings.Contains(searched.View(), "recent lookups succeeded") {
+		t.Errorf("expected no hint after succeeding on the current network")
+	}
+}
+
+func TestUpdate_SetChainID(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+
+	// C only opens the chain prompt on an empty input
+	m.input.SetValue("0xab")
+	typed, _ := m.Update(tea.KeyMsg{Runes: []rune("C"), Type: tea.KeyRunes})
+	if typed.(Model).chainEntry || typed.(Model).input.Value() != "0xabC" {
+		t.Fatalf("expected C to be typed into a non-empty input, got %q", typed.(Model).input.Value())
+	}
+
+	m.input.SetValue("")
+	entry, _ := m.Update(tea.KeyMsg{Runes: []rune("C"), Type: tea.KeyRunes})
+	if !entry.(Model).chainEntry || !strings.Contains(entry.View(), chainPrompt) {
+		t.Fatalf("expected chain prompt, got %q", entry.View())
+	}
+
+	// Esc cancels without changing the chain
+	cancelled, _ := entry.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cancelled.(Model).chainEntry || cancelled.(Model).state != inputState || client.ChainID() != 1 {
+		t.Errorf("expected esc to cancel chain entry")
+	}
+
+	em := entry.(Model)
+	em.input.SetValue("abc")
+	invalid, _ := em.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(invalid.View(), "chain ID must be a positive number") || client.ChainID() != 1 {
+		t.Errorf("expected invalid chain warning")
+	}
+
+	em.input.SetValue("999")
+	unknown, _ := em.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(unknown.View(), "unsupported chain 999") || client.ChainID() != 1 || !unknown.(Model).chainEntry {
+		t.Fatalf("expected an unsupported chain warning, got %q", unknown.View())
+	}
+	// Pressing enter again doesn't force it
+	unknown.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if client.ChainID() != 1 {
+		t.Errorf("expected an unsupported chain to be rejected, got %d", client.ChainID())
+	}
+
+	// Supported chains switch straight away
+	um := unknown.(Model)
+	um.input.SetValue("137")
+	switched, _ := um.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if client.ChainID() != 137 || switched.(Model).chainEntry {
+		t.Errorf("expected Polygon, got %d", client.ChainID())
+	}
+	if view := switched.View(); !strings.Contains(view, inputPrompt) || !strings.Contains(view, "Polygon") {
+		t.Errorf("expected to return to the search prompt on Polygon, got %q", view)
+	}
+}
+
+func TestUpdate_FoundElsewhere(t *testing.T) {
+	client := etherscan.NewClient("test-key")
+	m := New(client)
+	m.state = loadingState
+
+	updated, _ := m.Update(foundElsewhereMsg{
+		hash:    "0xabc",
+		network: etherscan.NetworkByID(11155111),
+		err:     etherscan.ErrTransactionNotFound,
+	})
+	um := updated.(Model)
+	if um.state != errorState || um.footer.Help() != defaultKeyMap().redirectHelp() {
+		t.Fatalf("expected redirect error state, got state %v help %q", um.state, um.footer.Help())
+	}
+	if view := um.View(); !strings.Contains(view, "Not found on Mainnet, but found on Sepolia — press Enter to view.") {
+		t.Errorf("expected redirect hint, got %q", view)
+	}
+
+	redirected, cmd := um.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if redirected.(Model).state != loadingState || cmd == nil {
+		t.Fatalf("expected enter to load the transaction, got state %v", redirected.(Model).state)
+	}
+	if client.ChainID() != 11155111 || redirected.(Model).loader.Text() != "0xabc" {
+		t.Errorf("expected to load 0xabc on Sepolia, got chain %d text %q", client.ChainID(), redirected.(Model).loader.Text())
+	}
+
+	// A plain error offers no redirect, so enter searches again
+	failed, _ := redirected.Update(errMsg(errors.New("boom")))
+	again, _ := failed.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if again.(Model).state != inputState {
+		t.Errorf("expected enter to search again after a plain error, got %v", again.(Model).state)
+	}
+}
+
+func TestFetchPendingCmd_Replaced(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxPending
+	routes["eth_getTransactionReceipt"] = etherscantest.NullResult
+	server := etherscantest.NewServer(t, routes)
+	client := etherscan.NewClient("test", etherscan.WithBaseURL(server.URL), etherscan.WithTuning(etherscan.FastTuning()))
+
+	// Only the pending poll checks for a replacement
+	msg := fetchPendingCmd(t.Context(), 1, "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060", client)().(pendingTxMsg)
+	if msg.err != nil || msg.tx.Status != "replaced" {
+		t.Errorf("expected the refetch to find the transaction replaced, got %+v, %v", msg.tx, msg.err)
+	}
+}
+
+func TestUpdate_PendingPoll(t *testing.T) {
+	m := New(etherscan.NewClient("test-key"))
+	m.SetPendingPoll(time.Millisecond)
+
+	// A pending transaction schedules a refetch
+	m2, cmd := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xabc", Status: "Pending"}})
+	polling := m2.(Model)
+	if cmd == nil {
+		t.Fatal("expected a refetch to be scheduled")
+	}
+	id := polling.pollID
+
+	m3, cmd := polling.Update(pendingTickMsg{id: id})
+	if cmd == nil || m3.(Model).cancelPoll == nil {
+		t.Fatal("expected the tick to start a cancellable refetch")
+	}
+
+	// A failed refetch keeps the transaction and tries again
+	m4, cmd := m3.Update(pendingTxMsg{id: id, err: errors.New("timeout")})
+	if m4.(Model).state != resultState || m4.(Model).tx.Status != "Pending" || cmd == nil {
+		t.Fatalf("expected the pending transaction kept with another refetch scheduled")
+	}
+	if help := m4.(Model).footer.Help(); !strings.Contains(help, "refresh failed: timeout") {
+		t.Errorf("expected the failure in the footer, got %q", help)
+	}
+
+	// Still pending: keep polling
+	m5, cmd := m4.Update(pendingTxMsg{id: id, tx: &etherscan.Transaction{Hash: "0xabc", Status: "Pending", Confirmations: "0"}})
+	if cmd == nil {
+		t.Error("expected polling to continue while pending")
+	}
+
+	// Mined: the view updates in place and polling stops
+	mined := &etherscan.Transaction{Hash: "0xabc", Status: "success", BlockNumber: "100"}
+	m6, cmd := m5.Update(pendingTxMsg{id: id, tx: mined})
+	settled := m6.(Model)
+	if settled.tx != mined || cmd != nil {
+		t.Errorf("expected the mined transaction shown with polling stopped, got %+v", settled.tx)
+	}
+	if got := settled.history.entries[0].status; got != "success" {
+		t.Errorf("expected the history updated, got status %q", got)
+	}
+	if settled.footer.Help() != settled.resultHelp() {
+		t.Errorf("expected the result help restored, got %q", settled.footer.Help())
+	}
+}
+
+func TestUpdate_PendingPollStops(t *testing.T) {
+	m := New(etherscan.NewClient("test-key"))
+	m2, _ := m.Update(txMsg{tx: &etherscan.Transaction{Hash: "0xabc", Status: "Pending"}})
+	id := m2.(Model).pollID
+	m3, _ := m2.Update(pendingTickMsg{id: id})
+
+	// Leaving the transaction cancels the refetch in flight
+	m4, _ := m3.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	left := m4.(Model)
+	if left.cancelPoll != nil {
+		t.Error("expected the refetch in flight to be cancelled")
+	}
+	if _, cmd := left.Update(pendingTickMsg{id: id}); cmd != nil {
+		t.Error("expected a stale tick to stop polling")
+	}
+	m5, cmd := left.Update(pendingTxMsg{id: id, tx: &etherscan.Transaction{Hash: "0xabc", Status: "success"}})
+	if cmd != nil || m5.(Model).tx.Status != "Pending" {
+		t.Error("expected a refetch finishing after leaving to be ignored")
+	}
+
+}
+
+func TestPollPending_Skipped(t *testing.T) {
+	pending := &etherscan.Transaction{Hash: "0xabc", Status: "Pending"}
+	tests := []struct {
+		name  string
+		setup func(*Model)
+	}{
+		{"Settled", func(m *Model) { m.tx = &etherscan.Transaction{Hash: "0xabc", Status: "success"} }},
+		{"Disabled", func(m *Model) { m.SetPendingPoll(0) }},
+		{"Snapshot", func(m *Model) { m.snapshot = &etherscan.Snapshot{Transaction: pending} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(etherscan.NewClient("test-key"))
+			m.tx = pending
+			tt.setup(&m)
+			if cmd := m.pollPending(); cmd != nil {
+				t.Error("expected no polling")
+			}
+		})
+	}
+}
+
+func TestUpdate_ErrorHints(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"No API Key", etherscan.ErrNoAPIKey, "ETHERSCAN_API_KEY"},
+		{"Rate Limited", &etherscan.APIError{Kind: etherscan.KindRateLimited, Message: "Max rate limit reached"}, "wait a few seconds"},
+		{"Wrong Network", &etherscan.APIError{Kind: etherscan.KindNotFound, Message: "Error! Transaction hash not found", WrongNetwork: true}, "press tab to switch"},
+		{"Not Found", fmt.Errorf("%w or invalid response", etherscan.ErrTransactionNotFound), "Check the hash"},
+		{"Other", errors.New("boom"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(etherscan.NewClient("test-key"))
+			m2, _ := m.Update(errMsg(tt.err))
+			view := m2.(Model).errorView.View()
+			if tt.want == "" {
+				if m2.(Model).errorHint(tt.err) != "" {
+					t.Errorf("expected no hint, got %q", view)
+				}
+				return
+			}
+			if !strings.Contains(view, tt.want) {
+				t.Errorf("expected a hint containing %q, got %q", tt.want, view)
+			}
+		})
+	}
+}
@@ -1,5 +1,13 @@
 package model
 
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
 // View renders the current state of the Model.
 func (m Model) View() string {
 	var s string
@@ -7,18 +15,148 @@ func (m Model) View() string {
 
 	switch m.state {
 	case inputState:
-		s = m.header.View() + "\n\n" + m.input.View()
+		s = m.header.View()
+		if status := m.networkStatus(time.Now()); status != "" {
+			s += "\n" + status
+		}
+		s += "\n\n" + m.input.View()
+		if hint := m.networkHint(); hint != "" {
+			s += "\n\n" + m.ctx.Theme.Help.Render(hint)
+		}
 	case loadingState:
 		return "\n" + m.loader.View() + "\n"
 	case resultState:
-		s = m.transaction.View()
 		if m.ctx.ScreenWidth >= 80 {
 			footerWidth = int(float64(m.ctx.ScreenWidth) * 0.6)
 		}
+		// The footer's height decides how much of the transaction fits
+		m.ctx.FooterWidth = footerWidth
+		switch {
+		case m.showQR:
+			s = m.snapshotBanner() + m.qrCode.View()
+		case m.layoutResult():
+			s = m.resultView.View() + "\n" + m.resultScrollStatus()
+		default:
+			s = m.resultContent()
+		}
 	case errorState:
 		s = m.errorView.View()
+	case watchState:
+		s = m.blockWatch.View()
+	case compareState:
+		s = m.compare.View()
+	case traceState:
+		s = m.callTree.View()
+	case addressState:
+		s = m.activity.View()
+	}
+
+	if m.showHistory {
+		s = m.historyList.View()
+	}
+	if m.showKeys {
+		s = m.keyHelp.View()
 	}
 
 	m.ctx.FooterWidth = footerWidth
+	if m.banner.Visible() {
+		s = m.banner.View() + "\n\n" + s
+	}
 	return "\n" + s + "\n" + m.footer.View() + "\n"
 }
+
+// snapshotBanner renders the line marking a loaded snapshot, followed by a blank
+// line, or returns "" when not viewing one.
+func (m Model) snapshotBanner() string {
+	if m.snapshot == nil {
+		return ""
+	}
+	return m.ctx.Theme.Active.Render(snapshotBannerText(m.snapshot.Network, m.ctx.FormatTime(m.snapshot.FetchedAt))) + "\n\n"
+}
+
+// resultContent renders the transaction being shown, unscrolled.
+func (m Model) resultContent() string {
+	return m.snapshotBanner() + m.transaction.View()
+}
+
+// layoutResult fits the result viewport between the top of the screen and the
+// footer, filled with the transaction, and reports whether the transaction is
+// too tall to show whole. It never is while the screen height is unknown.
+func (m *Model) layoutResult() bool {
+	if m.ctx.ScreenHeight <= 0 {
+		return false
+	}
+	// View adds a blank line above and a line break after the footer
+	avail := m.ctx.ScreenHeight - 2 - lipgloss.Height(m.footer.View())
+	if m.banner.Visible() {
+		avail -= lipgloss.Height(m.banner.View()) + 1
+	}
+	content := m.resultContent()
+	if lipgloss.Height(content) <= avail {
+		return false
+	}
+	m.resultView.Width = m.ctx.ScreenWidth
+	m.resultView.Height = max(1, avail-1) // leaving a line for the scroll status
+	m.resultView.SetContent(content)
+	return true
+}
+
+// resultScrollStatus shows how far a transaction too tall for the screen is scrolled.
+func (m Model) resultScrollStatus() string {
+	status := fmt.Sprintf("%.0f%%", m.resultView.ScrollPercent()*100)
+	if !m.resultView.AtTop() {
+		status += " ↑"
+	}
+	if !m.resultView.AtBottom() {
+		status += " ↓"
+	}
+	if help := m.keys.help("scroll", actionScrollUp, actionScrollDown); help != "" {
+		status += " • " + help
+	}
+	return m.ctx.Theme.DarkGray.Render(status)
+}
+
+// followSelection scrolls a transaction too tall for the screen just far enough
+// to show the selected row.
+func (m *Model) followSelection() {
+	line, ok := m.transaction.SelectedLine()
+	if !ok || !m.layoutResult() {
+		return
+	}
+	line += strings.Count(m.snapshotBanner(), "\n")
+	switch {
+	case line < m.resultView.YOffset:
+		m.resultView.SetYOffset(line)
+	case line >= m.resultView.YOffset+m.resultView.Height:
+		m.resultView.SetYOffset(line - m.resultView.Height + 1)
+	}
+}
+
+// snapshotBannerText describes where and when a loaded snapshot was taken.
+func snapshotBannerText(network, fetchedAt string) string {
+	return "◆ snapshot · " + network + " · fetched " + fetchedAt + " · read-only"
+}
+
+// networkStatus describes how fresh the latest block is, or returns "" if its age is unknown.
+func (m Model) networkStatus(now time.Time) string {
+	if m.headTime.IsZero() {
+		return ""
+	}
+	age := max(now.Sub(m.headTime), 0) // Tolerate a clock slightly behind the chain's
+	if age > maxHeadAge {
+		return m.ctx.Theme.Warning.Render("● lagging (block age " + formatAge(age) + ")")
+	}
+	return m.ctx.Theme.Synced.Render("● synced (block age " + formatAge(age) + ")")
+}
+
+// formatAge renders a duration in its largest whole unit, e.g. "4s", "5m" or "2h".
+func formatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+}
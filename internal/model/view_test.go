@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 func TestView_States(t *testing.T) {
@@ -93,3 +96,71 @@ func TestView_FooterWidth(t *testing.T) {
 		t.Errorf("expected FooterWidth %d, got %d", expectedWidth, m.ctx.FooterWidth)
 	}
 }
+
+func TestNetworkStatus(t *testing.T) {
+	m := New(etherscan.NewClient("test-key"))
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		headTime time.Time
+		want     string
+	}{
+		{name: "Unknown", headTime: time.Time{}, want: ""},
+		{name: "Synced", headTime: now.Add(-4 * time.Second), want: "● synced (block age 4s)"},
+		{name: "AtThreshold", headTime: now.Add(-maxHeadAge), want: "● synced (block age 1m)"},
+		{name: "Lagging", headTime: now.Add(-5 * time.Minute), want: "● lagging (block age 5m)"},
+		{name: "LaggingHours", headTime: now.Add(-3*time.Hour - 10*time.Minute), want: "● lagging (block age 3h)"},
+		{name: "ClockBehind", headTime: now.Add(2 * time.Second), want: "● synced (block age 0s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.headTime = tt.headTime
+			if got := m.networkStatus(now); !strings.Contains(got, tt.want) || (tt.want == "" && got != "") {
+				t.Errorf("networkStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestView_ScrollsTallTransaction(t *testing.T) {
+	m := New(etherscan.NewClient("test-key"))
+	m1, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	tx := &etherscan.Transaction{Hash: "0xaaa", Status: "success", From: "0x01", To: "0x02", Input: "0xa9059cbb"}
+	m2, _ := m1.Update(txMsg{tx: tx})
+
+	view := m2.(Model).View()
+	if got := strings.Count(view, "\n"); got > 24 {
+		t.Errorf("expected the view to fit 24 lines, got %d", got)
+	}
+	if !strings.Contains(view, "0% ↓ • (pgup/pgdown) scroll") || !strings.Contains(view, "(r) refresh") {
+		t.Errorf("expected a scroll status above the footer, got %q", view)
+	}
+
+	scrolled, _ := m2.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if scrolled.(Model).resultView.YOffset == 0 || !strings.Contains(scrolled.(Model).View(), "↑") {
+		t.Error("expected pgdown to scroll the transaction")
+	}
+
+	// Selecting a row below the fold scrolls it into view
+	selected := m2
+	for range len(strings.Split(m2.(Model).resultContent(), "\n")) {
+		selected, _ = selected.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if line, _ := selected.(Model).transaction.SelectedLine(); line >= selected.(Model).resultView.YOffset+selected.(Model).resultView.Height {
+		t.Errorf("expected the selected line %d in view, scrolled to %d", line, selected.(Model).resultView.YOffset)
+	}
+
+	// Showing another transaction starts at the top
+	again, _ := scrolled.Update(txMsg{tx: tx})
+	if again.(Model).resultView.YOffset != 0 {
+		t.Errorf("expected a new transaction at the top, got offset %d", again.(Model).resultView.YOffset)
+	}
+
+	// A screen tall enough shows it whole
+	tall, _ := m2.Update(tea.WindowSizeMsg{Width: 80, Height: 200})
+	if view := tall.(Model).View(); strings.Contains(view, "scroll") {
+		t.Errorf("expected no scrolling on a tall screen, got %q", view)
+	}
+}
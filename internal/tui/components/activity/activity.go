@@ -0,0 +1,146 @@
+// Package activity provides a selectable list of an address's recent transactions.
+package activity
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/components/listview"
+	"awesomeProject/internal/tui/context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// reservedLines is the space kept free around the list for the title and footer.
+const reservedLines = 7
+
+// KeyMap holds the keys that move the selection.
+type KeyMap struct {
+	Up   key.Binding
+	Down key.Binding
+}
+
+// Model represents the address activity component state.
+type Model struct {
+	ctx      *context.ProgramContext
+	address  etherscan.Address
+	page     int
+	txs      []etherscan.AccountTransaction // newest first
+	keys     KeyMap
+	selected int
+}
+
+// New creates a list of one page of address's transactions, newest first, with
+// the newest selected.
+func New(ctx *context.ProgramContext, address etherscan.Address, page int, txs []etherscan.AccountTransaction) Model {
+	return Model{
+		ctx:     ctx,
+		address: address,
+		page:    page,
+		txs:     txs,
+		keys: KeyMap{
+			Up:   key.NewBinding(key.WithKeys("up")),
+			Down: key.NewBinding(key.WithKeys("down")),
+		},
+	}
+}
+
+// SetKeyMap sets the keys that move the selection.
+func (m *Model) SetKeyMap(keys KeyMap) {
+	m.keys = keys
+}
+
+// Update moves the selection.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Up):
+			m.selected = max(m.selected-1, 0)
+		case key.Matches(msg, m.keys.Down):
+			m.selected = max(min(m.selected+1, len(m.txs)-1), 0)
+		}
+	}
+	return m, nil
+}
+
+// UpdateProgramContext updates the component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// Address returns the address whose transactions are listed.
+func (m Model) Address() etherscan.Address {
+	return m.address
+}
+
+// Page returns the 1-based page number of the transactions listed.
+func (m Model) Page() int {
+	return m.page
+}
+
+// Selected returns the selected transaction, or false if the page is empty.
+func (m Model) Selected() (etherscan.AccountTransaction, bool) {
+	if m.selected >= len(m.txs) {
+		return etherscan.AccountTransaction{}, false
+	}
+	return m.txs[m.selected], true
+}
+
+// View renders the page of transactions, scrolled to keep the selection visible.
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Title.Render("Transactions of "+string(m.address)) + "\n")
+	b.WriteString(m.ctx.Theme.DarkGray.Render(fmt.Sprintf("page %d · newest first", m.page)) + "\n\n")
+
+	if len(m.txs) == 0 {
+		b.WriteString(m.ctx.Theme.Help.Render("No transactions found for this address."))
+		return b.String()
+	}
+
+	first, rows := listview.Window(len(m.txs), m.selected, m.ctx.ScreenHeight, reservedLines)
+	shown := m.txs[first : first+rows]
+
+	blockWidth, valueWidth := 0, 0
+	for _, tx := range shown {
+		blockWidth = max(blockWidth, lipgloss.Width(tx.BlockNumber))
+		valueWidth = max(valueWidth, lipgloss.Width(tx.Value))
+	}
+	lines := make([]string, len(shown))
+	for n, tx := range shown {
+		line := fmt.Sprintf("%s  %-*s  %s  %s  %*s", etherscan.ShortHex(string(tx.Hash)), blockWidth, tx.BlockNumber,
+			m.formatTimestamp(tx.Timestamp), m.direction(tx), valueWidth, tx.Value)
+		style := m.ctx.Theme.Value
+		if first+n == m.selected {
+			style = style.Copy().Reverse(true)
+		}
+		status := m.ctx.Theme.DarkGray.Render("(" + tx.Status + ")")
+		if tx.Status == "failed" {
+			status = m.ctx.Theme.Failed.Render("(" + tx.Status + ")")
+		}
+		lines[n] = style.Render(line) + " " + status
+	}
+	b.WriteString(listview.Render(lines, first, len(m.txs), m.ctx.Theme.Help))
+	return b.String()
+}
+
+// direction describes a transaction relative to the listed address, e.g.
+// "OUT → 0x5df9…e734" for one it sent or "IN ← 0xa1e4…63b4" for one it received.
+func (m Model) direction(tx etherscan.AccountTransaction) string {
+	if strings.EqualFold(string(tx.From), string(m.address)) {
+		return "OUT → " + etherscan.ShortHex(string(tx.To))
+	}
+	return "IN  ← " + etherscan.ShortHex(string(tx.From))
+}
+
+// formatTimestamp shows an RFC3339 timestamp in the configured timezone, or as
+// given if it can't be parsed.
+func (m Model) formatTimestamp(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return m.ctx.FormatTime(t)
+}
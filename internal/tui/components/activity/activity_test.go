@@ -0,0 +1,91 @@
+package activity
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const testAddress = "0xa1e4380a3b1f749673e270229993ee55f35663b4"
+
+var testTxs = []etherscan.AccountTransaction{
+	{Hash: "0x2f1c5c2b44f771e942a8506148e256f94f1a464babc938ae0690c6e34cd79190", BlockNumber: "19000001", From: testAddress,
+		To: "0x5df9b87991262f6ba471f09758cde1c0fc1de734", Value: "1.5 ETH", Timestamp: "2024-01-11T19:06:40Z", Status: "success"},
+	{Hash: "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b", BlockNumber: "19000000", From: "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+		To: testAddress, Value: "0.25 ETH", Timestamp: "2024-01-11T19:06:28Z", Status: "failed"},
+}
+
+func TestSelection(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, testAddress, 1, testTxs)
+
+	steps := []struct {
+		key  tea.KeyType
+		want etherscan.Hash
+	}{
+		{tea.KeyUp, testTxs[0].Hash}, // already at the newest
+		{tea.KeyDown, testTxs[1].Hash},
+		{tea.KeyDown, testTxs[1].Hash}, // stays at the oldest
+		{tea.KeyUp, testTxs[0].Hash},
+	}
+	for _, step := range steps {
+		m, _ = m.Update(tea.KeyMsg{Type: step.key})
+		if got, _ := m.Selected(); got.Hash != step.want {
+			t.Errorf("after %v: Selected() = %s; want %s", step.key, got.Hash, step.want)
+		}
+	}
+
+	if _, ok := New(ctx, testAddress, 2, nil).Selected(); ok {
+		t.Errorf("expected no selection on an empty page")
+	}
+}
+
+func TestView(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	view := New(ctx, testAddress, 3, testTxs).View()
+	for _, sub := range []string{
+		"Transactions of " + testAddress, "page 3",
+		"0x2f1c…9190", "19000001", "2024-01-11 19:06:40 UTC", "OUT → 0x5df9…e734", "1.5 ETH", "(success)",
+		"IN  ← 0x5df9…e734", "0.25 ETH", "(failed)",
+	} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("view missing expected substring: %q", sub)
+		}
+	}
+
+	if view := New(ctx, testAddress, 1, nil).View(); !strings.Contains(view, "No transactions found for this address.") {
+		t.Errorf("expected an empty list message, got %q", view)
+	}
+}
+
+func TestView_ScrollsToSelection(t *testing.T) {
+	var txs []etherscan.AccountTransaction
+	for i := range 20 {
+		txs = append(txs, etherscan.AccountTransaction{Hash: etherscan.Hash(fmt.Sprintf("0x%02d", i)), From: testAddress, Status: "success"})
+	}
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenHeight: reservedLines + 5}
+	m := New(ctx, testAddress, 1, txs)
+
+	view := m.View()
+	if !strings.Contains(view, "0x00") || strings.Contains(view, "0x05") || !strings.Contains(view, "↓ 15 more") {
+		t.Errorf("expected the first 5 transactions and a count of the rest, got %q", view)
+	}
+
+	for range 9 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	view = m.View()
+	for _, sub := range []string{"↑ 5 more", "0x05", "0x09", "↓ 10 more"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("scrolled view missing expected substring: %q", sub)
+		}
+	}
+	if strings.Contains(view, "0x04") || strings.Contains(view, "0x10") {
+		t.Errorf("expected only the transactions around the selection, got %q", view)
+	}
+}
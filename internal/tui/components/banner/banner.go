@@ -0,0 +1,54 @@
+// Package banner provides a persistent status banner displayed above the main view.
+package banner
+
+import (
+	"awesomeProject/internal/tui/context"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Model represents the banner component state.
+type Model struct {
+	ctx  *context.ProgramContext
+	text string
+}
+
+// New creates a new, empty banner component with the given context.
+func New(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx: ctx,
+	}
+}
+
+// Update updates the banner component state. Currently a no-op.
+func (m Model) Update(_ tea.Msg) (Model, tea.Cmd) {
+	return m, nil
+}
+
+// UpdateProgramContext updates the banner's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// SetText sets the banner message. An empty string hides the banner.
+func (m *Model) SetText(text string) {
+	m.text = text
+}
+
+// Clear hides the banner.
+func (m *Model) Clear() {
+	m.text = ""
+}
+
+// Visible reports whether the banner currently has a message to show.
+func (m Model) Visible() bool {
+	return m.text != ""
+}
+
+// View renders the banner component as a string.
+func (m Model) View() string {
+	if m.text == "" {
+		return ""
+	}
+	return m.ctx.Theme.Warning.Render("⚠ " + m.text)
+}
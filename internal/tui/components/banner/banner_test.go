@@ -0,0 +1,49 @@
+package banner
+
+import (
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"strings"
+	"testing"
+)
+
+func TestBanner(t *testing.T) {
+	ctx := &context.ProgramContext{
+		Theme:       theme.DefaultTheme(),
+		ScreenWidth: 80,
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		m := New(ctx)
+		if m.Visible() {
+			t.Error("new banner should not be visible")
+		}
+		if m.View() != "" {
+			t.Errorf("expected empty view, got %q", m.View())
+		}
+	})
+
+	t.Run("SetText and Clear", func(t *testing.T) {
+		m := New(ctx)
+		m.SetText("offline")
+		if !m.Visible() {
+			t.Error("banner should be visible after SetText")
+		}
+		if !strings.Contains(m.View(), "offline") {
+			t.Errorf("view should contain text, got: %s", m.View())
+		}
+		m.Clear()
+		if m.Visible() || m.View() != "" {
+			t.Error("banner should be hidden after Clear")
+		}
+	})
+
+	t.Run("UpdateProgramContext", func(t *testing.T) {
+		m := New(ctx)
+		newCtx := &context.ProgramContext{ScreenWidth: 50}
+		m.UpdateProgramContext(newCtx)
+		if m.ctx != newCtx {
+			t.Error("context not updated correctly")
+		}
+	})
+}
@@ -0,0 +1,137 @@
+// Package blockwatch provides a component that lists new blocks as they arrive.
+package blockwatch
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxBlocks is the number of most recent blocks kept on screen.
+const maxBlocks = 10
+
+// Model represents the block watch component state.
+type Model struct {
+	ctx     *context.ProgramContext
+	blocks  []etherscan.BlockSummary
+	paused  bool
+	err     error
+	spinner spinner.Model
+}
+
+// New creates a new block watch component with the given context.
+func New(ctx *context.ProgramContext) Model {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	return Model{
+		ctx:     ctx,
+		spinner: s,
+	}
+}
+
+// Update updates the block watch component state, animating the spinner while watching.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	if !m.paused {
+		m.spinner, cmd = m.spinner.Update(msg)
+	}
+	return m, cmd
+}
+
+// Tick returns a command that performs a spinner tick.
+func (m Model) Tick() tea.Cmd {
+	return m.spinner.Tick
+}
+
+// UpdateProgramContext updates the block watch component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// Reset clears all blocks and errors and resumes watching.
+func (m *Model) Reset() {
+	m.blocks = nil
+	m.err = nil
+	m.paused = false
+}
+
+// AddBlock prepends a newly seen block, keeping only the most recent ones.
+func (m *Model) AddBlock(b etherscan.BlockSummary) {
+	m.err = nil
+	m.blocks = append([]etherscan.BlockSummary{b}, m.blocks...)
+	if len(m.blocks) > maxBlocks {
+		m.blocks = m.blocks[:maxBlocks]
+	}
+}
+
+// LatestBlock returns the decimal number of the most recently added block, or "" if none.
+func (m Model) LatestBlock() string {
+	if len(m.blocks) == 0 {
+		return ""
+	}
+	return m.blocks[0].Number
+}
+
+// LastState summarizes the most recent observation, for reporting after watching ends.
+func (m Model) LastState() string {
+	switch {
+	case m.err != nil:
+		return "last error: " + m.err.Error()
+	case len(m.blocks) == 0:
+		return "no blocks seen yet"
+	}
+	b := m.blocks[0]
+	return fmt.Sprintf("last block %s (%d txs)", b.Number, b.TransactionCount)
+}
+
+// SetPaused pauses or resumes watching.
+func (m *Model) SetPaused(paused bool) {
+	m.paused = paused
+}
+
+// Paused reports whether watching is paused.
+func (m Model) Paused() bool {
+	return m.paused
+}
+
+// SetError records the last polling error, shown until the next block arrives.
+func (m *Model) SetError(err error) {
+	m.err = err
+}
+
+// View renders the block watch component as a string.
+func (m Model) View() string {
+	var b strings.Builder
+
+	status := m.spinner.View() + " watching"
+	if m.paused {
+		status = "paused"
+	}
+	b.WriteString(m.ctx.Theme.Title.Render("Watching Blocks") + " " + m.ctx.Theme.DarkGray.Render("("+status+")") + "\n")
+
+	if m.err != nil {
+		b.WriteString(m.ctx.Theme.Error.Render(m.err.Error()) + "\n")
+	}
+
+	if len(m.blocks) == 0 {
+		b.WriteString(m.ctx.Theme.Inactive.Render("Waiting for the next block...") + "\n")
+		return b.String()
+	}
+
+	for i, blk := range m.blocks {
+		style := m.ctx.Theme.Value
+		if i > 0 {
+			style = m.ctx.Theme.LightGray
+		}
+		row := fmt.Sprintf("#%-10s %-22s %4d txs   base fee %s Gwei",
+			blk.Number, blk.Timestamp, blk.TransactionCount, cmp.Or(blk.BaseFeePerGas, "n/a"))
+		b.WriteString(style.Render(row) + "\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,85 @@
+package blockwatch
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBlockWatch(t *testing.T) {
+	ctx := &context.ProgramContext{
+		Theme:       theme.DefaultTheme(),
+		ScreenWidth: 100,
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		m := New(ctx)
+		if !strings.Contains(m.View(), "Waiting for the next block") {
+			t.Errorf("expected waiting message, got: %s", m.View())
+		}
+		if m.LatestBlock() != "" {
+			t.Errorf("expected no latest block, got %s", m.LatestBlock())
+		}
+	})
+
+	t.Run("AddBlock", func(t *testing.T) {
+		m := New(ctx)
+		m.AddBlock(etherscan.BlockSummary{Number: "100", Timestamp: "2024-02-20T20:12:48Z", TransactionCount: 12, BaseFeePerGas: "1.5"})
+		m.AddBlock(etherscan.BlockSummary{Number: "101", Timestamp: "2024-02-20T20:13:00Z", TransactionCount: 3})
+
+		if m.LatestBlock() != "101" {
+			t.Errorf("expected latest block 101, got %s", m.LatestBlock())
+		}
+		view := m.View()
+		for _, s := range []string{"#101", "#100", "12 txs", "base fee 1.5 Gwei", "base fee n/a Gwei"} {
+			if !strings.Contains(view, s) {
+				t.Errorf("expected view to contain %q, got: %s", s, view)
+			}
+		}
+		if strings.Index(view, "#101") > strings.Index(view, "#100") {
+			t.Error("expected newest block first")
+		}
+	})
+
+	t.Run("Bounded", func(t *testing.T) {
+		m := New(ctx)
+		for i := range maxBlocks + 5 {
+			m.AddBlock(etherscan.BlockSummary{Number: fmt.Sprint(i)})
+		}
+		if len(m.blocks) != maxBlocks {
+			t.Errorf("expected %d blocks, got %d", maxBlocks, len(m.blocks))
+		}
+	})
+
+	t.Run("Paused and Error", func(t *testing.T) {
+		m := New(ctx)
+		m.SetPaused(true)
+		m.SetError(errors.New("rate limit"))
+		view := m.View()
+		if !strings.Contains(view, "paused") || !strings.Contains(view, "rate limit") {
+			t.Errorf("expected paused status and error, got: %s", view)
+		}
+		m.Reset()
+		if m.Paused() || m.err != nil {
+			t.Error("expected Reset to resume and clear the error")
+		}
+	})
+	t.Run("LastState", func(t *testing.T) {
+		m := New(ctx)
+		if got := m.LastState(); got != "no blocks seen yet" {
+			t.Errorf("expected no blocks, got %q", got)
+		}
+		m.AddBlock(etherscan.BlockSummary{Number: "100", TransactionCount: 12})
+		if got := m.LastState(); got != "last block 100 (12 txs)" {
+			t.Errorf("expected last block, got %q", got)
+		}
+		m.SetError(errors.New("rate limit"))
+		if got := m.LastState(); got != "last error: rate limit" {
+			t.Errorf("expected last error, got %q", got)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+// Package calltree provides a component showing a transaction's internal
+// transactions as a scrollable call tree.
+package calltree
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// maxDepth is the deepest call shown; deeper calls are counted instead.
+	maxDepth = 12
+	// maxChildren is the most calls shown under one caller; the rest are counted instead.
+	maxChildren = 25
+)
+
+// Model represents the call tree component state.
+type Model struct {
+	ctx      *context.ProgramContext
+	tx       *etherscan.Transaction
+	roots    []*etherscan.CallNode
+	count    int
+	viewport viewport.Model
+}
+
+// New creates a call tree for tx from its internal transactions.
+func New(ctx *context.ProgramContext, tx *etherscan.Transaction, calls []etherscan.InternalTransaction) Model {
+	m := Model{
+		ctx:      ctx,
+		tx:       tx,
+		roots:    etherscan.BuildCallTree(calls),
+		count:    len(calls),
+		viewport: viewport.New(0, 0),
+	}
+	m.resize()
+	return m
+}
+
+// SetKeyMap sets the keys that scroll the tree.
+func (m *Model) SetKeyMap(keys viewport.KeyMap) {
+	m.viewport.KeyMap = keys
+}
+
+// Update scrolls the tree.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// UpdateProgramContext updates the call tree's reference to the global program
+// context, re-rendering it for the new screen size.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.resize()
+}
+
+// resize fits the viewport to the screen, leaving room for the title and footer.
+func (m *Model) resize() {
+	width := max(20, m.ctx.ScreenWidth)
+	m.viewport.Width = width
+	m.viewport.Height = max(5, m.ctx.ScreenHeight-8)
+	m.viewport.SetContent(m.render(width))
+}
+
+// View renders the call tree with scroll indicators when it doesn't fit.
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Title.Render("Call Tree") + "\n")
+	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", m.viewport.Width)) + "\n\n")
+
+	if m.count == 0 {
+		b.WriteString(m.ctx.Theme.DarkGray.Render("No internal transactions: this transaction made no calls or transfers of its own.") + "\n")
+		return b.String()
+	}
+
+	calls := "internal transactions"
+	if m.count == 1 {
+		calls = "internal transaction"
+	}
+	status := fmt.Sprintf("%d %s", m.count, calls)
+	if !m.viewport.AtTop() || !m.viewport.AtBottom() {
+		status += " • " + fmt.Sprintf("%.0f%%", m.viewport.ScrollPercent()*100)
+		if !m.viewport.AtTop() {
+			status += " ↑"
+		}
+		if !m.viewport.AtBottom() {
+			status += " ↓"
+		}
+	}
+	b.WriteString(m.ctx.Theme.DarkGray.Render(status) + "\n")
+	b.WriteString(m.viewport.View())
+	return b.String()
+}
+
+// render draws the tree, one call per line, starting with the transaction itself.
+func (m Model) render(width int) string {
+	line := lipgloss.NewStyle().MaxWidth(width)
+	var lines []string
+	add := func(s string) { lines = append(lines, line.Render(s)) }
+
+	if m.tx != nil {
+		typ, to := "CALL", m.tx.To
+		if to == "" {
+			typ = "CREATE"
+		}
+		add(m.renderCall(typ, to, cmp.Or(m.tx.ValueWei, "0"), ""))
+	}
+	m.renderNodes(add, m.roots, "", 1)
+	return strings.Join(lines, "\n")
+}
+
+// renderNodes draws nodes under prefix, counting any beyond maxChildren or maxDepth.
+func (m Model) renderNodes(add func(string), nodes []*etherscan.CallNode, prefix string, depth int) {
+	shown := nodes
+	if len(nodes) > maxChildren {
+		shown = nodes[:maxChildren]
+	}
+	for i, node := range shown {
+		last := i == len(nodes)-1
+		branch, indent := "├─ ", "│  "
+		if last {
+			branch, indent = "└─ ", "   "
+		}
+
+		call := node.Call
+		to := call.To
+		if to == "" {
+			to = call.ContractAddress
+		}
+		var reverted string
+		if call.Failed() {
+			reverted = m.ctx.Theme.Warning.Render("(reverted" + prefixed(": ", call.ErrCode) + ")")
+		}
+		add(m.ctx.Theme.DarkGray.Render(prefix+branch) + m.renderCall(strings.ToUpper(cmp.Or(call.Type, "call")), to, call.Value, reverted))
+
+		if len(node.Children) == 0 {
+			continue
+		}
+		if depth >= maxDepth {
+			n := countCalls(node.Children)
+			add(m.ctx.Theme.DarkGray.Render(prefix + indent + "└─ " + fmt.Sprintf("… %d nested %s", n, plural(n, "call"))))
+			continue
+		}
+		m.renderNodes(add, node.Children, prefix+indent, depth+1)
+	}
+	if hidden := len(nodes) - len(shown); hidden > 0 {
+		add(m.ctx.Theme.DarkGray.Render(prefix + "└─ " + fmt.Sprintf("… %d more %s", hidden, plural(hidden, "call"))))
+	}
+}
+
+// renderCall draws one call: its type, target, value if any, and a note such as a revert.
+func (m Model) renderCall(typ string, to etherscan.Address, value, note string) string {
+	parts := []string{m.ctx.Theme.Active.Render(typ)}
+	if to != "" {
+		parts = append(parts, "→ "+m.ctx.Theme.Value.Render(string(to)))
+		if name := m.addressName(to); name != "" {
+			parts = append(parts, m.ctx.Theme.LightGray.Render("("+name+")"))
+		}
+	}
+	if value != "" && strings.Trim(value, "0") != "" {
+		if amount := etherscan.FormatAmount(value, m.ctx.Unit); amount != "" {
+			parts = append(parts, m.ctx.Theme.Synced.Render(amount))
+		}
+	}
+	if note != "" {
+		parts = append(parts, note)
+	}
+	return strings.Join(parts, " ")
+}
+
+// addressName returns an address's label or ENS name, or "" if it has neither.
+func (m Model) addressName(addr etherscan.Address) string {
+	if m.tx == nil {
+		return ""
+	}
+	return cmp.Or(m.tx.Label(addr), m.tx.ENSName(addr))
+}
+
+// countCalls counts nodes and all their descendants.
+func countCalls(nodes []*etherscan.CallNode) int {
+	n := len(nodes)
+	for _, node := range nodes {
+		n += countCalls(node.Children)
+	}
+	return n
+}
+
+// plural returns word, with an s unless n is 1.
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
+
+// prefixed returns prefix+s, or "" if s is empty.
+func prefixed(prefix, s string) string {
+	if s == "" {
+		return ""
+	}
+	return prefix + s
+}
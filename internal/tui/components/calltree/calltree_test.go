@@ -0,0 +1,110 @@
+package calltree
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newContext() *context.ProgramContext {
+	return &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 200, ScreenHeight: 60}
+}
+
+func TestView(t *testing.T) {
+	tx := &etherscan.Transaction{To: "0xrouter", ValueWei: "1000000000000000000"}
+	tx.SetLabel("0xpool", "Uniswap V3: Pool")
+	calls := []etherscan.InternalTransaction{
+		{To: "0xpool", Type: "call", TraceID: "0", Value: "0"},
+		{To: "0xweth", Type: "delegatecall", TraceID: "0_0", Value: "500000000000000000"},
+		{ContractAddress: "0xnew", Type: "create", TraceID: "1", Value: "0", IsError: "1", ErrCode: "out of gas"},
+	}
+
+	got := New(newContext(), tx, calls).View()
+	for _, want := range []string{
+		"Call Tree",
+		"3 internal transactions",
+		"CALL → 0xrouter 1 ETH",
+		"├─ CALL → 0xpool (Uniswap V3: Pool)",
+		"│  └─ DELEGATECALL → 0xweth 0.5 ETH",
+		"└─ CREATE → 0xnew (reverted: out of gas)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("View() missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestView_NoCalls(t *testing.T) {
+	got := New(newContext(), &etherscan.Transaction{To: "0xabc"}, nil).View()
+	if !strings.Contains(got, "No internal transactions") {
+		t.Errorf("expected a note about no internal transactions, got:\n%s", got)
+	}
+}
+
+func TestView_Truncation(t *testing.T) {
+	tests := []struct {
+		name  string
+		calls []etherscan.InternalTransaction
+		want  string
+	}{
+		{
+			name: "Wide",
+			calls: func() []etherscan.InternalTransaction {
+				var calls []etherscan.InternalTransaction
+				for i := range maxChildren + 3 {
+					calls = append(calls, etherscan.InternalTransaction{To: "0xabc", Type: "call", TraceID: fmt.Sprint(i)})
+				}
+				return calls
+			}(),
+			want: "└─ … 3 more calls",
+		},
+		{
+			name: "Deep",
+			calls: func() []etherscan.InternalTransaction {
+				var calls []etherscan.InternalTransaction
+				id := "0"
+				for range maxDepth + 2 {
+					calls = append(calls, etherscan.InternalTransaction{To: "0xabc", Type: "call", TraceID: id})
+					id += "_0"
+				}
+				return calls
+			}(),
+			want: "└─ … 2 nested calls",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(newContext(), &etherscan.Transaction{To: "0xabc"}, tt.calls).View()
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("View() missing %q:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdate_Scrolls(t *testing.T) {
+	ctx := newContext()
+	ctx.ScreenHeight = 10
+	var calls []etherscan.InternalTransaction
+	for i := range 20 {
+		calls = append(calls, etherscan.InternalTransaction{To: etherscan.Address(fmt.Sprintf("0x%02d", i)), Type: "call", TraceID: fmt.Sprint(i)})
+	}
+
+	m := New(ctx, &etherscan.Transaction{To: "0xabc"}, calls)
+	m.SetKeyMap(viewport.DefaultKeyMap())
+	if !strings.Contains(m.View(), "↓") || strings.Contains(m.View(), "0x19") {
+		t.Fatalf("expected the tree to be cut off with a scroll indicator:\n%s", m.View())
+	}
+	for range 20 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if got := m.View(); !strings.Contains(got, "0x19") || !strings.Contains(got, "↑") {
+		t.Errorf("expected to scroll to the last call:\n%s", got)
+	}
+}
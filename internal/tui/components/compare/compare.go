@@ -0,0 +1,95 @@
+// Package compare provides a component for comparing two transactions field by field.
+package compare
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"cmp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// labelWidth is the width of the field name column.
+const labelWidth = 18
+
+// Side is one of the two compared transactions, or the error that kept it from loading.
+type Side struct {
+	Hash etherscan.Hash
+	Tx   *etherscan.Transaction
+	Err  error
+}
+
+// Model represents the compare component state.
+type Model struct {
+	ctx  *context.ProgramContext
+	a, b Side
+}
+
+// New creates a new compare component for the two given transactions.
+func New(ctx *context.ProgramContext, a, b Side) Model {
+	return Model{
+		ctx: ctx,
+		a:   a,
+		b:   b,
+	}
+}
+
+// Update updates the compare component state. Currently a no-op.
+func (m Model) Update(_ tea.Msg) (Model, tea.Cmd) {
+	return m, nil
+}
+
+// UpdateProgramContext updates the compare component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// View renders the two transactions side by side, highlighting fields that differ.
+func (m Model) View() string {
+	colWidth := max(20, (m.ctx.ScreenWidth-labelWidth-4)/2)
+
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Title.Render("Compare Transactions") + "\n")
+	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", labelWidth+2*colWidth+2)) + "\n\n")
+
+	b.WriteString(m.row(colWidth, m.ctx.Theme.Label.Render(""),
+		m.ctx.Theme.Title.Render("A: "+string(m.a.Hash)),
+		m.ctx.Theme.Title.Render("B: "+string(m.b.Hash))))
+	if m.a.Err != nil || m.b.Err != nil {
+		b.WriteString(m.row(colWidth, m.ctx.Theme.Label.Render("Error:"), m.renderErr(m.a.Err), m.renderErr(m.b.Err)))
+	}
+	b.WriteString("\n")
+
+	for _, f := range etherscan.CompareTransactions(m.a.Tx, m.b.Tx) {
+		label := m.ctx.Theme.Label.Render(f.Name + ":")
+		valueA, valueB := cmp.Or(f.A, "n/a"), cmp.Or(f.B, "n/a")
+
+		// Fields can't differ meaningfully when a side failed to load
+		if m.a.Tx != nil && m.b.Tx != nil && f.Differs() {
+			label = m.ctx.Theme.Warning.Render("≠ " + f.Name + ":")
+			b.WriteString(m.row(colWidth, label, m.ctx.Theme.Warning.Render(valueA), m.ctx.Theme.Warning.Render(valueB)))
+			continue
+		}
+		b.WriteString(m.row(colWidth, label, m.ctx.Theme.Value.Render(valueA), m.ctx.Theme.Value.Render(valueB)))
+	}
+
+	return b.String()
+}
+
+// row lays out a label and two values as fixed-width columns, wrapping long values.
+func (m Model) row(colWidth int, label, a, b string) string {
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(labelWidth).Render(label),
+		lipgloss.NewStyle().Width(colWidth).PaddingRight(1).Render(a),
+		lipgloss.NewStyle().Width(colWidth).Render(b),
+	) + "\n"
+}
+
+func (m Model) renderErr(err error) string {
+	if err == nil {
+		return ""
+	}
+	return m.ctx.Theme.Error.Render(err.Error())
+}
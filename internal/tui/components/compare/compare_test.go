@@ -0,0 +1,44 @@
+package compare
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestView(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 120}
+	a := Side{Hash: "0xaaa", Tx: &etherscan.Transaction{Status: "success", GasUsed: "21000", Nonce: "5"}}
+	b := Side{Hash: "0xbbb", Tx: &etherscan.Transaction{Status: "success", GasUsed: "50000", Nonce: "5"}}
+
+	view := New(ctx, a, b).View()
+	for _, sub := range []string{"Compare Transactions", "A: 0xaaa", "B: 0xbbb", "≠ Gas Usage:", "21000", "50000"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("view missing %q", sub)
+		}
+	}
+	for _, sub := range []string{"≠ Status:", "≠ Nonce:", "Error:"} {
+		if strings.Contains(view, sub) {
+			t.Errorf("view has unexpected %q", sub)
+		}
+	}
+}
+
+func TestView_OneSideFailed(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 120}
+	a := Side{Hash: "0xaaa", Tx: &etherscan.Transaction{Status: "success", GasUsed: "21000"}}
+	b := Side{Hash: "0xbbb", Err: errors.New("transaction not found")}
+
+	view := New(ctx, a, b).View()
+	for _, sub := range []string{"Error:", "transaction not found", "21000"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("view missing %q", sub)
+		}
+	}
+	if strings.Contains(view, "≠") {
+		t.Error("expected no differences to be highlighted when a side failed to load")
+	}
+}
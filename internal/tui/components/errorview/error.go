@@ -10,8 +10,9 @@ import (
 
 // Model represents the error view component state.
 type Model struct {
-	ctx *context.ProgramContext
-	err error
+	ctx  *context.ProgramContext
+	err  error
+	hint string
 }
 
 // New creates a new error view component with the given context and error.
@@ -32,9 +33,15 @@ func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = ctx
 }
 
-// SetError sets the error to be displayed.
+// SetError sets the error to be displayed and clears any hint.
 func (m *Model) SetError(err error) {
 	m.err = err
+	m.hint = ""
+}
+
+// SetHint sets a suggestion shown below the error, such as a way to recover.
+func (m *Model) SetHint(hint string) {
+	m.hint = hint
 }
 
 // View renders the error view component as a string.
@@ -42,9 +49,13 @@ func (m Model) View() string {
 	if m.err == nil {
 		return ""
 	}
-	return fmt.Sprintf(
+	view := fmt.Sprintf(
 		"%s\n\n%s",
 		m.ctx.Theme.Title.Render("Error"),
 		m.ctx.Theme.Error.Render(m.err.Error()),
 	)
+	if m.hint != "" {
+		view += "\n\n" + m.ctx.Theme.Active.Render(m.hint)
+	}
+	return view
 }
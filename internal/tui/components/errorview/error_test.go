@@ -77,3 +77,18 @@ func TestErrorView(t *testing.T) {
 		}
 	})
 }
+
+func TestErrorView_Hint(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, errors.New("transaction not found"))
+	m.SetHint("Not found on Sepolia, but found on Mainnet — press Enter to view.")
+
+	if view := m.View(); !strings.Contains(view, "found on Mainnet") {
+		t.Errorf("expected hint in view, got %q", view)
+	}
+
+	m.SetError(errors.New("another error"))
+	if view := m.View(); strings.Contains(view, "found on Mainnet") {
+		t.Errorf("expected a new error to clear the hint, got %q", view)
+	}
+}
@@ -5,7 +5,10 @@ package header
 import (
 	"awesomeProject/internal/etherscan"
 	"awesomeProject/internal/tui/context"
+	"cmp"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,6 +18,7 @@ import (
 // Model represents the header component state.
 type Model struct {
 	ctx             *context.ProgramContext
+	chains          []etherscan.Network // the networks listed in the switcher
 	chainID         int
 	latestBlock     string
 	latestTxHash    string
@@ -29,6 +33,7 @@ func New(ctx *context.ProgramContext, chainID int) Model {
 	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
 	return Model{
 		ctx:             ctx,
+		chains:          etherscan.SupportedChains(),
 		chainID:         chainID,
 		isFetchingBlock: true,
 		spinner:         s,
@@ -67,6 +72,11 @@ func (m *Model) SetChainID(id int) {
 	m.isFetchingBlock = true
 }
 
+// SetChains sets the networks listed in the switcher, by default every supported chain.
+func (m *Model) SetChains(chains []etherscan.Network) {
+	m.chains = chains
+}
+
 // LatestTxHash returns the latest transaction hash stored in the header.
 func (m Model) LatestTxHash() string {
 	return m.latestTxHash
@@ -74,12 +84,7 @@ func (m Model) LatestTxHash() string {
 
 // View renders the header component as a string.
 func (m Model) View() string {
-	var networkToggle string
-	if m.chainID == 1 {
-		networkToggle = m.ctx.Theme.Active.Render("Mainnet") + " | " + m.ctx.Theme.Inactive.Render("Sepolia")
-	} else {
-		networkToggle = m.ctx.Theme.Inactive.Render("Mainnet") + " | " + m.ctx.Theme.Active.Render("Sepolia")
-	}
+	networkToggle := m.networkToggle()
 
 	latestBlockDisplay := "Total Transactions: "
 	switch {
@@ -101,3 +106,21 @@ func (m Model) View() string {
 		"Network: "+networkToggle,
 	)
 }
+
+// networkToggle lists the switcher's networks with the current one highlighted.
+// A current network outside the list, such as a snapshot's, is added at the end.
+func (m Model) networkToggle() string {
+	chains := m.chains
+	if !slices.ContainsFunc(chains, func(n etherscan.Network) bool { return n.ChainID == m.chainID }) {
+		chains = append(slices.Clip(chains), etherscan.NetworkByID(m.chainID))
+	}
+	names := make([]string, len(chains))
+	for i, n := range chains {
+		style := m.ctx.Theme.Inactive
+		if n.ChainID == m.chainID {
+			style = m.ctx.Theme.Active
+		}
+		names[i] = style.Render(cmp.Or(n.ShortName, n.Name))
+	}
+	return strings.Join(names, " | ")
+}
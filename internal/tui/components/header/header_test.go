@@ -1,6 +1,7 @@
 package header
 
 import (
+	"awesomeProject/internal/etherscan"
 	"awesomeProject/internal/tui/context"
 	"awesomeProject/internal/tui/theme"
 	"strings"
@@ -74,6 +75,35 @@ func TestHeader(t *testing.T) {
 		}
 	})
 
+	t.Run("View - Network Switcher", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			chainID int
+			want    []string // names in order, the current one marked with *
+		}{
+			{"Current Listed", 11155111, []string{"Mainnet", "*Sepolia", "Polygon"}},
+			{"Current Not Listed", 999, []string{"Mainnet", "Sepolia", "Polygon", "*Chain 999"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				m := New(ctx, tt.chainID)
+				m.SetChains([]etherscan.Network{etherscan.NetworkByID(1), etherscan.NetworkByID(11155111), etherscan.NetworkByID(137)})
+
+				names := make([]string, len(tt.want))
+				for i, name := range tt.want {
+					if current, ok := strings.CutPrefix(name, "*"); ok {
+						names[i] = ctx.Theme.Active.Render(current)
+					} else {
+						names[i] = ctx.Theme.Inactive.Render(name)
+					}
+				}
+				if want := "Network: " + strings.Join(names, " | "); !strings.Contains(m.View(), want) {
+					t.Errorf("expected %q in view, got %q", want, m.View())
+				}
+			})
+		}
+	})
+
 	t.Run("UpdateProgramContext", func(t *testing.T) {
 		m := New(ctx, 1)
 		newCtx := &context.ProgramContext{ScreenWidth: 50}
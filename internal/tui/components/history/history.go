@@ -0,0 +1,152 @@
+// Package history provides a searchable list of the transactions viewed this session.
+package history
+
+import (
+	"awesomeProject/internal/tui/components/listview"
+	"awesomeProject/internal/tui/context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// reservedLines is the space kept free around the list for the title, filter and footer.
+const reservedLines = 8
+
+// Entry is a transaction viewed earlier in the session.
+type Entry struct {
+	Hash     string
+	Chain    string // name of the network it was found on
+	Status   string
+	Searched time.Time
+}
+
+// KeyMap holds the keys that move the selection.
+type KeyMap struct {
+	Up   key.Binding
+	Down key.Binding
+}
+
+// Model represents the history list component state.
+type Model struct {
+	ctx      *context.ProgramContext
+	entries  []Entry // newest first
+	filter   textinput.Model
+	keys     KeyMap
+	selected int // index into Matches
+}
+
+// New creates a history list of entries, newest first, with an empty filter that
+// has focus and the newest entry selected.
+func New(ctx *context.ProgramContext, entries []Entry) Model {
+	ti := textinput.New()
+	ti.Prompt = "/ "
+	ti.Placeholder = "type to filter by hash, network or status"
+	ti.Focus()
+
+	return Model{
+		ctx:     ctx,
+		entries: entries,
+		filter:  ti,
+		keys: KeyMap{
+			Up:   key.NewBinding(key.WithKeys("up")),
+			Down: key.NewBinding(key.WithKeys("down")),
+		},
+	}
+}
+
+// SetKeyMap sets the keys that move the selection.
+func (m *Model) SetKeyMap(keys KeyMap) {
+	m.keys = keys
+}
+
+// Update moves the selection, passing any other key press to the filter.
+// Changing the filter selects the first match again.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.keys.Up):
+			m.selected = max(m.selected-1, 0)
+			return m, nil
+		case key.Matches(msg, m.keys.Down):
+			m.selected = max(min(m.selected+1, len(m.Matches())-1), 0)
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.filter.Value()
+	m.filter, cmd = m.filter.Update(msg)
+	if m.filter.Value() != before {
+		m.selected = 0
+	}
+	return m, cmd
+}
+
+// UpdateProgramContext updates the component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// Matches returns the indexes of the entries whose hash, network or status
+// contains the filter, ignoring case, newest first.
+func (m Model) Matches() []int {
+	pattern := strings.ToLower(strings.TrimSpace(m.filter.Value()))
+	var matches []int
+	for i, e := range m.entries {
+		if strings.Contains(strings.ToLower(e.Hash+" "+e.Chain+" "+e.Status), pattern) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// Selected returns the index of the selected entry, or false if no entry matches the filter.
+func (m Model) Selected() (int, bool) {
+	matches := m.Matches()
+	if m.selected >= len(matches) {
+		return 0, false
+	}
+	return matches[m.selected], true
+}
+
+// View renders the filter and the matching entries, scrolled to keep the selection visible.
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Title.Render("History") + "\n")
+	b.WriteString(m.filter.View() + "\n\n")
+
+	if len(m.entries) == 0 {
+		b.WriteString(m.ctx.Theme.Help.Render("No transactions viewed yet."))
+		return b.String()
+	}
+	matches := m.Matches()
+	if len(matches) == 0 {
+		b.WriteString(m.ctx.Theme.Help.Render(fmt.Sprintf("No transactions match %q.", m.filter.Value())))
+		return b.String()
+	}
+
+	first, rows := listview.Window(len(matches), m.selected, m.ctx.ScreenHeight, reservedLines)
+	shown := matches[first : first+rows]
+
+	chainWidth := 0
+	for _, i := range shown {
+		chainWidth = max(chainWidth, lipgloss.Width(m.entries[i].Chain))
+	}
+	lines := make([]string, len(shown))
+	for n, i := range shown {
+		e := m.entries[i]
+		line := fmt.Sprintf("%s  %-*s  %s", e.Hash, chainWidth, e.Chain, m.ctx.FormatTime(e.Searched))
+		style := m.ctx.Theme.Value
+		if first+n == m.selected {
+			style = style.Copy().Reverse(true)
+		}
+		lines[n] = style.Render(line) + " " + m.ctx.Theme.DarkGray.Render("("+e.Status+")")
+	}
+	b.WriteString(listview.Render(lines, first, len(matches), m.ctx.Theme.Help))
+	return b.String()
+}
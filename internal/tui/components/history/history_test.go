@@ -0,0 +1,125 @@
+package history
+
+import (
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var testEntries = []Entry{
+	{Hash: "0xccc", Chain: "Sepolia", Status: "failed", Searched: time.Date(2024, 5, 1, 12, 2, 0, 0, time.UTC)},
+	{Hash: "0xbbb", Chain: "Mainnet", Status: "success", Searched: time.Date(2024, 5, 1, 12, 1, 0, 0, time.UTC)},
+	{Hash: "0xaaa", Chain: "Mainnet", Status: "Pending", Searched: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+}
+
+// typeText sends s to the model one key press at a time, as a user would type it.
+func typeText(m Model, s string) Model {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []int
+	}{
+		{"", []int{0, 1, 2}},
+		{"mainnet", []int{1, 2}},
+		{"FAILED", []int{0}},
+		{"0xbb", []int{1}},
+		{"polygon", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+			m := typeText(New(ctx, testEntries), tt.filter)
+			if got := m.Matches(); !slices.Equal(got, tt.want) {
+				t.Errorf("Matches() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelection(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, testEntries)
+
+	steps := []struct {
+		key  tea.KeyType
+		want int
+	}{
+		{tea.KeyUp, 0}, // already at the newest
+		{tea.KeyDown, 1},
+		{tea.KeyDown, 2},
+		{tea.KeyDown, 2}, // stays at the oldest
+		{tea.KeyUp, 1},
+	}
+	for _, step := range steps {
+		m, _ = m.Update(tea.KeyMsg{Type: step.key})
+		if got, _ := m.Selected(); got != step.want {
+			t.Errorf("after %v: Selected() = %d; want %d", step.key, got, step.want)
+		}
+	}
+
+	// Filtering selects the first match again
+	m = typeText(m, "mainnet")
+	if got, ok := m.Selected(); !ok || got != 1 {
+		t.Errorf("Selected() after filtering = %d, %v; want 1, true", got, ok)
+	}
+	m = typeText(m, "x")
+	if _, ok := m.Selected(); ok {
+		t.Errorf("expected no selection when nothing matches")
+	}
+}
+
+func TestView(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	view := New(ctx, testEntries).View()
+	for _, sub := range []string{"History", "0xccc", "Sepolia", "(failed)", "2024-05-01 12:00:00 UTC"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("view missing expected substring: %q", sub)
+		}
+	}
+
+	if view := New(ctx, nil).View(); !strings.Contains(view, "No transactions viewed yet.") {
+		t.Errorf("expected an empty history message, got %q", view)
+	}
+	if view := typeText(New(ctx, testEntries), "zzz").View(); !strings.Contains(view, `No transactions match "zzz".`) {
+		t.Errorf("expected a no match message, got %q", view)
+	}
+}
+
+func TestView_ScrollsToSelection(t *testing.T) {
+	var entries []Entry
+	for i := range 20 {
+		entries = append(entries, Entry{Hash: fmt.Sprintf("0x%02d", i), Chain: "Mainnet", Status: "success"})
+	}
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenHeight: reservedLines + 5}
+	m := New(ctx, entries)
+
+	view := m.View()
+	if !strings.Contains(view, "0x00") || strings.Contains(view, "0x05") || !strings.Contains(view, "↓ 15 more") {
+		t.Errorf("expected the first 5 entries and a count of the rest, got %q", view)
+	}
+
+	for range 9 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	view = m.View()
+	for _, sub := range []string{"↑ 5 more", "0x05", "0x09", "↓ 10 more"} {
+		if !strings.Contains(view, sub) {
+			t.Errorf("scrolled view missing expected substring: %q", sub)
+		}
+	}
+	if strings.Contains(view, "0x04") || strings.Contains(view, "0x10") {
+		t.Errorf("expected only the entries around the selection, got %q", view)
+	}
+}
@@ -12,12 +12,14 @@ import (
 type Model struct {
 	ctx       *context.ProgramContext
 	textInput textinput.Model
+	prompt    string
+	warning   string
 }
 
 // New creates a new input component with the given context.
 func New(ctx *context.ProgramContext) Model {
 	ti := textinput.New()
-	ti.Placeholder = "0x..."
+	ti.Placeholder = "0x... (or 0xaddress#nonce)"
 	ti.Focus()
 	ti.CharLimit = 66
 	ti.Width = 70
@@ -25,13 +27,19 @@ func New(ctx *context.ProgramContext) Model {
 	return Model{
 		ctx:       ctx,
 		textInput: ti,
+		prompt:    "Enter transaction hash:",
 	}
 }
 
 // Update updates the input component state based on the received message.
+// Editing the value clears any warning about it.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
+	before := m.textInput.Value()
 	m.textInput, cmd = m.textInput.Update(msg)
+	if m.textInput.Value() != before {
+		m.warning = ""
+	}
 	return m, cmd
 }
 
@@ -42,7 +50,27 @@ func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 
 // View renders the input component as a string.
 func (m Model) View() string {
-	return "Enter transaction hash:\n" + m.textInput.View()
+	view := m.prompt + "\n" + m.textInput.View()
+	if m.warning != "" {
+		view += "\n" + m.ctx.Theme.Warning.Render("⚠ "+m.warning)
+	}
+	return view
+}
+
+// SetPrompt sets the text shown above the input.
+func (m *Model) SetPrompt(s string) {
+	m.prompt = s
+}
+
+// SetWarning shows a warning about the current value below the input.
+// An empty string clears it.
+func (m *Model) SetWarning(s string) {
+	m.warning = s
+}
+
+// Warning returns the warning currently shown, or "" if there is none.
+func (m Model) Warning() string {
+	return m.warning
 }
 
 // Value returns the current text value of the input.
@@ -50,9 +78,10 @@ func (m Model) Value() string {
 	return m.textInput.Value()
 }
 
-// SetValue sets the current text value of the input.
+// SetValue sets the current text value of the input and clears any warning.
 func (m *Model) SetValue(s string) {
 	m.textInput.SetValue(s)
+	m.warning = ""
 }
 
 // Blur removes focus from the input.
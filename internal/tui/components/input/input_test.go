@@ -63,6 +63,30 @@ func TestInput(t *testing.T) {
 		}
 	})
 
+	t.Run("Warning", func(t *testing.T) {
+		m := New(ctx)
+		m.SetWarning("checksum mismatch")
+		if !strings.Contains(m.View(), "checksum mismatch") {
+			t.Error("view should contain the warning")
+		}
+
+		// Moving the cursor keeps the warning; editing the value clears it
+		m2, _ := m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+		if m2.Warning() == "" {
+			t.Error("expected warning to survive a cursor move")
+		}
+		m3, _ := m2.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+		if m3.Warning() != "" {
+			t.Errorf("expected warning to clear on edit, got %q", m3.Warning())
+		}
+
+		m3.SetWarning("checksum mismatch")
+		m3.SetValue("")
+		if m3.Warning() != "" {
+			t.Errorf("expected SetValue to clear the warning, got %q", m3.Warning())
+		}
+	})
+
 	t.Run("UpdateProgramContext", func(t *testing.T) {
 		m := New(ctx)
 		newCtx := &context.ProgramContext{ScreenWidth: 100}
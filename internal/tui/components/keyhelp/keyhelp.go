@@ -0,0 +1,147 @@
+// Package keyhelp provides a searchable list of the explorer's key bindings.
+package keyhelp
+
+import (
+	"awesomeProject/internal/tui/context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// reservedLines is the space kept free around the list for the title, filter and footer.
+const reservedLines = 8
+
+// Binding is a key and what it does.
+type Binding struct {
+	Key         string // as shown in the footer, e.g. "ctrl+c" or "↑/↓"
+	Description string
+	Context     string // the screen the key works on, e.g. "result", or "" if it works everywhere
+}
+
+// Model represents the key list component state.
+type Model struct {
+	ctx      *context.ProgramContext
+	bindings []Binding
+	filter   textinput.Model
+}
+
+// New creates a key list showing bindings, with an empty filter that has focus.
+func New(ctx *context.ProgramContext, bindings []Binding) Model {
+	ti := textinput.New()
+	ti.Prompt = "/ "
+	ti.Placeholder = "type to filter, e.g. copy"
+	ti.Focus()
+
+	return Model{
+		ctx:      ctx,
+		bindings: bindings,
+		filter:   ti,
+	}
+}
+
+// Update passes key presses to the filter.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	return m, cmd
+}
+
+// UpdateProgramContext updates the component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// Matches returns the bindings that match the filter, best matches first.
+// Bindings containing the filter as typed come before those that only contain
+// its characters in order, e.g. "cpy" matches "copy"; otherwise bindings keep
+// their order.
+func (m Model) Matches() []Binding {
+	pattern := strings.ToLower(strings.TrimSpace(m.filter.Value()))
+	type match struct {
+		binding Binding
+		score   int
+	}
+	var matches []match
+	for _, b := range m.bindings {
+		if score := matchScore(pattern, b); score >= 0 {
+			matches = append(matches, match{b, score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b match) int { return a.score - b.score })
+
+	bindings := make([]Binding, len(matches))
+	for i, mt := range matches {
+		bindings[i] = mt.binding
+	}
+	return bindings
+}
+
+// matchScore rates how well pattern matches a binding: 0 if its key, description
+// or context contains pattern, 1 if its key and description contain pattern's
+// characters in order, and -1 otherwise. Contexts are left out of the looser
+// match since they would let short patterns match nearly everything.
+func matchScore(pattern string, b Binding) int {
+	text := strings.ToLower(b.Key + " " + b.Description)
+	switch {
+	case strings.Contains(text+" "+strings.ToLower(b.Context), pattern):
+		return 0
+	case isSubsequence(pattern, text):
+		return 1
+	}
+	return -1
+}
+
+// isSubsequence reports whether the runes of pattern appear in s in order,
+// ignoring spaces in pattern.
+func isSubsequence(pattern, s string) bool {
+	rest := []rune(s)
+	for _, r := range pattern {
+		if r == ' ' {
+			continue
+		}
+		i := slices.Index(rest, r)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+1:]
+	}
+	return true
+}
+
+// View renders the filter and the matching bindings, as many as fit the screen.
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Title.Render("Key Bindings") + "\n")
+	b.WriteString(m.filter.View() + "\n\n")
+
+	matches := m.Matches()
+	if len(matches) == 0 {
+		b.WriteString(m.ctx.Theme.Help.Render(fmt.Sprintf("No keys match %q.", m.filter.Value())))
+		return b.String()
+	}
+
+	shown := matches
+	if m.ctx.ScreenHeight > 0 {
+		shown = matches[:min(len(matches), max(1, m.ctx.ScreenHeight-reservedLines))]
+	}
+	keyWidth := 0
+	for _, mt := range shown {
+		keyWidth = max(keyWidth, lipgloss.Width(mt.Key))
+	}
+	labelStyle := m.ctx.Theme.Label.Copy().Width(keyWidth + 2)
+	for _, mt := range shown {
+		line := labelStyle.Render(mt.Key) + m.ctx.Theme.Value.Render(mt.Description)
+		if mt.Context != "" {
+			line += m.ctx.Theme.Help.Render(" (" + mt.Context + ")")
+		}
+		b.WriteString(line + "\n")
+	}
+	if hidden := len(matches) - len(shown); hidden > 0 {
+		b.WriteString(m.ctx.Theme.Help.Render(fmt.Sprintf("… %d more, type to narrow the list", hidden)) + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
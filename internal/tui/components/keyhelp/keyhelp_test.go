@@ -0,0 +1,85 @@
+package keyhelp
+
+import (
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var testBindings = []Binding{
+	{Key: "r", Description: "refresh", Context: "result"},
+	{Key: "y", Description: "copy the selected field", Context: "result"},
+	{Key: "c", Description: "compare with another transaction", Context: "result"},
+	{Key: "w", Description: "watch new blocks", Context: "search"},
+	{Key: "ctrl+c", Description: "quit"},
+}
+
+// typeText sends s to the model one key press at a time, as a user would type it.
+func typeText(m Model, s string) Model {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []string // keys, in order
+	}{
+		{"", []string{"r", "y", "c", "w", "ctrl+c"}},
+		{"copy", []string{"y"}},
+		{"COPY", []string{"y"}},
+		{"ctrl", []string{"ctrl+c"}},
+		{"search", []string{"w"}},
+		{"cpy", []string{"y"}},
+		// Substring matches ("watch") rank above subsequence ones ("with another")
+		{"wa", []string{"w", "c"}},
+		{"xyz", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+			m := typeText(New(ctx, testBindings), tt.filter)
+			var got []string
+			for _, b := range m.Matches() {
+				got = append(got, b.Key)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Matches() = %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestView(t *testing.T) {
+	t.Run("Lists matches", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 80, ScreenHeight: 40}
+		view := typeText(New(ctx, testBindings), "copy").View()
+		if !strings.Contains(view, "copy the selected field") || !strings.Contains(view, "(result)") {
+			t.Errorf("expected the copy binding, got: %s", view)
+		}
+		if strings.Contains(view, "refresh") {
+			t.Errorf("expected non-matching bindings to be hidden, got: %s", view)
+		}
+	})
+
+	t.Run("No matches", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 80, ScreenHeight: 40}
+		view := typeText(New(ctx, testBindings), "xyz").View()
+		if !strings.Contains(view, `No keys match "xyz".`) {
+			t.Errorf("expected a no-match note, got: %s", view)
+		}
+	})
+
+	t.Run("Short screen", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 80, ScreenHeight: reservedLines + 2}
+		view := New(ctx, testBindings).View()
+		if !strings.Contains(view, "… 3 more") || strings.Contains(view, "watch new blocks") {
+			t.Errorf("expected two bindings and a note about the rest, got: %s", view)
+		}
+	})
+}
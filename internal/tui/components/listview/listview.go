@@ -0,0 +1,39 @@
+// Package listview renders the visible part of a selectable list that is longer than the screen.
+package listview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Window returns the first of total rows to show and how many fit on a screen of
+// screenHeight lines with reserved of them kept free, scrolled just far enough to
+// show the selected row at the bottom. At least one row is shown, and every row
+// while the screen height is still zero.
+func Window(total, selected, screenHeight, reserved int) (first, rows int) {
+	rows = total
+	if screenHeight > 0 {
+		rows = min(rows, max(1, screenHeight-reserved))
+	}
+	first = max(0, selected-rows+1)
+	return first, rows
+}
+
+// Render joins the rendered rows that Window chose, starting at first, one per
+// line, with "↑ N more" and "↓ N more" lines in style for the rest of the total
+// rows scrolled out of view above and below.
+func Render(lines []string, first, total int, style lipgloss.Style) string {
+	var b strings.Builder
+	if first > 0 {
+		b.WriteString(style.Render(fmt.Sprintf("↑ %d more", first)) + "\n")
+	}
+	for _, line := range lines {
+		b.WriteString(line + "\n")
+	}
+	if hidden := total - first - len(lines); hidden > 0 {
+		b.WriteString(style.Render(fmt.Sprintf("↓ %d more", hidden)) + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,61 @@
+package listview
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		selected     int
+		screenHeight int
+		wantFirst    int
+		wantRows     int
+	}{
+		{"Unknown Height", 30, 20, 0, 0, 30},
+		{"Fits", 5, 4, 20, 0, 5},
+		{"Selection In View", 30, 3, 18, 0, 10},
+		{"Scrolled To Selection", 30, 14, 18, 5, 10},
+		{"Tiny Screen", 30, 7, 4, 7, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, rows := Window(tt.total, tt.selected, tt.screenHeight, 8)
+			if first != tt.wantFirst || rows != tt.wantRows {
+				t.Errorf("Window(%d, %d, %d, 8) = %d, %d; want %d, %d", tt.total, tt.selected, tt.screenHeight, first, rows, tt.wantFirst, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		first int
+		total int
+		want  string
+	}{
+		{"Everything Shown", []string{"a", "b"}, 0, 2, "a\nb"},
+		{"More Above", []string{"c", "d"}, 2, 4, "↑ 2 more\nc\nd"},
+		{"More Below", []string{"a"}, 0, 3, "a\n↓ 2 more"},
+		{"Both", []string{"b"}, 1, 3, "↑ 1 more\nb\n↓ 1 more"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.lines, tt.first, tt.total, lipgloss.NewStyle())
+			if got != tt.want {
+				t.Errorf("Render() = %q; want %q", got, tt.want)
+			}
+			if strings.HasSuffix(got, "\n") {
+				t.Error("expected no trailing newline")
+			}
+		})
+	}
+}
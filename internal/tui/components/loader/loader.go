@@ -4,16 +4,44 @@ package loader
 import (
 	"awesomeProject/internal/tui/context"
 	"fmt"
+	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+const (
+	// progressMargin is the horizontal space left around the progress bar.
+	progressMargin = 10
+	// minProgressWidth keeps the bar readable on very narrow terminals.
+	minProgressWidth = 10
+	// maxProgressWidth keeps the bar from stretching across wide terminals.
+	maxProgressWidth = 80
+	// slowFrameGap is how late an animation frame may arrive before it counts as
+	// falling behind; frames are scheduled every 1/60s.
+	slowFrameGap = 100 * time.Millisecond
+	// maxSlowFrames is how many frames in a row may fall behind before the loader
+	// switches to the simple bar.
+	maxSlowFrames = 3
+	// simpleBarColor fills the simple bar with the theme's purple.
+	simpleBarColor = "#7D56F4"
+)
+
+// now is stubbed in tests to simulate slow frame handling.
+var now = time.Now
+
 // Model represents the loader component state.
 type Model struct {
 	ctx      *context.ProgramContext
 	progress progress.Model
 	text     string
+	step     string
+	noBar    bool // the terminal reported zero width, so the bar is skipped
+
+	simple     bool      // render a static solid bar instead of animating a gradient
+	percent    float64   // the bar's percentage in simple mode
+	lastFrame  time.Time // when the previous animation frame (or animation start) was handled
+	slowFrames int       // consecutive frames that arrived later than slowFrameGap
 }
 
 // New creates a new loader component with the given context.
@@ -25,9 +53,18 @@ func New(ctx *context.ProgramContext) Model {
 }
 
 // Update updates the loader component state based on the received message.
+// If animation frames keep arriving late, the loader falls back to the simple bar.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 	if msg, ok := msg.(progress.FrameMsg); ok {
+		if m.simple {
+			// Drop frames still in flight from before the switch
+			return m, nil
+		}
+		if m.fallingBehind() {
+			m.SetSimple(true)
+			return m, nil
+		}
 		var pm tea.Model
 		pm, cmd = m.progress.Update(msg)
 		if p, ok := pm.(progress.Model); ok {
@@ -37,40 +74,96 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, cmd
 }
 
+// fallingBehind records the arrival of an animation frame and reports whether
+// too many frames in a row have arrived late.
+func (m *Model) fallingBehind() bool {
+	t := now()
+	if !m.lastFrame.IsZero() && t.Sub(m.lastFrame) > slowFrameGap {
+		m.slowFrames++
+	} else {
+		m.slowFrames = 0
+	}
+	m.lastFrame = t
+	return m.slowFrames >= maxSlowFrames
+}
+
+// SetSimple switches between the animated gradient bar and a static solid bar,
+// which is cheaper to draw on slow terminals.
+func (m *Model) SetSimple(simple bool) {
+	if simple && !m.simple {
+		width := m.progress.Width
+		m.percent = m.progress.Percent()
+		m.progress = progress.New(progress.WithSolidFill(simpleBarColor))
+		m.progress.Width = width
+	}
+	m.simple = simple
+}
+
+// Simple reports whether the loader renders the static solid bar.
+func (m Model) Simple() bool {
+	return m.simple
+}
+
 // UpdateProgramContext updates the loader's reference to the global program context.
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = ctx
-	m.progress.Width = m.ctx.ScreenWidth - 10
-	if m.progress.Width > 80 {
-		m.progress.Width = 80
-	}
+	// Some CI PTYs report a width of 0, which would make the bar width negative
+	m.noBar = m.ctx.ScreenWidth <= 0
+	m.progress.Width = min(max(m.ctx.ScreenWidth-progressMargin, minProgressWidth), maxProgressWidth)
 }
 
-// SetText sets the descriptive text displayed above the progress bar.
+// SetText sets the descriptive text displayed above the progress bar and clears the current step.
 func (m *Model) SetText(text string) {
 	m.text = text
+	m.step = ""
+}
+
+// Text returns the descriptive text displayed above the progress bar.
+func (m Model) Text() string {
+	return m.text
+}
+
+// SetStep sets the label of the fetch step currently running, displayed under the progress bar.
+func (m *Model) SetStep(step string) {
+	m.step = step
 }
 
 // SetPercent sets the progress bar percentage (0.0 to 1.0).
 func (m *Model) SetPercent(p float64) tea.Cmd {
+	if m.simple {
+		m.percent = min(max(p, 0), 1)
+		return nil
+	}
+	// Time the first frame from now rather than from the end of the last animation
+	m.lastFrame = now()
 	return m.progress.SetPercent(p)
 }
 
 // IncrPercent increments the progress bar percentage by the given amount.
 func (m *Model) IncrPercent(p float64) tea.Cmd {
-	return m.progress.IncrPercent(p)
+	return m.SetPercent(m.Percent() + p)
 }
 
 // Percent returns the current progress bar percentage.
 func (m Model) Percent() float64 {
+	if m.simple {
+		return m.percent
+	}
 	return m.progress.Percent()
 }
 
 // View renders the loader component as a string.
 func (m Model) View() string {
-	return fmt.Sprintf(
-		"\n  Searching for %s...\n\n  %s",
-		m.text,
-		m.progress.View(),
-	)
+	view := fmt.Sprintf("\n  Searching for %s...", m.text)
+	switch {
+	case m.noBar:
+	case m.simple:
+		view += "\n\n  " + m.progress.ViewAs(m.percent)
+	default:
+		view += "\n\n  " + m.progress.View()
+	}
+	if m.step != "" {
+		view += "\n\n  " + m.ctx.Theme.DarkGray.Render(m.step)
+	}
+	return view
 }
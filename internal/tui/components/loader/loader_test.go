@@ -5,6 +5,9 @@ import (
 	"awesomeProject/internal/tui/theme"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress"
 )
 
 func TestLoader(t *testing.T) {
@@ -40,6 +43,22 @@ func TestLoader(t *testing.T) {
 		}
 	})
 
+	t.Run("SetStep", func(t *testing.T) {
+		m := New(ctx)
+		m.SetText("0x123")
+		if strings.Contains(m.View(), "Fetching receipt…") {
+			t.Error("view should not contain a step before one is set")
+		}
+		m.SetStep("Fetching receipt…")
+		if !strings.Contains(m.View(), "Fetching receipt…") {
+			t.Errorf("view should contain step, got: %s", m.View())
+		}
+		m.SetText("next transaction")
+		if m.step != "" {
+			t.Errorf("expected SetText to clear step, got %q", m.step)
+		}
+	})
+
 	t.Run("UpdateProgramContext", func(t *testing.T) {
 		m := New(ctx)
 		newCtx := &context.ProgramContext{ScreenWidth: 50}
@@ -61,4 +80,80 @@ func TestLoader(t *testing.T) {
 			t.Errorf("expected progress width to be capped at 80, got %d", m.progress.Width)
 		}
 	})
+
+	t.Run("UpdateProgramContext - Min Width", func(t *testing.T) {
+		m := New(ctx)
+		m.UpdateProgramContext(&context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 5})
+		if m.progress.Width != minProgressWidth {
+			t.Errorf("expected progress width to be clamped to %d, got %d", minProgressWidth, m.progress.Width)
+		}
+	})
+
+	t.Run("UpdateProgramContext - Zero Width", func(t *testing.T) {
+		m := New(ctx)
+		m.SetText("0x123")
+		m.UpdateProgramContext(&context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 0})
+		if m.progress.Width < minProgressWidth {
+			t.Errorf("expected a sane progress width, got %d", m.progress.Width)
+		}
+		view := m.View()
+		if !strings.Contains(view, "0x123") || strings.Contains(view, "░") || strings.Contains(view, "█") {
+			t.Errorf("expected text without a progress bar, got: %s", view)
+		}
+
+		m.UpdateProgramContext(&context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100})
+		if !strings.Contains(m.View(), "░") {
+			t.Errorf("expected the progress bar to return once the width is known, got: %s", m.View())
+		}
+	})
+	t.Run("Simple", func(t *testing.T) {
+		m := New(ctx)
+		m.SetSimple(true)
+		if cmd := m.SetPercent(0.5); cmd != nil {
+			t.Error("expected no animation in simple mode")
+		}
+		if m.Percent() != 0.5 {
+			t.Errorf("expected percent 0.5, got %f", m.Percent())
+		}
+		m.IncrPercent(0.1)
+		if m.Percent() != 0.6 {
+			t.Errorf("expected percent 0.6, got %f", m.Percent())
+		}
+		if view := m.View(); !strings.Contains(view, "60%") || !strings.Contains(view, "█") {
+			t.Errorf("expected a static bar at 60%%, got: %s", view)
+		}
+	})
+
+	t.Run("Falls Back When Frames Lag", func(t *testing.T) {
+		start := time.Date(2024, 2, 20, 20, 12, 48, 0, time.UTC)
+		clock := start
+		now = func() time.Time { return clock }
+		t.Cleanup(func() { now = time.Now })
+
+		m := New(ctx)
+		m.SetPercent(0.5)
+
+		// On-time frames keep the animation
+		for range maxSlowFrames + 1 {
+			clock = clock.Add(16 * time.Millisecond)
+			m, _ = m.Update(progress.FrameMsg{})
+		}
+		if m.Simple() {
+			t.Fatal("expected on-time frames to keep the animation")
+		}
+
+		for range maxSlowFrames {
+			clock = clock.Add(2 * slowFrameGap)
+			m, _ = m.Update(progress.FrameMsg{})
+		}
+		if !m.Simple() {
+			t.Fatal("expected lagging frames to switch to the simple bar")
+		}
+		if m.Percent() != 0.5 {
+			t.Errorf("expected the target percent to carry over, got %f", m.Percent())
+		}
+		if _, cmd := m.Update(progress.FrameMsg{}); cmd != nil {
+			t.Error("expected frames to be dropped in simple mode")
+		}
+	})
 }
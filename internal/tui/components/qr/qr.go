@@ -0,0 +1,72 @@
+// Package qr provides a component that shows a transaction's explorer link as a QR code.
+package qr
+
+import (
+	"awesomeProject/internal/tui/context"
+	"fmt"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// reservedLines is the space kept free around the code for the title, link and footer.
+const reservedLines = 8
+
+// Model represents the QR code component state.
+type Model struct {
+	ctx  *context.ProgramContext
+	url  string
+	code string // the rendered code, empty if the link couldn't be encoded
+	err  error
+}
+
+// New creates a QR code component for a link. An empty url means the network has no known explorer.
+func New(ctx *context.ProgramContext, url string) Model {
+	m := Model{ctx: ctx, url: url}
+	if url == "" {
+		return m
+	}
+	code, err := qrcode.New(url, qrcode.Medium)
+	if err != nil {
+		m.err = err
+		return m
+	}
+	// Light modules are drawn as blocks so the code scans on dark terminal backgrounds
+	m.code = strings.TrimSuffix(code.ToSmallString(false), "\n")
+	return m
+}
+
+// UpdateProgramContext updates the component's reference to the global program context.
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+// URL returns the link encoded in the code.
+func (m Model) URL() string {
+	return m.url
+}
+
+// size returns the width and height of the rendered code in cells.
+func (m Model) size() (int, int) {
+	lines := strings.Split(m.code, "\n")
+	return len([]rune(lines[0])), len(lines)
+}
+
+// View renders the QR code with its link, or just the link if the terminal is too small.
+func (m Model) View() string {
+	title := m.ctx.Theme.Title.Render("Share Transaction")
+	switch {
+	case m.url == "":
+		return title + "\n\n" + m.ctx.Theme.Error.Render("No block explorer is known for this network.")
+	case m.err != nil:
+		return title + "\n\n" + m.ctx.Theme.Error.Render("Couldn't generate a QR code: "+m.err.Error()) +
+			"\n\n" + m.ctx.Theme.Value.Render(m.url)
+	}
+
+	width, height := m.size()
+	if width > m.ctx.ScreenWidth || height+reservedLines > m.ctx.ScreenHeight {
+		note := fmt.Sprintf("Terminal too small for the QR code (needs %dx%d). Open the link instead:", width, height+reservedLines)
+		return title + "\n\n" + m.ctx.Theme.Help.Render(note) + "\n\n" + m.ctx.Theme.Value.Render(m.url)
+	}
+	return title + "\n\n" + m.code + "\n\n" + m.ctx.Theme.Value.Render(m.url)
+}
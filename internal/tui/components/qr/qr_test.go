@@ -0,0 +1,47 @@
+package qr
+
+import (
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"strings"
+	"testing"
+)
+
+func TestQR(t *testing.T) {
+	const url = "https://etherscan.io/tx/0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
+
+	t.Run("Fits", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 120, ScreenHeight: 60}
+		m := New(ctx, url)
+		view := m.View()
+		if !strings.Contains(view, "█") || !strings.Contains(view, url) {
+			t.Errorf("expected QR code and link, got: %s", view)
+		}
+		width, height := m.size()
+		if width == 0 || width > 120 || height+reservedLines > 60 {
+			t.Errorf("unexpected code size %dx%d", width, height)
+		}
+		if m.URL() != url {
+			t.Errorf("expected URL %q, got %q", url, m.URL())
+		}
+	})
+
+	t.Run("TooSmall", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 40, ScreenHeight: 20}
+		view := New(ctx, url).View()
+		if strings.Contains(view, "█") {
+			t.Errorf("expected no QR code on a small terminal, got: %s", view)
+		}
+		if !strings.Contains(view, "Terminal too small") || !strings.Contains(view, url) {
+			t.Errorf("expected size note and link, got: %s", view)
+		}
+	})
+
+	t.Run("NoExplorer", func(t *testing.T) {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 120, ScreenHeight: 60}
+		view := New(ctx, "").View()
+		if !strings.Contains(view, "No block explorer") {
+			t.Errorf("expected no-explorer message, got: %s", view)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package transaction
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// minBoxWidth is the narrowest details column drawn in a box; narrower ones use the flat list.
+	minBoxWidth = 60
+	// boxChrome is the width the box's borders and padding take from the details column,
+	// including the column's own right padding.
+	boxChrome = 6
+)
+
+// renderBox draws body in a rounded border with title set into the top edge,
+// sized to the content but no wider than maxWidth.
+func (m Model) renderBox(title, body string, maxWidth int) string {
+	body = strings.TrimRight(body, "\n")
+	border := lipgloss.RoundedBorder()
+	borderStyle := m.ctx.Theme.Purple
+
+	// Width excludes the border but includes the padding; the title needs at
+	// least one border character after it
+	innerWidth := min(lipgloss.Width(body)+2, maxWidth-2)
+	innerWidth = max(innerWidth, lipgloss.Width(title)+4)
+	box := lipgloss.NewStyle().
+		Border(border, false, true, true, true).
+		BorderForeground(borderStyle.GetForeground()).
+		Padding(0, 1).
+		Width(innerWidth).
+		Render(body)
+
+	// lipgloss can't put a title in a border, so the top edge is drawn by hand
+	fill := max(0, innerWidth-lipgloss.Width(title)-3)
+	top := borderStyle.Render(border.TopLeft+border.Top+" ") + title +
+		borderStyle.Render(" "+strings.Repeat(border.Top, fill)+border.TopRight)
+	return top + "\n" + box
+}
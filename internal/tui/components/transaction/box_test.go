@@ -0,0 +1,82 @@
+package transaction
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestRenderDetails_Boxed(t *testing.T) {
+	tx := &etherscan.Transaction{Hash: "0x123", From: "0xaaa", To: "0xbbb", Value: "1 ETH", Status: "Success"}
+
+	tests := []struct {
+		name  string
+		boxed bool
+		width int
+		want  bool // whether a box is drawn
+	}{
+		{"boxed", true, 100, true},
+		{"boxed too narrow", true, minBoxWidth - 1, false},
+		{"not boxed", false, 100, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), Boxed: tt.boxed}
+			result := New(ctx, tx).renderDetails(tt.width)
+			lines := strings.Split(strings.TrimRight(result, "\n"), "\n")
+
+			if got := strings.HasPrefix(lines[0], "╭─ "); got != tt.want {
+				t.Fatalf("box drawn = %v; want %v\n%s", got, tt.want, result)
+			}
+			if !strings.Contains(lines[0], "Transaction Details") {
+				t.Errorf("first line %q is missing the title", lines[0])
+			}
+			if !tt.want {
+				if strings.ContainsAny(result, "╭╮╰╯") {
+					t.Errorf("unboxed details contain border characters:\n%s", result)
+				}
+				return
+			}
+
+			last := lines[len(lines)-1]
+			if !strings.HasPrefix(last, "╰") || !strings.HasSuffix(last, "╯") {
+				t.Errorf("last line %q is not the bottom border", last)
+			}
+			if !strings.HasSuffix(lines[0], "╮") {
+				t.Errorf("first line %q is not the top border", lines[0])
+			}
+			// Every line is the same width and fits the column with room for its padding
+			for i, line := range lines {
+				if w := lipgloss.Width(line); w != lipgloss.Width(lines[0]) || w > tt.width-2 {
+					t.Errorf("line %d is %d wide; want %d, at most %d", i, w, lipgloss.Width(lines[0]), tt.width-2)
+				}
+			}
+			if !strings.Contains(result, "│ ") || !strings.Contains(result, "0xaaa") {
+				t.Errorf("box body is missing the rows:\n%s", result)
+			}
+		})
+	}
+}
+
+func TestRenderBox_FitsContent(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	box := New(ctx, nil).renderBox("Title", "a short body", 100)
+	lines := strings.Split(box, "\n")
+	want := []string{
+		"╭─ Title ──────╮",
+		"│ a short body │",
+		"╰──────────────╯",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("renderBox() = %d lines; want %d:\n%s", len(lines), len(want), box)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q; want %q", i, lines[i], want[i])
+		}
+	}
+}
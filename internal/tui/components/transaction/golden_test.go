@@ -0,0 +1,146 @@
+package transaction
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/golden"
+)
+
+// TestView_Golden compares the rendered transaction view with testdata/TestView_Golden/*.golden.
+// Run `go test ./internal/tui/components/transaction -update` to accept intended layout changes.
+func TestView_Golden(t *testing.T) {
+	theme.DisableColor()
+	fixed := time.Date(2024, 2, 20, 21, 12, 48, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	t.Cleanup(func() { now = time.Now })
+
+	tests := []struct {
+		name  string
+		width int
+		tx    *etherscan.Transaction
+	}{
+		{
+			name:  "success",
+			width: 120,
+			tx: &etherscan.Transaction{
+				Status:                "success",
+				Hash:                  "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+				Type:                  "2 (EIP-1559)",
+				Timestamp:             "2024-02-20T20:12:48Z",
+				BlockNumber:           "19272784",
+				Confirmations:         "64",
+				Finalized:             true,
+				From:                  "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:                    "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+				ToAccountType:         "EOA",
+				Value:                 "1.5 ETH",
+				Gas:                   "21000",
+				BlockGasLimit:         "30000000",
+				GasUsed:               "21000",
+				GasPrice:              "⛽ 25 Gwei (0.000000025 ETH)",
+				TransactionFee:        "0.000525 ETH",
+				BaseFeePerGas:         "24",
+				MaxFeePerGas:          "30",
+				MaxPriorityFeePerGas:  "1",
+				BurntFees:             "0.000504 ETH",
+				Savings:               "0.000105 ETH",
+				Nonce:                 "42",
+				TransactionIndex:      "5",
+				BlockTransactionCount: "150",
+				Input:                 "0x",
+			},
+		},
+		{
+			name:  "failed",
+			width: 120,
+			tx: &etherscan.Transaction{
+				Status:           "failed",
+				RevertReason:     "ERC20: transfer amount exceeds balance",
+				Hash:             "0x1c9f1c1b1b2a3a4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5",
+				Type:             "2 (EIP-1559)",
+				BlockNumber:      "19272790",
+				Confirmations:    "58",
+				From:             "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:               "0xdac17f958d2ee523a2206206994597c13d831ec7",
+				ToAccountType:    "Smart Contract",
+				Value:            "0 ETH",
+				Gas:              "60000",
+				GasUsed:          "23512",
+				GasPrice:         "⛽ 30 Gwei (0.00000003 ETH)",
+				TransactionFee:   "0.00070536 ETH",
+				Nonce:            "43",
+				TransactionIndex: "12",
+				Input:            "0xa9059cbb0000000000000000000000005df9b87991262f6ba471f09758cde1c0fc1de7340000000000000000000000000000000000000000000000000000000005f5e100",
+			},
+		},
+		{
+			name:  "pending",
+			width: 120,
+			tx: &etherscan.Transaction{
+				Status:   "Pending",
+				Hash:     "0x9a8b7c6d5e4f30211f2e3d4c5b6a79881726354453627180a9b8c7d6e5f40312",
+				Type:     "2 (EIP-1559)",
+				From:     "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:       "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+				Value:    "0.1 ETH",
+				Gas:      "21000",
+				GasPrice: "⛽ 20 Gwei (0.00000002 ETH)",
+				Nonce:    "44",
+				Input:    "0x",
+			},
+		},
+		{
+			name:  "contract_creation",
+			width: 120,
+			tx: &etherscan.Transaction{
+				Status:           "success",
+				Hash:             "0x2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a",
+				Type:             "2 (EIP-1559)",
+				BlockNumber:      "19272800",
+				Confirmations:    "48",
+				From:             "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				Value:            "0 ETH",
+				Gas:              "500000",
+				GasUsed:          "312345",
+				GasPrice:         "⛽ 25 Gwei (0.000000025 ETH)",
+				TransactionFee:   "0.007808625 ETH",
+				Nonce:            "45",
+				TransactionIndex: "0",
+				Input:            "0x6080604052348015600f57600080fd5b50603f80601d6000396000f3fe6080604052600080fdfea164736f6c6343000814000a",
+			},
+		},
+		{
+			name:  "legacy_narrow",
+			width: 70,
+			tx: &etherscan.Transaction{
+				Status:           "success",
+				Hash:             "0x3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b",
+				Type:             "0 (Legacy)",
+				BlockNumber:      "1000000",
+				Confirmations:    "18272784",
+				From:             "0xa1e4380a3b1f749673e270229993ee55f35663b4",
+				To:               "0x5df9b87991262f6ba471f09758cde1c0fc1de734",
+				Value:            "3 ETH",
+				Gas:              "21000",
+				GasUsed:          "21000",
+				GasPrice:         "⛽ 50 Gwei (0.00000005 ETH)",
+				TransactionFee:   "0.00105 ETH",
+				Nonce:            "7",
+				TransactionIndex: "2",
+				Input:            "0x",
+				Warnings:         []string{"pre-Byzantium receipt has no status: success or failure can't be determined"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: tt.width}
+			golden.RequireEqual(t, []byte(New(ctx, tt.tx).View()))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package transaction
+
+import "awesomeProject/internal/tui/context"
+
+// field identifies a row in the transaction details, independent of its label.
+type field int
+
+const (
+	fieldStatus field = iota
+	fieldRevertReason
+	fieldHash
+	fieldType
+	fieldTimestamp
+	fieldBlockNumber
+	fieldFrom
+	fieldTo
+	fieldContractCreated
+	fieldMethod
+	fieldValue
+	fieldGasLimit
+	fieldGasUsage
+	fieldGasPrice
+	fieldTransactionFee
+	fieldSavings
+	fieldBurntFees
+	fieldGasFees
+	fieldNonce
+	fieldTxIndex
+)
+
+// etherscanLabels are the default labels, matching Etherscan's transaction page.
+var etherscanLabels = map[field]string{
+	fieldStatus:          "Status",
+	fieldRevertReason:    "Revert Reason",
+	fieldHash:            "Hash",
+	fieldType:            "Type",
+	fieldTimestamp:       "Timestamp",
+	fieldBlockNumber:     "Block Number",
+	fieldFrom:            "From",
+	fieldTo:              "To",
+	fieldContractCreated: "Contract Created",
+	fieldMethod:          "Method",
+	fieldValue:           "Value",
+	fieldGasLimit:        "Gas Limit",
+	fieldGasUsage:        "Gas Usage",
+	fieldGasPrice:        "Gas Price",
+	fieldTransactionFee:  "Transaction Fee",
+	fieldSavings:         "Savings",
+	fieldBurntFees:       "Burnt Fees",
+	fieldGasFees:         "Gas Fees",
+	fieldNonce:           "Nonce",
+	fieldTxIndex:         "Tx Index",
+}
+
+// blockscoutLabels override etherscanLabels with Blockscout's terminology.
+var blockscoutLabels = map[field]string{
+	fieldRevertReason:   "Error",
+	fieldHash:           "Transaction Hash",
+	fieldType:           "Txn Type",
+	fieldBlockNumber:    "Block",
+	fieldTo:             "Interacted With",
+	fieldGasUsage:       "Gas Limit & Usage",
+	fieldTransactionFee: "Txn Fee",
+	fieldTxIndex:        "Position",
+}
+
+// label returns the label for f in the context's flavor, falling back to Etherscan's.
+func (m Model) label(f field) string {
+	if m.ctx.Flavor == context.FlavorBlockscout {
+		if l, ok := blockscoutLabels[f]; ok {
+			return l
+		}
+	}
+	return etherscanLabels[f]
+}
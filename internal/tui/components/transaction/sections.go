@@ -0,0 +1,94 @@
+package transaction
+
+import (
+	"awesomeProject/internal/etherscan"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// section identifies a collapsible part of the transaction view.
+type section int
+
+const (
+	sectionDetails section = iota
+	sectionInput
+	sectionBlobs
+	sectionTransfers
+	sectionWarnings
+	numSections
+)
+
+// sections returns the sections currently shown, in display order.
+func (m Model) sections() []section {
+	if m.tx == nil {
+		return nil
+	}
+	s := []section{sectionDetails}
+	if m.tx.Input != "" && !m.ctx.HideInput {
+		s = append(s, sectionInput)
+	}
+	if etherscan.IsBlobTransaction(m.tx) {
+		s = append(s, sectionBlobs)
+	}
+	if len(m.tx.TokenTransfers) > 0 {
+		s = append(s, sectionTransfers)
+	}
+	if len(m.tx.Warnings) > 0 {
+		s = append(s, sectionWarnings)
+	}
+	return s
+}
+
+// focused returns the focused section, falling back to the details when the
+// focused section is no longer shown (e.g., the input was hidden).
+func (m Model) focused() section {
+	for _, s := range m.sections() {
+		if s == m.focus {
+			return s
+		}
+	}
+	return sectionDetails
+}
+
+// FocusNext moves focus to the next shown section, wrapping around.
+func (m *Model) FocusNext() {
+	m.moveFocus(1)
+}
+
+// FocusPrev moves focus to the previous shown section, wrapping around.
+func (m *Model) FocusPrev() {
+	m.moveFocus(-1)
+}
+
+func (m *Model) moveFocus(delta int) {
+	shown := m.sections()
+	if len(shown) == 0 {
+		return
+	}
+	current := m.focused()
+	for i, s := range shown {
+		if s == current {
+			m.focus = shown[(i+delta+len(shown))%len(shown)]
+			return
+		}
+	}
+}
+
+// ToggleFocused expands the focused section if collapsed, or collapses it otherwise.
+func (m *Model) ToggleFocused() {
+	f := m.focused()
+	m.collapsed[f] = !m.collapsed[f]
+}
+
+// renderSectionTitle renders a section heading with an expand/collapse marker,
+// highlighting the marker of the focused section.
+func (m Model) renderSectionTitle(s section, title string, style lipgloss.Style) string {
+	marker := "▾ "
+	if m.collapsed[s] {
+		marker = "▸ "
+	}
+	if s == m.focused() {
+		return m.ctx.Theme.Active.Render(marker) + style.Render(title)
+	}
+	return m.ctx.Theme.DarkGray.Render(marker) + style.Render(title)
+}
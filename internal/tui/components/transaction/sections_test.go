@@ -0,0 +1,91 @@
+package transaction
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	tests := []struct {
+		name      string
+		tx        *etherscan.Transaction
+		hideInput bool
+		expected  []section
+	}{
+		{"no transaction", nil, false, nil},
+		{"details only", &etherscan.Transaction{}, false, []section{sectionDetails}},
+		{"with input", &etherscan.Transaction{Input: "0x"}, false, []section{sectionDetails, sectionInput}},
+		{"input hidden", &etherscan.Transaction{Input: "0x"}, true, []section{sectionDetails}},
+		{"with warnings", &etherscan.Transaction{Input: "0x", Warnings: []string{"w"}}, false, []section{sectionDetails, sectionInput, sectionWarnings}},
+		{"blob transaction", &etherscan.Transaction{Type: "3 (EIP-4844)"}, false, []section{sectionDetails, sectionBlobs}},
+		{"with transfers", &etherscan.Transaction{TokenTransfers: []etherscan.TokenTransfer{{}}, Warnings: []string{"w"}}, false, []section{sectionDetails, sectionTransfers, sectionWarnings}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), HideInput: tt.hideInput}
+			if got := New(ctx, tt.tx).sections(); !slices.Equal(got, tt.expected) {
+				t.Errorf("sections() = %v; want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFocusCycle(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, &etherscan.Transaction{Input: "0xa9059cbb", Warnings: []string{"w"}})
+
+	if m.focused() != sectionDetails {
+		t.Fatalf("expected initial focus on details, got %v", m.focused())
+	}
+
+	for _, want := range []section{sectionInput, sectionWarnings, sectionDetails} {
+		m.FocusNext()
+		if m.focused() != want {
+			t.Errorf("FocusNext() focused %v; want %v", m.focused(), want)
+		}
+	}
+
+	m.FocusPrev()
+	if m.focused() != sectionWarnings {
+		t.Errorf("FocusPrev() from details focused %v; want warnings (wrap around)", m.focused())
+	}
+
+	// Hiding the focused input section moves focus back to the details
+	m.FocusPrev()
+	ctx.HideInput = true
+	if m.focused() != sectionDetails {
+		t.Errorf("expected focus to fall back to details when input is hidden, got %v", m.focused())
+	}
+}
+
+func TestToggleFocused(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+	m := New(ctx, &etherscan.Transaction{Hash: "0x123", Input: "0xa9059cbb"})
+
+	m.ToggleFocused()
+	view := m.View()
+	if !strings.Contains(view, "▸ Transaction Details") || strings.Contains(view, "0x123") {
+		t.Errorf("expected collapsed details to show only the title, got %q", view)
+	}
+	if !strings.Contains(view, "▾ Input Data") || !strings.Contains(view, "a9 05 9c bb") {
+		t.Errorf("expected input section to stay expanded, got %q", view)
+	}
+
+	m.FocusNext()
+	m.ToggleFocused()
+	view = m.View()
+	if !strings.Contains(view, "▸ Input Data") || strings.Contains(view, "a9 05 9c bb") {
+		t.Errorf("expected collapsed input to show only the title, got %q", view)
+	}
+
+	m.FocusPrev()
+	m.ToggleFocused()
+	if view := m.View(); !strings.Contains(view, "0x123") {
+		t.Errorf("expected details to expand again, got %q", view)
+	}
+}
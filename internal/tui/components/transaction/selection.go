@@ -0,0 +1,92 @@
+package transaction
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds the keys that move the row selection.
+type KeyMap struct {
+	Up   key.Binding
+	Down key.Binding
+}
+
+// DefaultKeyMap returns a KeyMap with the arrow keys.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up:   key.NewBinding(key.WithKeys("up")),
+		Down: key.NewBinding(key.WithKeys("down")),
+	}
+}
+
+// SetKeyMap replaces the keys that move the row selection.
+func (m *Model) SetKeyMap(keys KeyMap) {
+	m.keys = keys
+}
+
+// selecting reports whether the arrow keys move the row selection, which they
+// do while the details section is focused and expanded.
+func (m Model) selecting() bool {
+	return m.tx != nil && m.focused() == sectionDetails && !m.collapsed[sectionDetails]
+}
+
+// moveSelection moves the selected row by delta, stopping at the first and last
+// rows. With no row selected, moving down selects the first row and moving up the last.
+func (m *Model) moveSelection(delta int) {
+	n := len(m.detailItems())
+	switch {
+	case m.selected < 0 && delta > 0:
+		m.selected = 0
+	case m.selected < 0:
+		m.selected = n - 1
+	default:
+		m.selected = max(0, min(n-1, m.selected+delta))
+	}
+}
+
+// SelectedField returns the label and raw value of the selected row, for copying.
+// The value leaves out annotations the view adds, such as an address's label or
+// "(contract)" suffix, or a gas price's ETH equivalent.
+// ok is false if no row is selected or the selected row has no value.
+func (m Model) SelectedField() (label, value string, ok bool) {
+	if m.tx == nil || m.selected < 0 {
+		return "", "", false
+	}
+	items := m.detailItems()
+	if m.selected >= len(items) {
+		return "", "", false
+	}
+	item := items[m.selected]
+	switch item.field {
+	case fieldStatus:
+		value = m.tx.Status
+	case fieldValue:
+		value = m.formatAmount(m.tx.ValueWei, m.tx.ValueAmount(), "")
+	case fieldTransactionFee:
+		value = m.formatAmount(m.tx.TransactionFeeWei, m.tx.FeeAmount(), "")
+	case fieldGasPrice:
+		value = m.tx.GasPriceGwei()
+	default:
+		value = rawValue(item.field, item.value)
+	}
+	if value == "" || value == "n/a" {
+		return "", "", false
+	}
+	return m.label(item.field), value, true
+}
+
+// rawValue strips the decorations a detail row's value is formatted with,
+// e.g. "0.01 ETH 💸" becomes "0.01 ETH" and "2 (EIP-1559)" becomes "2".
+// Amounts with a structured accessor on the transaction don't come through here.
+func rawValue(f field, value string) string {
+	if f == fieldGasFees {
+		// The parts of the fee breakdown are all needed to make sense of it
+		return strings.TrimSpace(strings.TrimPrefix(value, "⛽"))
+	}
+	value, _, _ = strings.Cut(value, " (")
+	for _, symbol := range []string{"♦", "⛽", "💸", "🔥"} {
+		value = strings.ReplaceAll(value, symbol, "")
+	}
+	return strings.TrimSpace(value)
+}
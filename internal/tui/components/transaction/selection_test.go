@@ -0,0 +1,189 @@
+package transaction
+
+import (
+	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/tui/context"
+	"awesomeProject/internal/tui/theme"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSelectedField(t *testing.T) {
+	tx := &etherscan.Transaction{
+		Status:            "success",
+		Hash:              "0xabc",
+		Type:              "2 (EIP-1559)",
+		From:              "0x00000000000000000000000000000000000000aa",
+		To:                "0x00000000000000000000000000000000000000bb",
+		ToAccountType:     "Smart Contract",
+		Value:             "♦ 1.5 ETH",
+		ValueWei:          "1500000000000000000",
+		GasPrice:          "⛽ 1 Gwei (0.000000001 ETH)",
+		GasPriceWei:       "1000000000",
+		TransactionFeeWei: "21000000000000",
+		TransactionFee:    "0.000021 ETH",
+		Savings:           "0.01 ETH 💸",
+	}
+	tx.SetLabel(tx.To, "Uniswap V2: Router")
+
+	tests := []struct {
+		name      string
+		field     field
+		wantLabel string
+		wantValue string
+	}{
+		{"Status", fieldStatus, "Status", "success"},
+		{"Type", fieldType, "Type", "2"},
+		{"LabelledContract", fieldTo, "To", "0x00000000000000000000000000000000000000bb"},
+		{"Value", fieldValue, "Value", "1.5 ETH"},
+		{"GasPrice", fieldGasPrice, "Gas Price", "1 Gwei"},
+		{"TransactionFee", fieldTransactionFee, "Transaction Fee", "0.000021 ETH"},
+		{"Savings", fieldSavings, "Savings", "0.01 ETH"},
+	}
+
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, tx)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, item := range m.detailItems() {
+				if item.field == tt.field {
+					m.selected = i
+				}
+			}
+			label, value, ok := m.SelectedField()
+			if !ok || label != tt.wantLabel || value != tt.wantValue {
+				t.Errorf("SelectedField() = %q, %q, %v; want %q, %q", label, value, ok, tt.wantLabel, tt.wantValue)
+			}
+		})
+	}
+
+	// Rows without a value have nothing to copy
+	for i, item := range m.detailItems() {
+		if item.field == fieldBurntFees {
+			m.selected = i
+		}
+	}
+	if _, _, ok := m.SelectedField(); ok {
+		t.Error("expected an empty row not to be copyable")
+	}
+}
+
+func TestSelectedField_Amounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		tx        *etherscan.Transaction
+		unit      etherscan.Unit
+		field     field
+		wantValue string
+	}{
+		{"Value In Gwei", &etherscan.Transaction{Value: "♦ 1.5 ETH", ValueWei: "1500000000000000000"}, etherscan.UnitGwei, fieldValue, "1500000000 Gwei"},
+		{"Fee In Wei", &etherscan.Transaction{TransactionFee: "0.000021 ETH", TransactionFeeWei: "21000000000000"}, etherscan.UnitWei, fieldTransactionFee, "21000000000000 Wei"},
+		// Snapshots saved before the raw amounts existed still copy their formatted amounts
+		{"Old Snapshot Value", &etherscan.Transaction{Value: "♦ 2 ETH"}, etherscan.UnitGwei, fieldValue, "2 ETH"},
+		{"Old Snapshot Gas Price", &etherscan.Transaction{GasPrice: "⛽ 3 Gwei (0.000000003 ETH)"}, etherscan.UnitEther, fieldGasPrice, "3 Gwei"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), Unit: tt.unit}
+			m := New(ctx, tt.tx)
+			for i, item := range m.detailItems() {
+				if item.field == tt.field {
+					m.selected = i
+				}
+			}
+			if _, value, ok := m.SelectedField(); !ok || value != tt.wantValue {
+				t.Errorf("SelectedField() = %q, %v; want %q", value, ok, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestSelectionKeys(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, &etherscan.Transaction{Hash: "0xabc", Input: "0xa9059cbb"})
+	up, down := tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyDown}
+
+	if _, _, ok := m.SelectedField(); ok {
+		t.Fatal("expected no row to be selected initially")
+	}
+	m, _ = m.Update(down)
+	m, _ = m.Update(down)
+	if label, _, _ := m.SelectedField(); label != "Hash" {
+		t.Errorf("expected the second row to be selected, got %q", label)
+	}
+
+	// The selection stops at the first row
+	for range 5 {
+		m, _ = m.Update(up)
+	}
+	if m.selected != 0 {
+		t.Errorf("expected the selection to stop at the first row, got %d", m.selected)
+	}
+
+	// With the input focused the arrows scroll it instead
+	m.FocusNext()
+	m, _ = m.Update(down)
+	if m.selected != 0 {
+		t.Errorf("expected the selection to stay while the input is focused, got %d", m.selected)
+	}
+
+	// Moving up with nothing selected starts at the last row
+	fresh := New(ctx, &etherscan.Transaction{Hash: "0xabc"})
+	fresh, _ = fresh.Update(up)
+	if want := len(fresh.detailItems()) - 1; fresh.selected != want {
+		t.Errorf("expected the last row, got %d", fresh.selected)
+	}
+}
+
+func TestSetTransaction_KeepsSelection(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, &etherscan.Transaction{Hash: "0xabc", Status: "Pending"})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.ToggleFocused() // collapse the details
+
+	m.SetTransaction(&etherscan.Transaction{Hash: "0xabc", Status: "success", BlockNumber: "100"})
+	if label, _, _ := m.SelectedField(); label != "Status" {
+		t.Errorf("expected the selected row to stay, got %q", label)
+	}
+	if !m.collapsed[sectionDetails] {
+		t.Error("expected the collapsed section to stay collapsed")
+	}
+	if m.tx.Status != "success" {
+		t.Errorf("expected the new transaction, got status %q", m.tx.Status)
+	}
+}
+
+func TestSelectedLine(t *testing.T) {
+	tx := &etherscan.Transaction{Hash: "0xabc", Status: "failed", RevertReason: "out of gas", From: "0x01", To: "0x02", Input: "0xa9059cbb"}
+	tests := []struct {
+		name  string
+		width int
+		boxed bool
+	}{
+		{"Wide", 120, false},
+		{"Narrow", 60, false},
+		{"Boxed", 120, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: tt.width, Boxed: tt.boxed}
+			m := New(ctx, tx)
+			if _, ok := m.SelectedLine(); ok {
+				t.Fatal("expected no line without a selection")
+			}
+			// Status, its revert reason, then Hash, Type, Timestamp, Block Number, From
+			for range 6 {
+				m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+			}
+			line, ok := m.SelectedLine()
+			lines := strings.Split(m.View(), "\n")
+			if !ok || line >= len(lines) || !strings.Contains(lines[line], "From:") {
+				t.Errorf("SelectedLine() = %d, %v; want the line with the From row in %q", line, ok, m.View())
+			}
+		})
+	}
+}
@@ -6,26 +6,37 @@ import (
 	"awesomeProject/internal/tui/context"
 	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// now returns the current time for relative timestamps. Tests replace it for stable output.
+var now = time.Now
+
 // Model represents the transaction details component state.
 type Model struct {
-	ctx      *context.ProgramContext
-	tx       *etherscan.Transaction
-	viewport viewport.Model
+	ctx       *context.ProgramContext
+	tx        *etherscan.Transaction
+	viewport  viewport.Model
+	focus     section
+	collapsed [numSections]bool
+	selected  int // index of the selected detail row, -1 for none
+	keys      KeyMap
 }
 
 // New creates a new transaction component with the given context and transaction data.
 func New(ctx *context.ProgramContext, tx *etherscan.Transaction) Model {
 	m := Model{
-		ctx: ctx,
-		tx:  tx,
+		ctx:      ctx,
+		tx:       tx,
+		selected: -1,
+		keys:     DefaultKeyMap(),
 	}
 
 	if tx != nil && tx.Input != "" && tx.Input != "0x" {
@@ -36,8 +47,36 @@ func New(ctx *context.ProgramContext, tx *etherscan.Transaction) Model {
 	return m
 }
 
-// Update updates the transaction component state, primarily handling viewport scrolling.
+// SetTransaction replaces the transaction shown, such as with a fresh copy of a
+// pending one, keeping the focused section, collapsed sections, selected row and
+// input scroll position.
+func (m *Model) SetTransaction(tx *etherscan.Transaction) {
+	m.tx = tx
+	if tx != nil && tx.Input != "" && tx.Input != "0x" {
+		m.viewport.SetContent(m.renderInputHex(tx.Input))
+	}
+	if n := len(m.detailItems()); m.selected >= n {
+		m.selected = n - 1
+	}
+}
+
+// Update updates the transaction component state. The arrow keys select a row
+// while the details are focused, and otherwise scroll the input data.
+// A collapsed input section doesn't scroll.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && m.selecting() {
+		switch {
+		case key.Matches(msg, m.keys.Up):
+			m.moveSelection(-1)
+			return m, nil
+		case key.Matches(msg, m.keys.Down):
+			m.moveSelection(1)
+			return m, nil
+		}
+	}
+	if m.collapsed[sectionInput] {
+		return m, nil
+	}
 	var cmd tea.Cmd
 	m.viewport, cmd = m.viewport.Update(msg)
 	return m, cmd
@@ -54,32 +93,132 @@ func (m Model) View() string {
 		return ""
 	}
 
+	summary := m.renderSummary() + "\n\n"
 	detailsWidth, inputWidth := m.calculateWidths()
 
 	if inputWidth == 0 {
 		// Vertical layout for small screens
 		details := m.renderDetails(detailsWidth)
 		input := m.renderInputData(detailsWidth)
-		if input == "" {
-			return details
+		if input != "" {
+			details += "\n\n" + input
 		}
-		return details + "\n\n" + input
+		return summary + details + m.renderBlobs(detailsWidth) + m.renderTransfers(detailsWidth) + m.renderWarnings(detailsWidth)
 	}
 
 	details := m.renderDetails(detailsWidth)
 	input := m.renderInputData(inputWidth)
 
 	if input == "" {
-		return details
+		return summary + details + m.renderBlobs(detailsWidth) + m.renderTransfers(detailsWidth) + m.renderWarnings(detailsWidth)
 	}
 
 	detailsStyle := lipgloss.NewStyle().Width(detailsWidth).PaddingRight(2)
 	inputStyle := lipgloss.NewStyle().Width(inputWidth)
 
-	return lipgloss.JoinHorizontal(lipgloss.Top,
+	return summary + lipgloss.JoinHorizontal(lipgloss.Top,
 		detailsStyle.Render(details),
 		inputStyle.Render(input),
-	)
+	) + m.renderBlobs(detailsWidth+inputWidth) + m.renderTransfers(detailsWidth+inputWidth) + m.renderWarnings(detailsWidth+inputWidth)
+}
+
+// renderSummary classifies the transaction at a glance as a transfer, contract call or deployment,
+// followed by a count of the events it emitted, if any.
+func (m Model) renderSummary() string {
+	nature := "ETH Transfer"
+	switch {
+	case m.tx.To == "":
+		nature = "Contract Creation"
+	case etherscan.IsContractInteraction(m.tx):
+		nature = "Contract Interaction"
+	}
+	summary := m.ctx.Theme.Active.Render(nature)
+	if events := etherscan.FormatEvents(m.tx.Events); events != "" {
+		summary += m.ctx.Theme.Separator.Render(" • ") + m.ctx.Theme.LightGray.Render(events)
+	}
+	return summary
+}
+
+// renderBlobs shows the blob gas fees and blobs of an EIP-4844 transaction, or
+// returns "" for any other type. Fields missing without a receipt show "n/a".
+func (m Model) renderBlobs(width int) string {
+	if !etherscan.IsBlobTransaction(m.tx) {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n" + m.renderSectionTitle(sectionBlobs, "Blob Gas", m.ctx.Theme.Title) + "\n")
+	if m.collapsed[sectionBlobs] {
+		return b.String()
+	}
+	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", max(20, width-2))) + "\n")
+
+	gwei := func(v string) string {
+		if v == "" {
+			return ""
+		}
+		return v + " Gwei"
+	}
+	rows := []struct{ label, value string }{
+		{"Max Fee Per Blob Gas", gwei(m.tx.MaxFeePerBlobGas)},
+		{"Blob Gas Used", etherscan.FormatThousands(m.tx.BlobGasUsed)},
+		{"Blob Gas Price", gwei(m.tx.BlobGasPrice)},
+		{"Blob Fee", m.tx.BlobFee},
+		{"Blobs", etherscan.FormatBlobHashes(m.tx.BlobVersionedHashes)},
+	}
+	labelStyle := m.ctx.Theme.Label.Copy().Width(22)
+	for _, row := range rows {
+		value := cmp.Or(row.value, "n/a")
+		b.WriteString(labelStyle.Render(row.label+":") + " " + m.ctx.Theme.Value.Render(value) + "\n")
+	}
+	return b.String()
+}
+
+// renderTransfers lists the ERC-20 tokens moved by the transaction, or returns "" if there are none.
+// Amounts are in the token's smallest unit, since its decimals aren't known.
+func (m Model) renderTransfers(width int) string {
+	if len(m.tx.TokenTransfers) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n" + m.renderSectionTitle(sectionTransfers, "Token Transfers", m.ctx.Theme.Title) + "\n")
+	if m.collapsed[sectionTransfers] {
+		return b.String()
+	}
+	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", max(20, width-2))) + "\n")
+	for _, t := range m.tx.TokenTransfers {
+		b.WriteString(m.renderTransferAddress(t.From) + m.ctx.Theme.DarkGray.Render(" → ") + m.renderTransferAddress(t.To) + "  " +
+			m.ctx.Theme.Value.Render(etherscan.FormatThousands(t.Amount)) + " " + m.renderTransferAddress(t.Token) + "\n")
+	}
+	return b.String()
+}
+
+// renderTransferAddress renders an address in a token transfer by its label or
+// ENS name, or shortened when it has neither.
+func (m Model) renderTransferAddress(addr etherscan.Address) string {
+	if name := m.addressName(addr); name != "" {
+		return m.ctx.Theme.Purple.Render(name)
+	}
+	return m.ctx.Theme.LightGray.Render(etherscan.ShortHex(string(addr)))
+}
+
+// renderWarnings lists the transaction's non-fatal warnings, or returns "" if there are none.
+func (m Model) renderWarnings(width int) string {
+	if len(m.tx.Warnings) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\n\n" + m.renderSectionTitle(sectionWarnings, "Warnings", m.ctx.Theme.Warning) + "\n")
+	if m.collapsed[sectionWarnings] {
+		return b.String()
+	}
+	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", max(20, width-2))) + "\n")
+	for _, w := range m.tx.Warnings {
+		b.WriteString(m.ctx.Theme.Warning.Render("⚠ "+w) + "\n")
+	}
+	return b.String()
 }
 
 func (m Model) calculateWidths() (int, int) {
@@ -97,64 +236,154 @@ func (m Model) calculateWidths() (int, int) {
 	return detailsWidth, inputWidth - 2
 }
 
+// detailItem is a row of the transaction details.
+type detailItem struct {
+	field field
+	value string
+	style lipgloss.Style
+}
+
+// detailItems returns the rows of the transaction details, in display order.
+// The method row only appears for contract calls, and a contract creation shows
+// the created contract in place of the recipient once its receipt is known.
+func (m Model) detailItems() []detailItem {
+	items := []detailItem{
+		{fieldStatus, m.formatStatus(m.tx.Status), m.getStatusStyle(m.tx.Status)},
+		{fieldHash, string(m.tx.Hash), m.ctx.Theme.Value},
+		{fieldType, m.tx.Type, m.ctx.Theme.Value},
+		{fieldTimestamp, m.tx.Timestamp, m.ctx.Theme.Value},
+		{fieldBlockNumber, m.tx.BlockNumber, m.ctx.Theme.Value},
+		{fieldFrom, string(m.tx.From), m.ctx.Theme.Value},
+		{fieldTo, string(m.tx.To), m.ctx.Theme.Value},
+		{fieldValue, m.formatAmount(m.tx.ValueWei, m.tx.Value, "♦ "), m.ctx.Theme.Value},
+		{fieldGasLimit, m.tx.Gas, m.ctx.Theme.Value},
+		{fieldGasUsage, m.tx.GasUsed, m.ctx.Theme.Value},
+		{fieldGasPrice, m.tx.GasPrice, m.ctx.Theme.Value},
+		{fieldTransactionFee, m.formatAmount(m.tx.TransactionFeeWei, m.tx.TransactionFee, ""), m.ctx.Theme.Value},
+		{fieldSavings, m.tx.Savings, m.ctx.Theme.Savings},
+		{fieldBurntFees, m.tx.BurntFees, m.ctx.Theme.Value},
+		{fieldGasFees, m.formatGasFees(m.tx), m.ctx.Theme.Value},
+		{fieldNonce, m.tx.Nonce, m.ctx.Theme.Value},
+		{fieldTxIndex, m.tx.TransactionIndex, m.ctx.Theme.Value},
+	}
+	i := slices.IndexFunc(items, func(item detailItem) bool { return item.field == fieldTo })
+	if m.tx.To == "" && m.tx.ContractAddress != "" {
+		items[i] = detailItem{fieldContractCreated, string(m.tx.ContractAddress), m.ctx.Theme.Value}
+	}
+	if m.tx.Method != "" {
+		items = slices.Insert(items, i+1, detailItem{fieldMethod, m.tx.Method, m.ctx.Theme.Value})
+	}
+	return items
+}
+
 func (m Model) renderDetails(width int) string {
-	var b strings.Builder
-	b.WriteString(m.ctx.Theme.Title.Render("Transaction Details") + "\n")
+	if m.boxedDetails(width) {
+		title := m.renderSectionTitle(sectionDetails, "Transaction Details", m.ctx.Theme.Title.UnsetMarginBottom())
+		return m.renderBox(title, m.renderDetailRows(width-boxChrome), width-2) + "\n"
+	}
+	if m.collapsed[sectionDetails] {
+		return m.renderSectionTitle(sectionDetails, "Transaction Details", m.ctx.Theme.Title) + "\n"
+	}
+	return m.detailsHeader(width) + m.renderDetailRows(width)
+}
+
+// boxedDetails reports whether the details are drawn in a box at the given width.
+func (m Model) boxedDetails(width int) bool {
+	return m.ctx.Boxed && width >= minBoxWidth && !m.collapsed[sectionDetails]
+}
 
+// detailsHeader renders the title and separator above the detail rows when they aren't boxed.
+func (m Model) detailsHeader(width int) string {
 	sepWidth := max(20, width-2)
-	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", sepWidth)) + "\n\n")
+	return m.renderSectionTitle(sectionDetails, "Transaction Details", m.ctx.Theme.Title) + "\n" +
+		m.ctx.Theme.Purple.Render(strings.Repeat("─", sepWidth)) + "\n\n"
+}
+
+// SelectedLine returns the line of View that the selected row starts on, so a
+// parent that scrolls the view can keep the selection in sight. ok is false if
+// no row is selected or the details are collapsed.
+func (m Model) SelectedLine() (line int, ok bool) {
+	if m.tx == nil || m.selected < 0 || m.collapsed[sectionDetails] {
+		return 0, false
+	}
+	width, _ := m.calculateWidths()
+	top, rowsWidth := 1, width-boxChrome // below the box's top edge
+	if !m.boxedDetails(width) {
+		top, rowsWidth = strings.Count(m.detailsHeader(width), "\n"), width
+	}
+	_, starts := m.detailRows(rowsWidth)
+	if m.selected >= len(starts) {
+		return 0, false
+	}
+	return strings.Count(m.renderSummary()+"\n\n", "\n") + top + starts[m.selected], true
+}
 
+// renderDetailRows renders the label and value of each detail row.
+func (m Model) renderDetailRows(width int) string {
+	rows, _ := m.detailRows(width)
+	return rows
+}
+
+// detailRows renders the detail rows along with the line each one starts on.
+func (m Model) detailRows(width int) (string, []int) {
+	var b strings.Builder
+	var starts []int
 	labelStyle := m.ctx.Theme.Label.Copy().Width(min(18, width-10))
 
-	items := []struct {
-		label string
-		value string
-		style lipgloss.Style
-	}{
-		{"Status", m.formatStatus(m.tx.Status), m.getStatusStyle(m.tx.Status)},
-		{"Hash", string(m.tx.Hash), m.ctx.Theme.Value},
-		{"Type", m.tx.Type, m.ctx.Theme.Value},
-		{"Timestamp", m.tx.Timestamp, m.ctx.Theme.Value},
-		{"Block Number", m.tx.BlockNumber, m.ctx.Theme.Value},
-		{"From", string(m.tx.From), m.ctx.Theme.Value},
-		{"To", string(m.tx.To), m.ctx.Theme.Value},
-		{"Value", m.tx.Value, m.ctx.Theme.Value},
-		{"Gas Limit", m.tx.Gas, m.ctx.Theme.Value},
-		{"Gas Usage", m.tx.GasUsed, m.ctx.Theme.Value},
-		{"Gas Price", m.tx.GasPrice, m.ctx.Theme.Value},
-		{"Transaction Fee", m.tx.TransactionFee, m.ctx.Theme.Value},
-		{"Savings", m.tx.Savings, m.ctx.Theme.Savings},
-		{"Burnt Fees", m.tx.BurntFees, m.ctx.Theme.Value},
-		{"Gas Fees", m.formatGasFees(m.tx), m.ctx.Theme.Value},
-		{"Nonce", m.tx.Nonce, m.ctx.Theme.Value},
-		{"Tx Index", m.tx.TransactionIndex, m.ctx.Theme.Value},
-	}
-
-	for _, item := range items {
+	items := m.detailItems()
+
+	for i, item := range items {
+		starts = append(starts, strings.Count(b.String(), "\n"))
 		if item.value == "" {
 			item.value = "n/a"
 		}
+		labelStyle := labelStyle
+		if i == m.selected {
+			labelStyle = m.ctx.Theme.Selected.Copy().Width(labelStyle.GetWidth())
+		}
 
 		var renderedValue string
 		switch {
-		case item.label == "Status":
+		case item.field == fieldStatus:
 			statusBox := item.style.Render(item.value)
-			b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(item.label+":"), " ", statusBox) + "\n")
+			b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(m.label(item.field)+":"), " ", statusBox) + "\n")
+			if strings.EqualFold(m.tx.Status, "failed") {
+				b.WriteString(labelStyle.Render(m.label(fieldRevertReason)+":") + " " + m.renderRevertReason(m.tx) + "\n")
+			}
 			continue
-		case item.label == "Gas Price" && strings.Contains(item.value, "("):
-			parts := strings.Split(item.value, " (")
-			gwei := parts[0]
-			eth := "(" + parts[1]
-			renderedValue = item.style.Render(gwei) + " " + m.ctx.Theme.LightGray.Render(eth)
-		case item.label == "Block Number" && m.tx.Confirmations != "":
+		case item.field == fieldGasPrice:
+			renderedValue = m.renderGasPrice(item.value, item.style)
+		case item.field == fieldGasLimit && item.value != "n/a":
+			renderedValue = m.renderGasLimit(m.tx, item.value, item.style)
+		case item.field == fieldBlockNumber && m.tx.Confirmations != "":
 			renderedValue = m.renderBlockNumber(m.tx, item.value, item.style)
-		case item.label == "Timestamp" && item.value != "n/a":
+		case item.field == fieldTimestamp && item.value != "n/a":
 			renderedValue = m.renderTimestamp(item.value, item.style)
-		case item.label == "Gas Usage" && item.value != "n/a" && m.tx.Gas != "" && m.tx.Gas != "n/a":
+		case item.field == fieldGasUsage && item.value != "n/a" && m.tx.Gas != "" && m.tx.Gas != "n/a":
 			renderedValue = m.renderGasUsage(m.tx, item.value, item.style)
-		case item.label == "To" && m.tx.ToAccountType != "":
-			renderedValue = item.style.Render(item.value) + " " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(%s)", m.tx.ToAccountType))
-		case item.label == "Tx Index":
+		case item.field == fieldFrom && m.addressName(m.tx.From) != "":
+			renderedValue = item.style.Render(item.value) + " " + m.renderLabel(m.addressName(m.tx.From))
+		case item.field == fieldTo && (m.tx.ToAccountType != "" || m.addressName(m.tx.To) != ""):
+			renderedValue = item.style.Render(item.value)
+			if label := m.addressName(m.tx.To); label != "" {
+				renderedValue += " " + m.renderLabel(label)
+			}
+			if m.tx.ToAccountType != "" {
+				renderedValue += " " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(%s)", m.tx.ToAccountType))
+			}
+		case item.field == fieldContractCreated:
+			renderedValue = item.style.Render(item.value) + " " + m.ctx.Theme.DarkGray.Render("(new contract)")
+			if label := m.addressName(m.tx.ContractAddress); label != "" {
+				renderedValue = item.style.Render(item.value) + " " + m.renderLabel(label)
+			}
+		case item.field == fieldNonce && m.tx.SenderTxCount != "":
+			renderedValue = item.style.Render(item.value)
+			if desc := etherscan.DescribeNonce(item.value, m.tx.SenderTxCount); desc != "" {
+				renderedValue += " " + m.ctx.Theme.DarkGray.Render("("+desc+")")
+			}
+		case (item.field == fieldValue || item.field == fieldTransactionFee) && m.usdValue(item.field) != "":
+			renderedValue = item.style.Render(item.value) + " " + m.ctx.Theme.DarkGray.Render("("+m.usdValue(item.field)+")")
+		case item.field == fieldTxIndex:
 			val := item.value
 			if m.tx.BlockTransactionCount != "" {
 				val = fmt.Sprintf("%s/%s", item.value, m.tx.BlockTransactionCount)
@@ -164,23 +393,34 @@ func (m Model) renderDetails(width int) string {
 			renderedValue = item.style.Render(item.value)
 		}
 
-		b.WriteString(labelStyle.Render(item.label+":") + " " + renderedValue + "\n")
+		b.WriteString(labelStyle.Render(m.label(item.field)+":") + " " + renderedValue + "\n")
 	}
 
-	return b.String()
+	return b.String(), starts
 }
 
 func (m Model) renderInputData(width int) string {
-	if m.tx.Input == "" {
+	if m.tx.Input == "" || m.ctx.HideInput {
 		return ""
 	}
 
 	var b strings.Builder
-	b.WriteString(m.ctx.Theme.Title.Render("Input Data (Raw Hex)") + "\n")
+	b.WriteString(m.renderSectionTitle(sectionInput, "Input Data (Raw Hex)", m.ctx.Theme.Title))
+	if size := inputSize(m.tx.Input); size > 0 {
+		b.WriteString(" " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(%s bytes)", etherscan.FormatThousands(fmt.Sprint(size)))))
+	}
+	b.WriteString("\n")
+	if m.collapsed[sectionInput] {
+		return b.String()
+	}
 
 	sepWidth := max(20, width)
 	b.WriteString(m.ctx.Theme.Purple.Render(strings.Repeat("─", sepWidth)) + "\n\n")
 
+	if method := m.renderMethod(m.tx.Input); method != "" {
+		b.WriteString(method + "\n")
+	}
+
 	if m.tx.Input == "0x" {
 		b.WriteString(m.ctx.Theme.Value.Render("0x") + "\n")
 		return b.String()
@@ -219,6 +459,40 @@ func (m Model) renderInputData(width int) string {
 	return b.String()
 }
 
+// renderMethod describes a well-known method call and its decoded approval arguments.
+func (m Model) renderMethod(input string) string {
+	signature, ok := etherscan.DecodeMethod(input)
+	if !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(m.ctx.Theme.Label.Render("Method:") + " " + m.ctx.Theme.Value.Render(signature) + "\n")
+
+	approval, ok := etherscan.DecodeApproval(input)
+	if !ok {
+		return b.String()
+	}
+	spender := m.ctx.Theme.Value.Render(string(approval.Spender))
+	if m.tx != nil {
+		if label := m.tx.Label(approval.Spender); label != "" {
+			spender += " " + m.renderLabel(label)
+		}
+	}
+	b.WriteString(m.ctx.Theme.Label.Render("Spender:") + " " + spender + "\n")
+	amount := m.ctx.Theme.Value.Render(approval.Amount.String())
+	if approval.Unlimited() {
+		amount = m.ctx.Theme.Warning.Render("unlimited")
+	}
+	b.WriteString(m.ctx.Theme.Label.Render("Amount:") + " " + amount + "\n")
+	return b.String()
+}
+
+// inputSize returns the size of hex-encoded calldata in bytes.
+func inputSize(hexInput string) int {
+	return (len(strings.TrimPrefix(hexInput, "0x")) + 1) / 2
+}
+
 func (m Model) renderInputHex(hexInput string) string {
 	var b strings.Builder
 	// Remove 0x prefix for formatting
@@ -268,12 +542,36 @@ func (m Model) formatGasFees(tx *etherscan.Transaction) string {
 	return fmt.Sprintf("⛽ Base: %s Gwei | Max: %s Gwei | Max Priority: %s Gwei", base, maxFee, priority)
 }
 
+// formatAmount renders a raw Wei amount in the selected unit, falling back to the
+// preformatted ETH value when the unit is ETH or the raw value is unavailable.
+func (m Model) formatAmount(wei, formatted, prefix string) string {
+	if m.ctx.Unit == etherscan.UnitEther || wei == "" {
+		return formatted
+	}
+	if amount := etherscan.FormatAmount(wei, m.ctx.Unit); amount != "" {
+		return prefix + amount
+	}
+	return formatted
+}
+
+// usdValue returns the approximate USD figure of the value or fee row, or "" if
+// the transaction has no ETH price, e.g. on testnets or when the price fetch failed.
+func (m Model) usdValue(f field) string {
+	wei := m.tx.ValueWei
+	if f == fieldTransactionFee {
+		wei = m.tx.TransactionFeeWei
+	}
+	return etherscan.FormatUSD(wei, m.tx.EtherPriceUSD)
+}
+
 func (m Model) formatStatus(status string) string {
 	switch strings.ToLower(status) {
 	case "success":
 		return "✔ success"
 	case "failed":
 		return "✘ failed"
+	case "mined":
+		return "✔ mined"
 	case "pending":
 		return "⧖ Pending"
 	case "dropped":
@@ -300,20 +598,63 @@ func (m Model) getStatusStyle(status string) lipgloss.Style {
 	}
 }
 
+// addressName returns the label for an address, falling back to its ENS name.
+func (m Model) addressName(addr etherscan.Address) string {
+	return cmp.Or(m.tx.Label(addr), m.tx.ENSName(addr))
+}
+
+// renderLabel renders an address label or ENS name.
+func (m Model) renderLabel(label string) string {
+	return m.ctx.Theme.Purple.Render("(" + label + ")")
+}
+
+// renderRevertReason shows why a failed transaction reverted. A replay that failed
+// leaves the reason unknown, which is shown apart from a revert without a reason.
+func (m Model) renderRevertReason(tx *etherscan.Transaction) string {
+	switch {
+	case tx.RevertReason != "":
+		return m.ctx.Theme.Value.Render(tx.RevertReason)
+	case tx.RevertReasonError != "":
+		return m.ctx.Theme.Warning.Render("unknown (replay failed: " + tx.RevertReasonError + ")")
+	default:
+		return m.ctx.Theme.DarkGray.Render("reverted (no reason)")
+	}
+}
+
+// renderGasLimit shows the gas limit alongside the block's gas limit. Pending
+// transactions have no block yet, so only the transaction's limit is shown.
+func (m Model) renderGasLimit(tx *etherscan.Transaction, value string, style lipgloss.Style) string {
+	rendered := style.Render(etherscan.FormatThousands(value))
+	if tx.BlockGasLimit == "" {
+		return rendered
+	}
+	return rendered + " " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(block limit %s)", etherscan.FormatThousands(tx.BlockGasLimit)))
+}
+
+// renderGasPrice shows the gas price in Gwei with its ETH equivalent dimmed
+// beside it, or value as formatted if the price can't be parsed.
+func (m Model) renderGasPrice(value string, style lipgloss.Style) string {
+	p, ok := m.tx.GasPriceAmounts()
+	if !ok {
+		return style.Render(value)
+	}
+	return style.Render("⛽ "+p.Gwei+" Gwei") + " " + m.ctx.Theme.LightGray.Render("("+p.ETH+" ETH)")
+}
+
+// renderGasUsage shows the gas used with its share of the gas limit, or just the
+// gas used if the limit is zero or can't be parsed.
 func (m Model) renderGasUsage(tx *etherscan.Transaction, value string, style lipgloss.Style) string {
-	var gasUsed, gasLimit float64
-	if _, err := fmt.Sscan(value, &gasUsed); err == nil {
-		if _, err := fmt.Sscan(tx.Gas, &gasLimit); err == nil && gasLimit > 0 {
-			percentage := (gasUsed / gasLimit) * 100
-			return style.Render(value) + " " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(%.2f%%)", percentage))
-		}
+	if percentage, ok := etherscan.FormatGasUsagePercent(value, tx.Gas); ok {
+		return style.Render(value) + " " + m.ctx.Theme.DarkGray.Render(fmt.Sprintf("(%s%%)", percentage))
 	}
 	return style.Render(value)
 }
 
 func (m Model) renderBlockNumber(tx *etherscan.Transaction, value string, style lipgloss.Style) string {
 	var confText string
-	if _, err := fmt.Sscan(tx.Confirmations, new(int)); err == nil {
+	if _, err := fmt.Sscan(tx.Confirmations, new(int)); err == nil && tx.Finalized {
+		confText = fmt.Sprintf(" (%s confirmations, finalized)", tx.Confirmations)
+	} else if err == nil {
 		confText = fmt.Sprintf(" (%s confirmations)", tx.Confirmations)
 	} else {
 		confText = fmt.Sprintf(" (%s)", tx.Confirmations)
@@ -323,21 +664,47 @@ func (m Model) renderBlockNumber(tx *etherscan.Transaction, value string, style
 
 func (m Model) renderTimestamp(value string, style lipgloss.Style) string {
 	t, err := time.Parse(time.RFC3339, value)
-	if err == nil {
-		duration := time.Since(t)
-		h := int(duration.Hours())
-		mMins := int(duration.Minutes()) % 60
-		s := int(duration.Seconds()) % 60
-		var agoStr string
-		switch {
-		case h > 0:
-			agoStr = fmt.Sprintf(" (%dh %dm %ds ago)", h, mMins, s)
-		case mMins > 0:
-			agoStr = fmt.Sprintf(" (%dm %ds ago)", mMins, s)
-		default:
-			agoStr = fmt.Sprintf(" (%ds ago)", s)
-		}
-		return style.Render(value) + " " + m.ctx.Theme.DarkGray.Render(agoStr)
+	if err != nil {
+		return style.Render(value)
 	}
-	return style.Render(value)
+	if t.Unix() == 0 {
+		return style.Render("unknown")
+	}
+	if m.ctx.Location != nil {
+		value = m.ctx.FormatTime(t)
+	}
+	return style.Render(value) + " " + m.ctx.Theme.DarkGray.Render(" ("+humanizeSince(t, now())+")")
+}
+
+// humanizeSince describes how long before now t was, e.g. "1h 2m 3s ago" or
+// "2d 3h 4m ago", or how far ahead, e.g. "in 3s", for a clock behind the
+// chain's. Under a second either way is "just now".
+func humanizeSince(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < time.Second {
+		return "just now"
+	}
+	days := int(d.Hours()) / 24
+	h := int(d.Hours()) % 24
+	mins := int(d.Minutes()) % 60
+	s := int(d.Seconds()) % 60
+	var str string
+	switch {
+	case days > 0:
+		str = fmt.Sprintf("%dd %dh %dm", days, h, mins)
+	case h > 0:
+		str = fmt.Sprintf("%dh %dm %ds", h, mins, s)
+	case mins > 0:
+		str = fmt.Sprintf("%dm %ds", mins, s)
+	default:
+		str = fmt.Sprintf("%ds", s)
+	}
+	if future {
+		return "in " + str
+	}
+	return str + " ago"
 }
@@ -2,10 +2,12 @@ package transaction
 
 import (
 	"awesomeProject/internal/etherscan"
+	"awesomeProject/internal/etherscan/etherscantest"
 	"awesomeProject/internal/tui/context"
 	"awesomeProject/internal/tui/theme"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -68,6 +70,7 @@ func TestFormatStatus(t *testing.T) {
 		{"Success", "success", "✔ success"},
 		{"Success Upper", "SUCCESS", "✔ success"},
 		{"Failed", "failed", "✘ failed"},
+		{"Mined (pre-Byzantium)", "mined", "✔ mined"},
 		{"Pending", "pending", "⧖ Pending"},
 		{"Dropped", "dropped", "↓ dropped"},
 		{"Replaced", "replaced", "↺ replaced"},
@@ -160,6 +163,32 @@ func TestRenderTransaction(t *testing.T) {
 	}
 }
 
+func TestRenderGasPrice(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+
+	tests := []struct {
+		name string
+		tx   *etherscan.Transaction
+		want string
+	}{
+		{"Structured", &etherscan.Transaction{GasPrice: "⛽ 1.5 Gwei (0.0000000015 ETH)", GasPriceWei: "1500000000"}, "⛽ 1.5 Gwei (0.0000000015 ETH)"},
+		// The raw price wins over a formatted one that disagrees with it
+		{"Raw Price Wins", &etherscan.Transaction{GasPrice: "⛽ 9 Gwei", GasPriceWei: "2000000000"}, "⛽ 2 Gwei (0.000000002 ETH)"},
+		// Snapshots saved before GasPriceWei existed keep the dimmed ETH equivalent
+		{"Old Snapshot", &etherscan.Transaction{GasPrice: "⛽ 3 Gwei (0.000000003 ETH)"}, "⛽ 3 Gwei (0.000000003 ETH)"},
+		{"Unparsed", &etherscan.Transaction{GasPrice: "invalid", GasPriceWei: "0x-1"}, "invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New(ctx, tt.tx)
+			if got := m.renderGasPrice(tt.tx.GasPrice, lipgloss.NewStyle()); got != tt.want {
+				t.Errorf("renderGasPrice() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRenderGasUsage(t *testing.T) {
 	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
 	m := New(ctx, nil)
@@ -175,6 +204,31 @@ func TestRenderGasUsage(t *testing.T) {
 	if strings.Contains(result, "%%") {
 		t.Errorf("should not contain percentage when gas limit is 0, got %q", result)
 	}
+
+	// Values beyond float64's exact-integer range must not lose precision
+	tx.Gas = "123456789012345678901234567890"
+	result = m.renderGasUsage(tx, "61728394506172839450617283945", lipgloss.NewStyle())
+	if !strings.Contains(result, "(50.00%)") {
+		t.Errorf("expected gas usage percentage '(50.00%%)', got %q", result)
+	}
+}
+
+func TestRenderGasLimit(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, nil)
+
+	tx := &etherscan.Transaction{BlockGasLimit: "30000000"}
+	result := m.renderGasLimit(tx, "500000", lipgloss.NewStyle())
+	if !strings.Contains(result, "500,000") || !strings.Contains(result, "(block limit 30,000,000)") {
+		t.Errorf("expected '500,000 (block limit 30,000,000)', got %q", result)
+	}
+
+	// Pending transactions have no block yet
+	tx.BlockGasLimit = ""
+	result = m.renderGasLimit(tx, "500000", lipgloss.NewStyle())
+	if strings.Contains(result, "block limit") {
+		t.Errorf("should not show a block limit without a block, got %q", result)
+	}
 }
 
 func TestRenderBlockNumber(t *testing.T) {
@@ -187,6 +241,13 @@ func TestRenderBlockNumber(t *testing.T) {
 		t.Errorf("expected '(10 confirmations)', got %q", result)
 	}
 
+	tx.Finalized = true
+	result = m.renderBlockNumber(tx, "100", lipgloss.NewStyle())
+	if !strings.Contains(result, "(10 confirmations, finalized)") {
+		t.Errorf("expected '(10 confirmations, finalized)', got %q", result)
+	}
+
+	tx.Finalized = false
 	tx.Confirmations = "Pending"
 	result = m.renderBlockNumber(tx, "100", lipgloss.NewStyle())
 	if !strings.Contains(result, "(Pending)") {
@@ -194,6 +255,115 @@ func TestRenderBlockNumber(t *testing.T) {
 	}
 }
 
+func TestRenderSummary(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 120}
+
+	tests := []struct {
+		name string
+		tx   *etherscan.Transaction
+		want string
+	}{
+		{"ETH Transfer", &etherscan.Transaction{To: "0x5df9b87991262f6ba471f09758cde1c0fc1de734", Input: "0x"}, "ETH Transfer"},
+		{"Contract Interaction", &etherscan.Transaction{To: "0xdac17f958d2ee523a2206206994597c13d831ec7", Input: "0xa9059cbb"}, "Contract Interaction"},
+		{"Contract Creation", &etherscan.Transaction{Input: "0x6080604052"}, "Contract Creation"},
+		{"Events", &etherscan.Transaction{
+			To:     "0x7a250d5630b4cf539739df2c5dacb4c659f2488d",
+			Input:  "0x7ff36ab5",
+			Events: []etherscan.EventCount{{Name: "Transfer", Count: 3}, {Name: "Approval", Count: 1}, {Name: "unknown", Count: 1}},
+		}, "Contract Interaction • Emitted 5 events (3 Transfer, 1 Approval, 1 unknown)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := New(ctx, tt.tx).View()
+			if first, _, _ := strings.Cut(view, "\n"); !strings.Contains(first, tt.want) {
+				t.Errorf("expected top line %q, got %q", tt.want, first)
+			}
+		})
+	}
+}
+
+func TestRenderTimestamp(t *testing.T) {
+	fixed := time.Date(2024, 2, 20, 20, 12, 48, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	t.Cleanup(func() { now = time.Now })
+
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, nil)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"Past", "2024-02-20T19:11:45Z", "(1h 1m 3s ago)"},
+		{"Seconds", "2024-02-20T20:12:45Z", "(3s ago)"},
+		{"Future", "2024-02-20T20:12:51Z", "(in 3s)"},
+		{"FutureMinutes", "2024-02-20T20:14:51Z", "(in 2m 3s)"},
+		{"Zero", "1970-01-01T00:00:00Z", "unknown"},
+		{"Unparsed", "block not found", "block not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := m.renderTimestamp(tt.value, lipgloss.NewStyle())
+			if !strings.Contains(result, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+			if tt.name == "Zero" && strings.Contains(result, "1970") {
+				t.Errorf("expected epoch zero to be hidden, got %q", result)
+			}
+		})
+	}
+}
+
+func TestHumanizeSince(t *testing.T) {
+	now := time.Date(2024, 2, 20, 20, 12, 48, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"Zero", now, "just now"},
+		{"Sub-second", now.Add(-400 * time.Millisecond), "just now"},
+		{"Sub-second Future", now.Add(400 * time.Millisecond), "just now"},
+		{"Seconds", now.Add(-3 * time.Second), "3s ago"},
+		{"Minutes", now.Add(-(2*time.Minute + 3*time.Second)), "2m 3s ago"},
+		{"Hours", now.Add(-(time.Hour + time.Minute + 3*time.Second)), "1h 1m 3s ago"},
+		{"Days", now.Add(-(50*time.Hour + 4*time.Minute + 5*time.Second)), "2d 2h 4m ago"},
+		{"Future", now.Add(3 * time.Second), "in 3s"},
+		{"Future Days", now.Add(25 * time.Hour), "in 1d 1h 0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := humanizeSince(tt.t, now); got != tt.want {
+				t.Errorf("humanizeSince() = %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderTimestamp_Location(t *testing.T) {
+	fixed := time.Date(2024, 5, 1, 19, 0, 3, 0, time.UTC)
+	now = func() time.Time { return fixed }
+	t.Cleanup(func() { now = time.Now })
+
+	pdt := time.FixedZone("PDT", -7*60*60)
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), Location: pdt}
+	m := New(ctx, nil)
+
+	result := m.renderTimestamp("2024-05-01T19:00:00Z", lipgloss.NewStyle())
+	if !strings.Contains(result, "2024-05-01 12:00:00 PDT") || !strings.Contains(result, "(3s ago)") {
+		t.Errorf("expected the timestamp in PDT, got %q", result)
+	}
+
+	// Epoch zero stays hidden whatever the zone
+	if result := m.renderTimestamp("1970-01-01T00:00:00Z", lipgloss.NewStyle()); result != "unknown" {
+		t.Errorf("expected unknown, got %q", result)
+	}
+}
+
 func TestRenderTransactionEmptyInput(t *testing.T) {
 	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
 	tx := &etherscan.Transaction{
@@ -272,3 +442,371 @@ func TestCalculateWidths(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderRevertReason(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+
+	tx := &etherscan.Transaction{Status: "failed", RevertReason: "insufficient balance", Input: "0x"}
+	result := New(ctx, tx).View()
+	if !strings.Contains(result, "Revert Reason:") || !strings.Contains(result, "insufficient balance") {
+		t.Errorf("expected revert reason in output, got %q", result)
+	}
+
+	tx.RevertReason = ""
+	result = New(ctx, tx).View()
+	if !strings.Contains(result, "reverted (no reason)") {
+		t.Errorf("expected 'reverted (no reason)' fallback, got %q", result)
+	}
+
+	tx.RevertReasonError = "timeout"
+	result = New(ctx, tx).View()
+	if !strings.Contains(result, "unknown (replay failed: timeout)") || strings.Contains(result, "no reason") {
+		t.Errorf("expected a failed replay to be shown apart from a revert without a reason, got %q", result)
+	}
+
+	tx.Status = "success"
+	result = New(ctx, tx).View()
+	if strings.Contains(result, "Revert Reason:") {
+		t.Errorf("successful transaction should not show a revert reason, got %q", result)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, nil)
+
+	if got := m.formatAmount("21000", "0.000000000000021 ETH", ""); got != "0.000000000000021 ETH" {
+		t.Errorf("expected preformatted ETH value, got %q", got)
+	}
+
+	ctx.Unit = etherscan.UnitWei
+	if got := m.formatAmount("21000", "0.000000000000021 ETH", ""); got != "21000 Wei" {
+		t.Errorf("expected '21000 Wei', got %q", got)
+	}
+	if got := m.formatAmount("", "0 ETH", "♦ "); got != "0 ETH" {
+		t.Errorf("expected fallback without a raw value, got %q", got)
+	}
+}
+
+func TestRenderMethod(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+	approve := "0x095ea7b3" + "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+
+	tx := &etherscan.Transaction{Status: "success", Input: approve + strings.Repeat("f", 64)}
+	result := New(ctx, tx).renderMethod(tx.Input)
+	for _, sub := range []string{"approve(address,uint256)", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "unlimited"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("rendered output missing expected substring: %q", sub)
+		}
+	}
+
+	if !strings.Contains(New(ctx, tx).View(), "approve(address,uint256)") {
+		t.Errorf("expected the method in the input data section")
+	}
+
+	tx.Input = approve + strings.Repeat("0", 63) + "1"
+	result = New(ctx, tx).renderMethod(tx.Input)
+	if strings.Contains(result, "unlimited") || !strings.Contains(result, "Amount:") {
+		t.Errorf("limited approval should show its amount, got %q", result)
+	}
+
+	if got := New(ctx, nil).renderMethod("0x6080604052348015"); got != "" {
+		t.Errorf("expected no method for unknown selector, got %q", got)
+	}
+}
+
+func TestRenderWarnings(t *testing.T) {
+	for _, width := range []int{100, 30} {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: width}
+
+		tx := &etherscan.Transaction{Status: "success", Input: "0x"}
+		if result := New(ctx, tx).View(); strings.Contains(result, "Warnings") {
+			t.Errorf("width %d: expected no warnings section, got %q", width, result)
+		}
+
+		tx.AddWarning("unlimited approval: %s can spend all of this token", "0xabc")
+		tx.AddWarning("block details unavailable: %s", "timeout")
+		result := New(ctx, tx).View()
+		for _, sub := range []string{"Warnings", "⚠ unlimited approval", "⚠ block details unavailable: timeout"} {
+			if !strings.Contains(result, sub) {
+				t.Errorf("width %d: rendered output missing expected substring: %q", width, sub)
+			}
+		}
+		if strings.Index(result, "Warnings") < strings.Index(result, "Input Data") {
+			t.Errorf("width %d: expected warnings at the bottom of the view", width)
+		}
+	}
+}
+
+func TestRenderBlobs(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+
+	tx := &etherscan.Transaction{Status: "success", Type: "2 (EIP-1559)", Input: "0x"}
+	if result := New(ctx, tx).View(); strings.Contains(result, "Blob Gas") {
+		t.Errorf("expected no blob section for a non-blob transaction, got %q", result)
+	}
+
+	tx.Type = "3 (EIP-4844)"
+	tx.MaxFeePerBlobGas = "2"
+	tx.BlobVersionedHashes = []etherscan.Hash{"0x01a5", "0x01c3"}
+	result := New(ctx, tx).View()
+	for _, sub := range []string{"Blob Gas", "Max Fee Per Blob Gas:", "2 Gwei", "Blob Gas Used:", "n/a", "2 blobs, first 0x01a5"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("rendered output missing expected substring: %q", sub)
+		}
+	}
+
+	tx.BlobGasUsed, tx.BlobGasPrice, tx.BlobFee = "262144", "1", "0.000262144 ETH"
+	result = New(ctx, tx).View()
+	for _, sub := range []string{"262,144", "1 Gwei", "0.000262144 ETH"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("rendered output missing expected substring: %q", sub)
+		}
+	}
+}
+
+func TestRenderTransfers(t *testing.T) {
+	for _, width := range []int{100, 30} {
+		ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: width}
+
+		tx := &etherscan.Transaction{Status: "success", Input: "0x"}
+		if result := New(ctx, tx).View(); strings.Contains(result, "Token Transfers") {
+			t.Errorf("width %d: expected no token transfers section, got %q", width, result)
+		}
+
+		tx.TokenTransfers = []etherscan.TokenTransfer{
+			{Token: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", From: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", To: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Amount: "1000000"},
+			{Token: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", From: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", To: "0xcccccccccccccccccccccccccccccccccccccccc", Amount: "5"},
+		}
+		tx.Labels = map[etherscan.Address]string{"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48": "USDC"}
+		tx.AddWarning("unlimited approval")
+		result := New(ctx, tx).View()
+		for _, sub := range []string{"Token Transfers", "0xaaaa…aaaa → 0xbbbb…bbbb  1,000,000 USDC", "0xbbbb…bbbb → 0xcccc…cccc  5 0xc02a…6cc2"} {
+			if !strings.Contains(result, sub) {
+				t.Errorf("width %d: rendered output missing expected substring: %q", width, sub)
+			}
+		}
+		if strings.Index(result, "Token Transfers") > strings.Index(result, "Warnings") {
+			t.Errorf("width %d: expected token transfers above the warnings", width)
+		}
+	}
+}
+
+func TestRenderNonceContext(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+
+	tx := &etherscan.Transaction{Status: "success", Nonce: "5", Input: "0x"}
+	if result := New(ctx, tx).View(); strings.Contains(result, "sender's") {
+		t.Errorf("expected no nonce annotation without a sender transaction count, got %q", result)
+	}
+
+	tx.SenderTxCount = "6"
+	if result := New(ctx, tx).View(); !strings.Contains(result, "(sender's 6th transaction, latest)") {
+		t.Errorf("expected nonce annotation, got %q", result)
+	}
+}
+
+func TestRenderUSDValues(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+
+	tx := &etherscan.Transaction{
+		Status:            "success",
+		Value:             "♦ 1 ETH",
+		ValueWei:          "1000000000000000000",
+		TransactionFee:    "0.000021 ETH",
+		TransactionFeeWei: "21000000000000",
+		Input:             "0x",
+	}
+	// Without a price, e.g. off Mainnet or when the price fetch failed, amounts are ETH only
+	if result := New(ctx, tx).View(); strings.Contains(result, "$") {
+		t.Errorf("expected no USD values without a price, got %q", result)
+	}
+
+	tx.EtherPriceUSD = 3200
+	result := New(ctx, tx).View()
+	for _, sub := range []string{"♦ 1 ETH (~$3,200)", "0.000021 ETH (~$0.07)"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("rendered output missing expected substring: %q", sub)
+		}
+	}
+
+	// A zero value has nothing to convert
+	tx.ValueWei, tx.Value = "0", "♦ 0 ETH"
+	if result := New(ctx, tx).View(); strings.Contains(result, "0 ETH (") {
+		t.Errorf("expected no USD value for a zero amount, got %q", result)
+	}
+}
+
+func TestLabelFlavor(t *testing.T) {
+	tx := &etherscan.Transaction{
+		Status:           "success",
+		Hash:             "0x123",
+		BlockNumber:      "11",
+		TransactionIndex: "5",
+		TransactionFee:   "0.00021 ETH",
+	}
+
+	tests := []struct {
+		name    string
+		flavor  context.Flavor
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "default is Etherscan-style",
+			flavor:  "",
+			want:    []string{"Hash:", "Block Number:", "Transaction Fee:", "Tx Index:"},
+			notWant: []string{"Txn Fee:", "Position:"},
+		},
+		{
+			name:    "Etherscan",
+			flavor:  context.FlavorEtherscan,
+			want:    []string{"Hash:", "Block Number:", "Transaction Fee:", "Tx Index:"},
+			notWant: []string{"Txn Fee:", "Position:"},
+		},
+		{
+			name:    "Blockscout",
+			flavor:  context.FlavorBlockscout,
+			want:    []string{"Transaction Hash:", "Block:", "Txn Fee:", "Position:", "Status:"},
+			notWant: []string{"Transaction Fee:", "Tx Index:", "Block Number:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), Flavor: tt.flavor}
+			result := New(ctx, tx).renderDetails(100)
+
+			for _, sub := range tt.want {
+				if !strings.Contains(result, sub) {
+					t.Errorf("renderDetails() missing label %q", sub)
+				}
+			}
+			for _, sub := range tt.notWant {
+				if strings.Contains(result, sub) {
+					t.Errorf("renderDetails() has unexpected label %q", sub)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderInputData(t *testing.T) {
+	tx := &etherscan.Transaction{Input: "0x" + strings.Repeat("ab", 1500)}
+
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme(), ScreenWidth: 100}
+	result := New(ctx, tx).renderInputData(40)
+	if !strings.Contains(result, "(1,500 bytes)") {
+		t.Errorf("expected input size in the title, got %q", result)
+	}
+	if !strings.Contains(result, "Scrollable:") {
+		t.Errorf("expected large input to scroll within the viewport")
+	}
+
+	ctx.HideInput = true
+	if result := New(ctx, tx).renderInputData(40); result != "" {
+		t.Errorf("expected no input section when hidden, got %q", result)
+	}
+}
+
+func TestInputSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"0x", 0},
+		{"0xa9059cbb", 4},
+		{"0xabc", 2},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		if got := inputSize(tt.input); got != tt.want {
+			t.Errorf("inputSize(%q) = %d; want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRenderAddressLabels(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	tx := &etherscan.Transaction{From: "0xaaa", To: "0xbbb", ToAccountType: "Smart Contract"}
+	tx.SetLabel("0xaaa", "Alice")
+	tx.SetLabel("0xBBB", "Uniswap V2: Router")
+
+	result := New(ctx, tx).renderDetails(100)
+	for _, sub := range []string{"0xaaa (Alice)", "0xbbb (Uniswap V2: Router) (Smart Contract)"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("renderDetails() missing %q", sub)
+		}
+	}
+}
+
+func TestRenderDetails_MethodRow(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+
+	call := New(ctx, &etherscan.Transaction{To: "0xbbb", Method: "transfer(address,uint256)"}).renderDetails(100)
+	to, method := strings.Index(call, "To:"), strings.Index(call, "Method:")
+	if method < 0 || method < to || !strings.Contains(call, "transfer(address,uint256)") {
+		t.Errorf("expected a method row after the recipient, got:\n%s", call)
+	}
+
+	transfer := New(ctx, &etherscan.Transaction{To: "0xbbb"}).renderDetails(100)
+	if strings.Contains(transfer, "Method:") {
+		t.Errorf("expected no method row for a plain transfer, got:\n%s", transfer)
+	}
+}
+
+func TestRenderContractCreation(t *testing.T) {
+	routes := etherscantest.DefaultRoutes()
+	routes["eth_getTransactionByHash"] = etherscantest.TxCreation
+	routes["eth_getTransactionReceipt"] = etherscantest.ReceiptCreation
+	server := etherscantest.NewServer(t, routes)
+	client := etherscan.NewClient("test", etherscan.WithBaseURL(server.URL), etherscan.WithTuning(etherscan.FastTuning()))
+
+	tx, err := client.FetchTransaction(t.Context(), "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	m := New(ctx, tx)
+	result := m.renderDetails(100)
+	if !strings.Contains(result, "Contract Created:") || !strings.Contains(result, "0x5fbdb2315678afecb367f032d93f642f64180aa3 (new contract)") {
+		t.Errorf("expected a contract created row, got:\n%s", result)
+	}
+	if strings.Contains(result, "To:") {
+		t.Errorf("expected no recipient row for a contract creation, got:\n%s", result)
+	}
+
+	// Copying the row gives the bare address
+	for range 7 {
+		m.moveSelection(1)
+	}
+	if label, value, ok := m.SelectedField(); !ok || label != "Contract Created" || value != "0x5fbdb2315678afecb367f032d93f642f64180aa3" {
+		t.Errorf("SelectedField() = %q, %q, %v; want the created contract", label, value, ok)
+	}
+
+	// Without the receipt there is no address to show, so the recipient row stays
+	tx.ContractAddress = ""
+	if result := New(ctx, tx).renderDetails(100); !strings.Contains(result, "To:") || strings.Contains(result, "Contract Created") {
+		t.Errorf("expected the recipient row without a contract address, got:\n%s", result)
+	}
+}
+
+func TestRenderENSNames(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: theme.DefaultTheme()}
+	tx := &etherscan.Transaction{From: "0xaaa", To: "0xbbb", ToAccountType: "EOA"}
+	tx.SetENSName("0xaaa", "alice.eth")
+	tx.SetENSName("0xbbb", "bob.eth")
+	tx.SetLabel("0xbbb", "Bob's Wallet")
+
+	result := New(ctx, tx).renderDetails(100)
+	// Labels take precedence over ENS names
+	for _, sub := range []string{"0xaaa (alice.eth)", "0xbbb (Bob's Wallet) (EOA)"} {
+		if !strings.Contains(result, sub) {
+			t.Errorf("renderDetails() missing %q", sub)
+		}
+	}
+	if strings.Contains(result, "bob.eth") {
+		t.Errorf("expected label to replace the ENS name")
+	}
+}
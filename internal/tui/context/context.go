@@ -2,13 +2,35 @@
 package context
 
 import (
+	"awesomeProject/internal/etherscan"
 	"awesomeProject/internal/tui/theme"
+	"time"
 )
 
-// ProgramContext holds global state such as screen dimensions and the current theme.
+// timeLayout is how timestamps are shown when converted to a display zone.
+const timeLayout = "2006-01-02 15:04:05 MST"
+
+// ProgramContext holds global state such as screen dimensions, the current theme
+// and display preferences that persist across lookups.
 type ProgramContext struct {
 	ScreenWidth  int
 	ScreenHeight int
 	FooterWidth  int
 	Theme        *theme.Theme
+	Unit         etherscan.Unit // unit for Value and Transaction Fee
+	Flavor       Flavor         // terminology for transaction field labels
+	HideInput    bool           // hide the Input Data section
+	Location     *time.Location // zone timestamps are shown in, nil to show them in UTC
+	Boxed        bool           // draw the transaction details in a bordered box
+}
+
+// FormatTime renders t in the display zone with the zone's abbreviation,
+// e.g. "2024-05-01 12:00:00 PDT". Timestamps are stored in UTC and only
+// converted here, at render time.
+func (c *ProgramContext) FormatTime(t time.Time) string {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(timeLayout)
 }
@@ -0,0 +1,29 @@
+package context
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		want     string
+	}{
+		{"DefaultUTC", nil, "2024-05-01 19:00:00 UTC"},
+		{"FixedZone", time.FixedZone("PDT", -7*60*60), "2024-05-01 12:00:00 PDT"},
+		{"AheadOfUTC", time.FixedZone("JST", 9*60*60), "2024-05-02 04:00:00 JST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ProgramContext{Location: tt.location}
+			if got := c.FormatTime(ts); got != tt.want {
+				t.Errorf("FormatTime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package context
+
+import "fmt"
+
+// Flavor selects the terminology used for transaction field labels.
+type Flavor string
+
+const (
+	// FlavorEtherscan uses Etherscan's field names (the default).
+	FlavorEtherscan Flavor = "etherscan"
+	// FlavorBlockscout uses Blockscout's field names, e.g. "Txn Fee".
+	FlavorBlockscout Flavor = "blockscout"
+)
+
+// ParseFlavor parses a label flavor name ("etherscan" or "blockscout").
+// An empty string selects FlavorEtherscan.
+func ParseFlavor(s string) (Flavor, error) {
+	switch Flavor(s) {
+	case "", FlavorEtherscan:
+		return FlavorEtherscan, nil
+	case FlavorBlockscout:
+		return FlavorBlockscout, nil
+	default:
+		return "", fmt.Errorf("unknown label flavor %q (want %q or %q)", s, FlavorEtherscan, FlavorBlockscout)
+	}
+}
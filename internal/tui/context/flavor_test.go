@@ -0,0 +1,27 @@
+package context
+
+import "testing"
+
+func TestParseFlavor(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Flavor
+		wantErr bool
+	}{
+		{"", FlavorEtherscan, false},
+		{"etherscan", FlavorEtherscan, false},
+		{"blockscout", FlavorBlockscout, false},
+		{"Blockscout", "", true},
+		{"otterscan", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseFlavor(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseFlavor(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseFlavor(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
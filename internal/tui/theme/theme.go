@@ -1,7 +1,10 @@
 // Package theme defines the visual styles and colors for the TUI.
 package theme
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
 
 // Theme defines the collection of styles used throughout the application.
 type Theme struct {
@@ -9,6 +12,7 @@ type Theme struct {
 	Label     lipgloss.Style
 	Value     lipgloss.Style
 	Error     lipgloss.Style
+	Warning   lipgloss.Style
 	Active    lipgloss.Style
 	Inactive  lipgloss.Style
 	Help      lipgloss.Style
@@ -19,8 +23,10 @@ type Theme struct {
 	LightGray lipgloss.Style
 	DarkGray  lipgloss.Style
 	Savings   lipgloss.Style
+	Synced    lipgloss.Style
 	Purple    lipgloss.Style
 	Separator lipgloss.Style
+	Selected  lipgloss.Style
 }
 
 // DefaultTheme returns the default adaptive theme for the TUI.
@@ -44,6 +50,10 @@ func DefaultTheme() *Theme {
 			Foreground(lipgloss.AdaptiveColor{Light: "#FF0000", Dark: "#FF0000"}).
 			MarginTop(1),
 
+		Warning: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.AdaptiveColor{Light: "#D4AF37", Dark: "#FFFF00"}),
+
 		Active: lipgloss.NewStyle().
 			Bold(true).
 			Foreground(purple),
@@ -90,9 +100,24 @@ func DefaultTheme() *Theme {
 			Foreground(lipgloss.AdaptiveColor{Light: "#008000", Dark: "#00FF00"}).
 			Italic(true),
 
+		Synced: lipgloss.NewStyle().
+			Foreground(lipgloss.AdaptiveColor{Light: "#008000", Dark: "#00FF00"}),
+
 		Purple: lipgloss.NewStyle().
 			Foreground(purple),
 		Separator: lipgloss.NewStyle().
 			Foreground(lipgloss.AdaptiveColor{Light: "#D9D9D9", Dark: "#383838"}),
+
+		// Reversed, so the selection still shows without color
+		Selected: lipgloss.NewStyle().
+			Bold(true).
+			Reverse(true).
+			Foreground(purple),
 	}
 }
+
+// DisableColor renders every style as plain text, without colors or other
+// terminal attributes. It affects all styles and is meant to be called once at startup.
+func DisableColor() {
+	lipgloss.SetColorProfile(termenv.Ascii)
+}